@@ -23,6 +23,10 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 )
 
+const (
+	AlluxioRuntimeKind = "AlluxioRuntime"
+)
+
 type AlluxioRuntimeRole common.RuntimeRole
 
 const (
@@ -78,6 +82,10 @@ type AlluxioCompTemplateSpec struct {
 	// +optional
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 
+	// SchedulingSpec defines the scheduling constraints of the pods, e.g. tolerations and affinity
+	// +optional
+	SchedulingSpec `json:",inline"`
+
 	// Whether to use hostnetwork or not
 	// +kubebuilder:validation:Enum=HostNetwork;"";ContainerNetwork
 	// +optional
@@ -136,6 +144,10 @@ type AlluxioFuseSpec struct {
 	// +optional
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 
+	// SchedulingSpec defines the scheduling constraints of the pods, e.g. tolerations and affinity
+	// +optional
+	SchedulingSpec `json:",inline"`
+
 	// CleanPolicy decides when to clean Alluxio Fuse pods.
 	// Currently Fluid supports two policies: OnDemand and OnRuntimeDeleted
 	// OnDemand cleans fuse pod once the fuse pod on some node is not needed
@@ -291,3 +303,8 @@ func (runtime *AlluxioRuntime) Replicas() int32 {
 func (runtime *AlluxioRuntime) GetStatus() *RuntimeStatus {
 	return &runtime.Status
 }
+
+// GetAutoscalingPolicy gets the autoscaling policy of runtime workers
+func (runtime *AlluxioRuntime) GetAutoscalingPolicy() *AutoscalingPolicy {
+	return runtime.Spec.RuntimeManagement.Autoscaling
+}
@@ -96,6 +96,47 @@ type RuntimeManagement struct {
 	// MetadataSyncPolicy defines the policy of syncing metadata when setting up the runtime. If not set,
 	// +optional
 	MetadataSyncPolicy MetadataSyncPolicy `json:"metadataSyncPolicy,omitempty"`
+
+	// Autoscaling defines the policy of scaling the cache workers automatically according to the cache states.
+	// The replicas of the runtime are managed by the runtime controller if set, so don't scale the runtime with
+	// HPA at the same time. Only AlluxioRuntime and JuiceFSRuntime support it for now.
+	// +optional
+	Autoscaling *AutoscalingPolicy `json:"autoscaling,omitempty"`
+}
+
+// AutoscalingPolicy defines the policy of scaling the cache workers automatically. The workers are scaled out when
+// the cache usage exceeds the target and the dataset is not cached enough, and scaled in one by one when the cached
+// data fits in the remaining workers under the target cache usage.
+type AutoscalingPolicy struct {
+	// MinReplicas is the lower limit of the worker replicas. If not set, it defaults to 1.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the upper limit of the worker replicas, it should not be less than MinReplicas.
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// TargetCachedPercentage is the percentage of the dataset expected to be cached. The workers are not scaled out
+	// once the cached percentage of the dataset reaches it. If not set, the workers are scaled out whenever the
+	// cache usage exceeds the target.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	TargetCachedPercentage *int32 `json:"targetCachedPercentage,omitempty"`
+
+	// TargetCacheUsagePercentage is the target percentage of the cache usage over the cache capacity under the
+	// high watermark of the tiered store. If not set, it defaults to 80.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	TargetCacheUsagePercentage *int32 `json:"targetCacheUsagePercentage,omitempty"`
+
+	// ScaleInCooldownSeconds is the minimum duration in seconds between the last scaling of the workers and a
+	// scale-in. If not set, it defaults to 300.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ScaleInCooldownSeconds *int32 `json:"scaleInCooldownSeconds,omitempty"`
 }
 
 // InitUsersSpec is a description of the initialize the users for runtime
@@ -190,17 +231,58 @@ type CleanCachePolicy struct {
 	MaxRetryAttempts *int32 `json:"maxRetryAttempts,omitempty"`
 }
 
+// MetadataSyncMode defines the mode of re-syncing metadata on schedule
+type MetadataSyncMode string
+
+const (
+	// FullMetadataSync reloads the metadata of all the files under the paths
+	FullMetadataSync MetadataSyncMode = "Full"
+
+	// IncrementalMetadataSync only loads the metadata of the files which are not loaded yet under the paths
+	IncrementalMetadataSync MetadataSyncMode = "Incremental"
+)
+
 // MetadataSyncPolicy defines policies when syncing metadata
 type MetadataSyncPolicy struct {
 	// AutoSync enables automatic metadata sync when setting up a runtime. If not set, it defaults to true.
 	// +optional
 	AutoSync *bool `json:"autoSync,omitempty"`
+
+	// Schedule re-syncs metadata periodically in Cron format after the runtime is set up,
+	// see https://en.wikipedia.org/wiki/Cron. If not set, metadata is only synced when setting up the runtime.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// Paths limits the scheduled re-sync to the given paths in the dataset, e.g. /spark/logs.
+	// If not set, metadata of the whole dataset is re-synced.
+	// +optional
+	Paths []string `json:"paths,omitempty"`
+
+	// Mode of the scheduled re-sync, one of Full and Incremental, it defaults to Full.
+	// Paths and Mode only take effect on runtimes loading metadata from UFS (e.g. Alluxio and GooseFS),
+	// the other runtimes only refresh the total size and file number of the dataset on schedule.
+	// +kubebuilder:validation:Enum=Full;Incremental
+	// +optional
+	Mode MetadataSyncMode `json:"mode,omitempty"`
 }
 
 func (msb *MetadataSyncPolicy) AutoSyncEnabled() bool {
 	return msb.AutoSync == nil || *msb.AutoSync
 }
 
+// ScheduledSyncPaths returns the paths to re-sync on schedule, which defaults to the root of the dataset
+func (msb *MetadataSyncPolicy) ScheduledSyncPaths() []string {
+	if len(msb.Paths) == 0 {
+		return []string{"/"}
+	}
+	return msb.Paths
+}
+
+// IncrementalSyncEnabled checks if the scheduled re-sync only loads the metadata of new files
+func (msb *MetadataSyncPolicy) IncrementalSyncEnabled() bool {
+	return msb.Mode == IncrementalMetadataSync
+}
+
 // VersionSpec represents the settings for the  version that fluid is orchestrating.
 type VersionSpec struct {
 	// Image (e.g. alluxio/alluxio)
@@ -230,6 +312,19 @@ const (
 	OnEvent Policy = "OnEvent"
 )
 
+type MigrateMode string
+
+const (
+	// CopyMigrateMode copies the files from the source to the destination, default mode is Copy
+	CopyMigrateMode MigrateMode = "Copy"
+
+	// VerifyMigrateMode compares the size and checksum of the files in the source and the destination without copying
+	VerifyMigrateMode MigrateMode = "Verify"
+
+	// IncrementalMigrateMode only copies the files changed since the last successful migration
+	IncrementalMigrateMode MigrateMode = "Incremental"
+)
+
 // Condition explains the transitions on phase
 type Condition struct {
 	// Type of condition, either `Complete` or `Failed`
@@ -285,7 +380,7 @@ type ObjectRef struct {
 
 	// Kind specifies the type of the referent operation
 	// +required
-	// +kubebuilder:validation:Enum=DataLoad;DataBackup;DataMigrate;DataProcess
+	// +kubebuilder:validation:Enum=DataLoad;DataBackup;DataMigrate;DataProcess;DataFree
 	Kind string `json:"kind"`
 
 	// Name specifies the name of the referent operation
@@ -318,3 +413,34 @@ type ClientMetrics struct {
 	// Defaults to None when it is not explicitly set.
 	ScrapeTarget string `json:"scrapeTarget,omitempty"`
 }
+
+// SchedulingSpec defines the scheduling constraints of the pods of a runtime component
+type SchedulingSpec struct {
+	// If specified, the pod's tolerations. They are appended to the tolerations of the dataset.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// If specified, the pod's scheduling constraints.
+	// It overrides the affinity generated by Fluid, e.g. the affinity to the nodes of the dataset.
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	// If specified, indicates the pod's priority. It overrides the priority class set by Fluid,
+	// e.g. system-node-critical for the fuse pods when criticalPod is enabled.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// TopologySpreadConstraints describes how the pods ought to spread across topology domains.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// If specified, the pod will be dispatched by specified scheduler.
+	// If not specified, the pod will be dispatched by default scheduler.
+	// +optional
+	SchedulerName string `json:"schedulerName,omitempty"`
+
+	// SecurityContext holds pod-level security attributes and common container settings.
+	// It overrides the pod security context set by Fluid.
+	// +optional
+	SecurityContext *corev1.PodSecurityContext `json:"securityContext,omitempty"`
+}
@@ -28,6 +28,12 @@ const (
 
 	// The cache system fails to bind
 	DatasetFailedToSetupReason = "DatasetFailedToSetup"
+
+	// The metadata of the dataset is synced
+	DatasetMetadataSyncSucceededReason = "MetadataSyncSucceeded"
+
+	// The metadata of the dataset fails to sync
+	DatasetMetadataSyncFailedReason = "MetadataSyncFailed"
 )
 
 type PlacementMode string
@@ -56,3 +62,14 @@ const (
 	// OnFuseChangedCleanPolicy cleans fuse pod when the fuse in runtime is updated and the fuse pod on some node is not needed
 	OnFuseChangedCleanPolicy FuseCleanPolicy = "OnFuseChanged"
 )
+
+type FuseMountUpdateStrategy string
+
+const (
+	// OnDeleteMountUpdateStrategy is the default strategy. The fuse pods pick up the changed mount points when they are
+	// recreated, which happens once they are not needed if the OnFuseChanged clean policy is used.
+	OnDeleteMountUpdateStrategy FuseMountUpdateStrategy = "OnDelete"
+
+	// RecreateMountUpdateStrategy deletes the running fuse pods to pick up the changed mount points immediately
+	RecreateMountUpdateStrategy FuseMountUpdateStrategy = "Recreate"
+)
@@ -58,6 +58,14 @@ type DataMigrateSpec struct {
 	// +optional
 	Schedule string `json:"schedule,omitempty"`
 
+	//+kubebuilder:default:=Copy
+	//+kubebuilder:validation:Enum=Copy;Verify;Incremental
+	// mode for migrate, including Copy, Verify, Incremental. Verify compares the size and checksum of the files
+	// without copying them, and Incremental only copies the files changed since the last successful migration,
+	// which requires the Cron policy.
+	// +optional
+	Mode MigrateMode `json:"mode,omitempty"`
+
 	// PodMetadata defines labels and annotations that will be propagated to DataMigrate pods
 	PodMetadata PodMetadata `json:"podMetadata,omitempty"`
 
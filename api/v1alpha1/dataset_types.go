@@ -258,6 +258,10 @@ const (
 
 	// DatasetInitialized means the cache system for the dataset is Initialized.
 	DatasetInitialized DatasetConditionType = "Initialized"
+
+	// DatasetMetadataSynced means the metadata of the dataset is synced, the last update time of the condition
+	// is the time when the last metadata sync finished.
+	DatasetMetadataSynced DatasetConditionType = "MetadataSynced"
 )
 
 // CacheableNodeAffinity defines constraints that limit what nodes this dataset can be cached to.
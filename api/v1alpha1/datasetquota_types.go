@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DatasetQuotaSpec defines the limits of the cache capacity which the runtimes of the datasets
+// in the namespace may request across the tiered store levels
+type DatasetQuotaSpec struct {
+	// CacheCapacity limits the total cache capacity requested across all the tiered store levels
+	// +optional
+	CacheCapacity *resource.Quantity `json:"cacheCapacity,omitempty"`
+
+	// MediumCacheCapacity limits the cache capacity requested on each medium type of the tiered store levels,
+	// e.g. MEM, SSD and HDD
+	// +optional
+	MediumCacheCapacity map[common.MediumType]resource.Quantity `json:"mediumCacheCapacity,omitempty"`
+}
+
+// DatasetQuotaStatus defines the cache capacity requested by the datasets in the namespace
+type DatasetQuotaStatus struct {
+	// CacheCapacity is the total cache capacity requested by the datasets in the namespace
+	// +optional
+	CacheCapacity *resource.Quantity `json:"cacheCapacity,omitempty"`
+
+	// MediumCacheCapacity is the cache capacity requested on each medium type by the datasets in the namespace
+	// +optional
+	MediumCacheCapacity map[common.MediumType]resource.Quantity `json:"mediumCacheCapacity,omitempty"`
+
+	// Datasets is the names of the datasets accounted in the quota
+	// +optional
+	Datasets []string `json:"datasets,omitempty"`
+}
+
+// +kubebuilder:printcolumn:name="Capacity Limit",type="string",JSONPath=`.spec.cacheCapacity`
+// +kubebuilder:printcolumn:name="Capacity Requested",type="string",JSONPath=`.status.cacheCapacity`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:resource:categories={fluid},shortName=dsquota
+// +genclient
+
+// DatasetQuota is the Schema for the datasetquotas API
+type DatasetQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DatasetQuotaSpec   `json:"spec,omitempty"`
+	Status DatasetQuotaStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+
+// DatasetQuotaList contains a list of DatasetQuota
+type DatasetQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DatasetQuota `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DatasetQuota{}, &DatasetQuotaList{})
+}
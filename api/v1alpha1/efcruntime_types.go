@@ -80,6 +80,10 @@ type EFCCompTemplateSpec struct {
 	// +optional
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 
+	// SchedulingSpec defines the scheduling constraints of the pods, e.g. tolerations and affinity
+	// +optional
+	SchedulingSpec `json:",inline"`
+
 	// Whether to use host network or not.
 	// +kubebuilder:validation:Enum=HostNetwork;"";ContainerNetwork
 	// +optional
@@ -111,6 +115,10 @@ type EFCFuseSpec struct {
 	// +optional
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 
+	// SchedulingSpec defines the scheduling constraints of the pods, e.g. tolerations and affinity
+	// +optional
+	SchedulingSpec `json:",inline"`
+
 	// CleanPolicy decides when to clean EFC Fuse pods.
 	// Currently Fluid supports two policies: OnDemand and OnRuntimeDeleted
 	// OnDemand cleans fuse pod once th fuse pod on some node is not needed
@@ -21,6 +21,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	GooseFSRuntimeKind = "GooseFSRuntime"
+)
+
 // GooseFSCompTemplateSpec is a description of the GooseFS commponents
 type GooseFSCompTemplateSpec struct {
 	// Replicas is the desired number of replicas of the given template.
@@ -60,6 +64,10 @@ type GooseFSCompTemplateSpec struct {
 	// +optional
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 
+	// SchedulingSpec defines the scheduling constraints of the pods, e.g. tolerations and affinity
+	// +optional
+	SchedulingSpec `json:",inline"`
+
 	// Annotations is an unstructured key value map stored with a resource that may be
 	// set by external tools to store and retrieve arbitrary metadata. They are not
 	// queryable and should be preserved when modifying objects.
@@ -105,6 +113,10 @@ type GooseFSFuseSpec struct {
 	// +optional
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 
+	// SchedulingSpec defines the scheduling constraints of the pods, e.g. tolerations and affinity
+	// +optional
+	SchedulingSpec `json:",inline"`
+
 	// CleanPolicy decides when to clean GooseFS Fuse pods.
 	// Currently Fluid supports two policies: OnDemand and OnRuntimeDeleted
 	// OnDemand cleans fuse pod once th fuse pod on some node is not needed
@@ -183,6 +195,10 @@ type GooseFSRuntimeSpec struct {
 	// CleanCachePolicy defines cleanCache Policy
 	// +optional
 	CleanCachePolicy CleanCachePolicy `json:"cleanCachePolicy,omitempty"`
+
+	// MetadataSyncPolicy defines the policy of syncing metadata when setting up the runtime and on schedule
+	// +optional
+	MetadataSyncPolicy MetadataSyncPolicy `json:"metadataSyncPolicy,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -55,9 +55,9 @@ type JindoCompTemplateSpec struct {
 	// +optional
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 
-	// If specified, the pod's tolerations.
+	// SchedulingSpec defines the scheduling constraints of the pods, e.g. tolerations and affinity
 	// +optional
-	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	SchedulingSpec `json:",inline"`
 
 	// Labels will be added on JindoFS Master or Worker pods.
 	// DEPRECATED: This is a deprecated field. Please use PodMetadata instead.
@@ -119,9 +119,9 @@ type JindoFuseSpec struct {
 	// +optional
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 
-	// If specified, the pod's tolerations.
+	// SchedulingSpec defines the scheduling constraints of the pods, e.g. tolerations and affinity
 	// +optional
-	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	SchedulingSpec `json:",inline"`
 
 	// Labels will be added on all the JindoFS pods.
 	// DEPRECATED: this is a deprecated field. Please use PodMetadata.Labels instead.
@@ -212,6 +212,10 @@ type JindoRuntimeSpec struct {
 	// +optional
 	CleanCachePolicy CleanCachePolicy `json:"cleanCachePolicy,omitempty"`
 
+	// MetadataSyncPolicy defines the policy of syncing metadata when setting up the runtime and on schedule
+	// +optional
+	MetadataSyncPolicy MetadataSyncPolicy `json:"metadataSyncPolicy,omitempty"`
+
 	// Volumes is the list of Kubernetes volumes that can be mounted by the jindo runtime components and/or fuses.
 	// +optional
 	Volumes []corev1.Volume `json:"volumes,omitempty"`
@@ -113,6 +113,10 @@ type JuiceFSCompTemplateSpec struct {
 	// +optional
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 
+	// SchedulingSpec defines the scheduling constraints of the pods, e.g. tolerations and affinity
+	// +optional
+	SchedulingSpec `json:",inline"`
+
 	// VolumeMounts specifies the volumes listed in ".spec.volumes" to mount into runtime component's filesystem.
 	// +optional
 	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
@@ -152,6 +156,10 @@ type JuiceFSFuseSpec struct {
 	// +optional
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 
+	// SchedulingSpec defines the scheduling constraints of the pods, e.g. tolerations and affinity
+	// +optional
+	SchedulingSpec `json:",inline"`
+
 	// VolumeMounts specifies the volumes listed in ".spec.volumes" to mount into runtime component's filesystem.
 	// +optional
 	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
@@ -165,6 +173,13 @@ type JuiceFSFuseSpec struct {
 	// +optional
 	CleanPolicy FuseCleanPolicy `json:"cleanPolicy,omitempty"`
 
+	// MountUpdateStrategy decides how the fuse pods are rolled when the mount points of the dataset are changed.
+	// OnDelete rolls the fuse pods once they are recreated, and Recreate deletes the running fuse pods immediately.
+	// Defaults to OnDelete
+	// +kubebuilder:validation:Enum=OnDelete;Recreate
+	// +optional
+	MountUpdateStrategy FuseMountUpdateStrategy `json:"mountUpdateStrategy,omitempty"`
+
 	// PodMetadata defines labels and annotations that will be propagated to JuiceFs's pods.
 	// +optional
 	PodMetadata PodMetadata `json:"podMetadata,omitempty"`
@@ -220,3 +235,8 @@ func (j *JuiceFSRuntime) Replicas() int32 {
 func (j *JuiceFSRuntime) GetStatus() *RuntimeStatus {
 	return &j.Status
 }
+
+// GetAutoscalingPolicy gets the autoscaling policy of runtime workers
+func (j *JuiceFSRuntime) GetAutoscalingPolicy() *AutoscalingPolicy {
+	return j.Spec.RuntimeManagement.Autoscaling
+}
@@ -154,6 +154,36 @@ type OperationStatus struct {
 
 	// NodeAffinity records the node affinity for operation pods
 	NodeAffinity *corev1.NodeAffinity `json:"nodeAffinity,omitempty"`
+
+	// Progress records the progress of each target path reported by the operation job
+	// +optional
+	Progress []TargetPathProgress `json:"progress,omitempty"`
+}
+
+// TargetPathProgress describes the progress of a target path in the data operation
+type TargetPathProgress struct {
+	// Path is the target path of the data operation
+	Path string `json:"path"`
+
+	// LoadedBytes is the size of data processed in bytes
+	// +optional
+	LoadedBytes int64 `json:"loadedBytes,omitempty"`
+
+	// TotalBytes is the total size of data under the path in bytes
+	// +optional
+	TotalBytes int64 `json:"totalBytes,omitempty"`
+
+	// LoadedFiles is the number of files processed
+	// +optional
+	LoadedFiles int64 `json:"loadedFiles,omitempty"`
+
+	// TotalFiles is the total number of files under the path
+	// +optional
+	TotalFiles int64 `json:"totalFiles,omitempty"`
+
+	// ETA is the estimated remaining time to finish the path, e.g. 1h30m
+	// +optional
+	ETA string `json:"eta,omitempty"`
 }
 
 type RuntimePhase string
@@ -190,6 +220,8 @@ const (
 	RuntimeFusesScaledIn RuntimeConditionType = "FusesScaledIn"
 	// RuntimeFusesScaledOut means the fuses of runtime just scaled out
 	RuntimeFusesScaledOut RuntimeConditionType = "FusesScaledOut"
+	// RuntimeSpecSynced means the changes of the runtime spec are synced to the components of runtime
+	RuntimeSpecSynced RuntimeConditionType = "SpecSynced"
 )
 
 const (
@@ -205,6 +237,10 @@ const (
 	RuntimeWorkersScaledInReason = "Workers scaled in"
 	// RuntimeWorkersScaledInReason means the workers of runtime just scaled out
 	RuntimeWorkersScaledOutReason = "Workers scaled out"
+	// RuntimeWorkersDrainingReason means the workers of runtime are being drained before scaling in
+	RuntimeWorkersDrainingReason = "Workers draining"
+	// RuntimeWorkersDrainCanceledReason means the draining of the workers is canceled because the runtime is scaled out again
+	RuntimeWorkersDrainCanceledReason = "Workers drain canceled"
 	// RuntimeFusesInitializedReason means the fuses of runtime are initialized
 	RuntimeFusesInitializedReason = "Fuses are initialized"
 	// RuntimeFusesReadyReason means the fuses of runtime are ready
@@ -213,6 +249,8 @@ const (
 	RuntimeFusesScaledInReason = "Fuses scaled in"
 	// RuntimeFusesScaledInReason means the fuses of runtime just scaled out
 	RuntimeFusesScaledOutReason = "Fuses scaled out"
+	// RuntimeSpecSyncedReason means the changes of the runtime spec are synced to the components of runtime
+	RuntimeSpecSyncedReason = "Spec synced"
 )
 
 // Condition describes the state of the cache at a certain point.
@@ -110,6 +110,10 @@ type ThinCompTemplateSpec struct {
 	// +optional
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 
+	// SchedulingSpec defines the scheduling constraints of the pods, e.g. tolerations and affinity
+	// +optional
+	SchedulingSpec `json:",inline"`
+
 	// VolumeMounts specifies the volumes listed in ".spec.volumes" to mount into runtime component's filesystem.
 	// +optional
 	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
@@ -167,6 +171,10 @@ type ThinFuseSpec struct {
 	// +optional
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 
+	// SchedulingSpec defines the scheduling constraints of the pods, e.g. tolerations and affinity
+	// +optional
+	SchedulingSpec `json:",inline"`
+
 	// CleanPolicy decides when to clean thinRuntime Fuse pods.
 	// Currently Fluid supports two policies: OnDemand and OnRuntimeDeleted
 	// OnDemand cleans fuse pod once the fuse pod on some node is not needed
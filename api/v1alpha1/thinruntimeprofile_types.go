@@ -53,6 +53,50 @@ type ThinRuntimeProfileSpec struct {
 	// +kubebuilder:default=MountNodePublishSecretIfExists
 	// +kubebuilder:validation:Enum=NotMountNodePublishSecret;MountNodePublishSecretIfExists;CopyNodePublishSecretAndMountIfNotExists
 	NodePublishSecretPolicy NodePublishSecretPolicy `json:"nodePublishSecretPolicy,omitempty"`
+
+	// DataLoad declares how to prefetch data of the file system. If it is set, DataLoad jobs
+	// are generated with the container template
+	// +optional
+	DataLoad *ThinDataOperationSpec `json:"dataLoad,omitempty"`
+
+	// DataMigrate declares how to migrate data from or to the file system. If it is set,
+	// DataMigrate jobs are generated with the container template
+	// +optional
+	DataMigrate *ThinDataOperationSpec `json:"dataMigrate,omitempty"`
+}
+
+// ThinDataOperationSpec is the container template of a data operation job. The dataset is mounted into the container
+// and the parameters of the data operation are passed through environment variables, e.g. FLUID_DATALOAD_PATHS
+// for DataLoad, FLUID_DATAMIGRATE_FROM and FLUID_DATAMIGRATE_TO for DataMigrate.
+type ThinDataOperationSpec struct {
+	// Image for the data operation container
+	// +required
+	Image string `json:"image"`
+
+	// Image tag for the data operation container
+	// +optional
+	ImageTag string `json:"imageTag,omitempty"`
+
+	// One of the three policies: `Always`, `IfNotPresent`, `Never`
+	// +optional
+	ImagePullPolicy string `json:"imagePullPolicy,omitempty"`
+
+	// Entrypoint array of the data operation container. The image's ENTRYPOINT is used if this is not provided.
+	// +optional
+	Command []string `json:"command,omitempty"`
+
+	// Arguments to the entrypoint.
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// Environment variables that will be used by the data operation container.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// Resources that will be requested by the data operation container, it's overridden by the resources
+	// specified in the data operation.
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
 }
 
 // ThinRuntimeProfileStatus defines the observed state of ThinRuntimeProfile
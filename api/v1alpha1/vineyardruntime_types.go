@@ -21,6 +21,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	VineyardRuntimeKind = "VineyardRuntime"
+)
+
 // VineyardCompTemplateSpec is the common configurations for vineyard components including Master and Worker.
 type VineyardCompTemplateSpec struct {
 	// The replicas of Vineyard component.
@@ -53,6 +57,10 @@ type VineyardCompTemplateSpec struct {
 	// +optional
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 
+	// SchedulingSpec defines the scheduling constraints of the pods, e.g. tolerations and affinity
+	// +optional
+	SchedulingSpec `json:",inline"`
+
 	// Ports used by Vineyard component.
 	// For Master, the default client port is 2379 and peer port is 2380.
 	// For Worker, the default rpc port is 9600 and the default exporter port is 9144.
@@ -187,6 +195,10 @@ type VineyardClientSocketSpec struct {
 	// +optional
 	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
 
+	// SchedulingSpec defines the scheduling constraints of the pods, e.g. tolerations and affinity
+	// +optional
+	SchedulingSpec `json:",inline"`
+
 	// Whether to use hostnetwork or not
 	// Default is HostNetwork
 	// +kubebuilder:validation:Enum=HostNetwork;"";ContainerNetwork
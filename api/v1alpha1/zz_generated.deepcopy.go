@@ -22,6 +22,7 @@ package v1alpha1
 import (
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -107,6 +108,7 @@ func (in *AlluxioCompTemplateSpec) DeepCopyInto(out *AlluxioCompTemplateSpec) {
 			(*out)[key] = val
 		}
 	}
+	in.SchedulingSpec.DeepCopyInto(&out.SchedulingSpec)
 	if in.VolumeMounts != nil {
 		in, out := &in.VolumeMounts, &out.VolumeMounts
 		*out = make([]v1.VolumeMount, len(*in))
@@ -172,6 +174,7 @@ func (in *AlluxioFuseSpec) DeepCopyInto(out *AlluxioFuseSpec) {
 			(*out)[key] = val
 		}
 	}
+	in.SchedulingSpec.DeepCopyInto(&out.SchedulingSpec)
 	if in.VolumeMounts != nil {
 		in, out := &in.VolumeMounts, &out.VolumeMounts
 		*out = make([]v1.VolumeMount, len(*in))
@@ -307,6 +310,41 @@ func (in *AlluxioRuntimeSpec) DeepCopy() *AlluxioRuntimeSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingPolicy) DeepCopyInto(out *AutoscalingPolicy) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetCachedPercentage != nil {
+		in, out := &in.TargetCachedPercentage, &out.TargetCachedPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetCacheUsagePercentage != nil {
+		in, out := &in.TargetCacheUsagePercentage, &out.TargetCacheUsagePercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ScaleInCooldownSeconds != nil {
+		in, out := &in.ScaleInCooldownSeconds, &out.ScaleInCooldownSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingPolicy.
+func (in *AutoscalingPolicy) DeepCopy() *AutoscalingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CacheableNodeAffinity) DeepCopyInto(out *CacheableNodeAffinity) {
 	*out = *in
@@ -488,6 +526,124 @@ func (in *DataBackupSpec) DeepCopy() *DataBackupSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataFree) DeepCopyInto(out *DataFree) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFree.
+func (in *DataFree) DeepCopy() *DataFree {
+	if in == nil {
+		return nil
+	}
+	out := new(DataFree)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataFree) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataFreeList) DeepCopyInto(out *DataFreeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DataFree, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFreeList.
+func (in *DataFreeList) DeepCopy() *DataFreeList {
+	if in == nil {
+		return nil
+	}
+	out := new(DataFreeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataFreeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataFreeSpec) DeepCopyInto(out *DataFreeSpec) {
+	*out = *in
+	out.Dataset = in.Dataset
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.PodMetadata.DeepCopyInto(&out.PodMetadata)
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RunAfter != nil {
+		in, out := &in.RunAfter, &out.RunAfter
+		*out = new(OperationRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFreeSpec.
+func (in *DataFreeSpec) DeepCopy() *DataFreeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DataFreeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataLoad) DeepCopyInto(out *DataLoad) {
 	*out = *in
@@ -930,6 +1086,124 @@ func (in *DatasetList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetQuota) DeepCopyInto(out *DatasetQuota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetQuota.
+func (in *DatasetQuota) DeepCopy() *DatasetQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatasetQuota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetQuotaList) DeepCopyInto(out *DatasetQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DatasetQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetQuotaList.
+func (in *DatasetQuotaList) DeepCopy() *DatasetQuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetQuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatasetQuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetQuotaSpec) DeepCopyInto(out *DatasetQuotaSpec) {
+	*out = *in
+	if in.CacheCapacity != nil {
+		in, out := &in.CacheCapacity, &out.CacheCapacity
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MediumCacheCapacity != nil {
+		in, out := &in.MediumCacheCapacity, &out.MediumCacheCapacity
+		*out = make(map[common.MediumType]resource.Quantity, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetQuotaSpec.
+func (in *DatasetQuotaSpec) DeepCopy() *DatasetQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetQuotaStatus) DeepCopyInto(out *DatasetQuotaStatus) {
+	*out = *in
+	if in.CacheCapacity != nil {
+		in, out := &in.CacheCapacity, &out.CacheCapacity
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MediumCacheCapacity != nil {
+		in, out := &in.MediumCacheCapacity, &out.MediumCacheCapacity
+		*out = make(map[common.MediumType]resource.Quantity, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Datasets != nil {
+		in, out := &in.Datasets, &out.Datasets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetQuotaStatus.
+func (in *DatasetQuotaStatus) DeepCopy() *DatasetQuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetQuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatasetSpec) DeepCopyInto(out *DatasetSpec) {
 	*out = *in
@@ -1095,6 +1369,7 @@ func (in *EFCCompTemplateSpec) DeepCopyInto(out *EFCCompTemplateSpec) {
 			(*out)[key] = val
 		}
 	}
+	in.SchedulingSpec.DeepCopyInto(&out.SchedulingSpec)
 	in.PodMetadata.DeepCopyInto(&out.PodMetadata)
 }
 
@@ -1127,6 +1402,7 @@ func (in *EFCFuseSpec) DeepCopyInto(out *EFCFuseSpec) {
 			(*out)[key] = val
 		}
 	}
+	in.SchedulingSpec.DeepCopyInto(&out.SchedulingSpec)
 	in.PodMetadata.DeepCopyInto(&out.PodMetadata)
 }
 
@@ -1338,6 +1614,7 @@ func (in *GooseFSCompTemplateSpec) DeepCopyInto(out *GooseFSCompTemplateSpec) {
 			(*out)[key] = val
 		}
 	}
+	in.SchedulingSpec.DeepCopyInto(&out.SchedulingSpec)
 	if in.Annotations != nil {
 		in, out := &in.Annotations, &out.Annotations
 		*out = make(map[string]string, len(*in))
@@ -1392,6 +1669,7 @@ func (in *GooseFSFuseSpec) DeepCopyInto(out *GooseFSFuseSpec) {
 			(*out)[key] = val
 		}
 	}
+	in.SchedulingSpec.DeepCopyInto(&out.SchedulingSpec)
 	if in.Annotations != nil {
 		in, out := &in.Annotations, &out.Annotations
 		*out = make(map[string]string, len(*in))
@@ -1501,6 +1779,7 @@ func (in *GooseFSRuntimeSpec) DeepCopyInto(out *GooseFSRuntimeSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.CleanCachePolicy.DeepCopyInto(&out.CleanCachePolicy)
+	in.MetadataSyncPolicy.DeepCopyInto(&out.MetadataSyncPolicy)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GooseFSRuntimeSpec.
@@ -1599,13 +1878,7 @@ func (in *JindoCompTemplateSpec) DeepCopyInto(out *JindoCompTemplateSpec) {
 			(*out)[key] = val
 		}
 	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.SchedulingSpec.DeepCopyInto(&out.SchedulingSpec)
 	if in.Labels != nil {
 		in, out := &in.Labels, &out.Labels
 		*out = make(map[string]string, len(*in))
@@ -1673,13 +1946,7 @@ func (in *JindoFuseSpec) DeepCopyInto(out *JindoFuseSpec) {
 			(*out)[key] = val
 		}
 	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.SchedulingSpec.DeepCopyInto(&out.SchedulingSpec)
 	if in.Labels != nil {
 		in, out := &in.Labels, &out.Labels
 		*out = make(map[string]string, len(*in))
@@ -1803,6 +2070,7 @@ func (in *JindoRuntimeSpec) DeepCopyInto(out *JindoRuntimeSpec) {
 		}
 	}
 	in.CleanCachePolicy.DeepCopyInto(&out.CleanCachePolicy)
+	in.MetadataSyncPolicy.DeepCopyInto(&out.MetadataSyncPolicy)
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
 		*out = make([]v1.Volume, len(*in))
@@ -1877,6 +2145,7 @@ func (in *JuiceFSCompTemplateSpec) DeepCopyInto(out *JuiceFSCompTemplateSpec) {
 			(*out)[key] = val
 		}
 	}
+	in.SchedulingSpec.DeepCopyInto(&out.SchedulingSpec)
 	if in.VolumeMounts != nil {
 		in, out := &in.VolumeMounts, &out.VolumeMounts
 		*out = make([]v1.VolumeMount, len(*in))
@@ -1922,6 +2191,7 @@ func (in *JuiceFSFuseSpec) DeepCopyInto(out *JuiceFSFuseSpec) {
 			(*out)[key] = val
 		}
 	}
+	in.SchedulingSpec.DeepCopyInto(&out.SchedulingSpec)
 	if in.VolumeMounts != nil {
 		in, out := &in.VolumeMounts, &out.VolumeMounts
 		*out = make([]v1.VolumeMount, len(*in))
@@ -2109,6 +2379,11 @@ func (in *MetadataSyncPolicy) DeepCopyInto(out *MetadataSyncPolicy) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataSyncPolicy.
@@ -2226,6 +2501,11 @@ func (in *OperationStatus) DeepCopyInto(out *OperationStatus) {
 		*out = new(v1.NodeAffinity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = make([]TargetPathProgress, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationStatus.
@@ -2360,6 +2640,11 @@ func (in *RuntimeManagement) DeepCopyInto(out *RuntimeManagement) {
 	*out = *in
 	in.CleanCachePolicy.DeepCopyInto(&out.CleanCachePolicy)
 	in.MetadataSyncPolicy.DeepCopyInto(&out.MetadataSyncPolicy)
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeManagement.
@@ -2422,6 +2707,45 @@ func (in *RuntimeStatus) DeepCopy() *RuntimeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingSpec) DeepCopyInto(out *SchedulingSpec) {
+	*out = *in
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingSpec.
+func (in *SchedulingSpec) DeepCopy() *SchedulingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScriptProcessor) DeepCopyInto(out *ScriptProcessor) {
 	*out = *in
@@ -2526,6 +2850,21 @@ func (in *TargetPath) DeepCopy() *TargetPath {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetPathProgress) DeepCopyInto(out *TargetPathProgress) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetPathProgress.
+func (in *TargetPathProgress) DeepCopy() *TargetPathProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetPathProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ThinCompTemplateSpec) DeepCopyInto(out *ThinCompTemplateSpec) {
 	*out = *in
@@ -2554,6 +2893,7 @@ func (in *ThinCompTemplateSpec) DeepCopyInto(out *ThinCompTemplateSpec) {
 			(*out)[key] = val
 		}
 	}
+	in.SchedulingSpec.DeepCopyInto(&out.SchedulingSpec)
 	if in.VolumeMounts != nil {
 		in, out := &in.VolumeMounts, &out.VolumeMounts
 		*out = make([]v1.VolumeMount, len(*in))
@@ -2583,6 +2923,39 @@ func (in *ThinCompTemplateSpec) DeepCopy() *ThinCompTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThinDataOperationSpec) DeepCopyInto(out *ThinDataOperationSpec) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThinDataOperationSpec.
+func (in *ThinDataOperationSpec) DeepCopy() *ThinDataOperationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ThinDataOperationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ThinFuseSpec) DeepCopyInto(out *ThinFuseSpec) {
 	*out = *in
@@ -2628,6 +3001,7 @@ func (in *ThinFuseSpec) DeepCopyInto(out *ThinFuseSpec) {
 			(*out)[key] = val
 		}
 	}
+	in.SchedulingSpec.DeepCopyInto(&out.SchedulingSpec)
 	if in.LivenessProbe != nil {
 		in, out := &in.LivenessProbe, &out.LivenessProbe
 		*out = new(v1.Probe)
@@ -2798,6 +3172,16 @@ func (in *ThinRuntimeProfileSpec) DeepCopyInto(out *ThinRuntimeProfileSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DataLoad != nil {
+		in, out := &in.DataLoad, &out.DataLoad
+		*out = new(ThinDataOperationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataMigrate != nil {
+		in, out := &in.DataMigrate, &out.DataMigrate
+		*out = new(ThinDataOperationSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThinRuntimeProfileSpec.
@@ -2934,6 +3318,7 @@ func (in *VineyardClientSocketSpec) DeepCopyInto(out *VineyardClientSocketSpec)
 		}
 	}
 	in.Resources.DeepCopyInto(&out.Resources)
+	in.SchedulingSpec.DeepCopyInto(&out.SchedulingSpec)
 	in.PodMetadata.DeepCopyInto(&out.PodMetadata)
 	if in.Options != nil {
 		in, out := &in.Options, &out.Options
@@ -2964,6 +3349,7 @@ func (in *VineyardCompTemplateSpec) DeepCopyInto(out *VineyardCompTemplateSpec)
 			(*out)[key] = val
 		}
 	}
+	in.SchedulingSpec.DeepCopyInto(&out.SchedulingSpec)
 	if in.Ports != nil {
 		in, out := &in.Ports, &out.Ports
 		*out = make(map[string]int, len(*in))
@@ -0,0 +1,31 @@
+{{/*
+datamigrate.env is the env of the container running rclone
+*/}}
+{{- define "datamigrate.env" -}}
+- name: FROM
+  value: {{ required "migrateFrom should be set" .Values.datamigrate.migrateFrom | quote }}
+- name: TO
+  value: {{ required "migrateTo should be set" .Values.datamigrate.migrateTo | quote }}
+- name: MODE
+  value: {{ .Values.datamigrate.mode | default "Copy" | quote }}
+{{- if eq (.Values.datamigrate.mode | default "Copy") "Incremental" }}
+# the start time of the last successful migration, which is unset before the first one
+- name: SINCE
+  valueFrom:
+    configMapKeyRef:
+      name: {{ printf "%s-state" .Release.Name }}
+      key: since
+      optional: true
+{{- end }}
+- name: TIMEOUT
+  value: {{ .Values.datamigrate.options.timeout | default "30m" | quote }}
+- name: OPTION
+  value: {{ .Values.datamigrate.options.option | default "" | quote }}
+{{- range .Values.datamigrate.encryptOptions }}
+- name: {{ .name }}
+  valueFrom:
+    secretKeyRef:
+      name: {{ .valueFrom.secretKeyRef.name }}
+      key: {{ .valueFrom.secretKeyRef.key }}
+{{- end }}
+{{- end -}}
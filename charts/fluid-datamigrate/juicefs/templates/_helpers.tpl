@@ -0,0 +1,115 @@
+{{/*
+datamigrate.ssh is true when the parallel tasks are distributed to the workers statefulset by ssh
+*/}}
+{{- define "datamigrate.ssh" -}}
+{{- if and (gt (.Values.datamigrate.parallelism | int) 1) (ne (.Values.datamigrate.parallelOptions.mode | default "ssh") "sharded") -}}
+true
+{{- end -}}
+{{- end -}}
+
+{{/*
+datamigrate.sharded is true when the parallel tasks are the pods of an indexed job, each of which migrates
+the shard of its completion index
+*/}}
+{{- define "datamigrate.sharded" -}}
+{{- if and (gt (.Values.datamigrate.parallelism | int) 1) (eq (.Values.datamigrate.parallelOptions.mode | default "ssh") "sharded") -}}
+true
+{{- end -}}
+{{- end -}}
+
+{{/*
+datamigrate.env is the env of the containers running juicefs sync
+*/}}
+{{- define "datamigrate.env" -}}
+- name: PARALLELISM
+  value: {{ .Values.datamigrate.parallelism | quote }}
+- name: MODE
+  value: {{ .Values.datamigrate.mode | default "Copy" | quote }}
+{{- if eq (.Values.datamigrate.mode | default "Copy") "Incremental" }}
+# the start time of the last successful migration, which is unset before the first one
+- name: SINCE
+  valueFrom:
+    configMapKeyRef:
+      name: {{ printf "%s-state" .Release.Name }}
+      key: since
+      optional: true
+{{- end }}
+{{- if include "datamigrate.ssh" . }}
+- name: POD_IP
+  valueFrom:
+    fieldRef:
+      fieldPath: status.podIP
+- name: SSH_READY_TIMEOUT
+  value: {{ .Values.datamigrate.parallelOptions.sshReadyTimeoutSeconds | quote }}
+- name: TARGET_SSH_PORT
+  value: "{{ .Values.datamigrate.parallelOptions.sshPort }}"
+- name: WORKER_NAME_FORMAT
+  value: {{ printf "%s-workers-{}.%s-workers" .Release.Name .Release.Name }}
+{{- end }}
+{{- if include "datamigrate.sharded" . }}
+- name: PARALLEL_MODE
+  value: sharded
+{{- end }}
+{{- range $key, $val := .Values.datamigrate.options }}
+{{- if eq $key "timeout" }}
+- name: TIMEOUT
+  value: {{ $val | quote }}
+{{- end }}
+{{- if eq $key "edition" }}
+- name: EDITION
+  value: {{ $val | quote }}
+{{- end }}
+{{- if eq $key "option" }}
+- name: OPTION
+  value: {{ $val | quote }}
+{{- end }}
+{{- end }}
+{{- range .Values.datamigrate.encryptOptions }}
+- name: {{ .name }}
+  valueFrom:
+    secretKeyRef:
+      name: {{ .valueFrom.secretKeyRef.name }}
+      key: {{ .valueFrom.secretKeyRef.key }}
+{{- end }}
+{{- end -}}
+
+{{/*
+datamigrate.shards.initContainers are the init containers in sharded mode. The pod of completion index 0 lists
+the source and publishes the shards to the ConfigMap, the others wait for and fetch their shards.
+*/}}
+{{- define "datamigrate.shards.initContainers" -}}
+- name: list-shards
+  image: {{ required "DataMigrate image should be set" .Values.datamigrate.image }}
+  imagePullPolicy: IfNotPresent
+  command: ["/bin/sh", "-c"]
+  args: ["/scripts/juicefs_datamigrate.sh list"]
+  env:
+    {{- include "datamigrate.env" . | nindent 4 }}
+  volumeMounts:
+    - mountPath: /scripts
+      name: data-migrate-script
+    - mountPath: /shards
+      name: data-migrate-shards
+    {{- with .Values.datamigrate.nativeVolumeMounts }}
+    {{- toYaml . | nindent 4 }}
+    {{- end }}
+- name: fetch-shards
+  image: {{ required "coordinatorImage should be set in sharded mode" .Values.datamigrate.parallelOptions.coordinatorImage }}
+  imagePullPolicy: IfNotPresent
+  command: ["/bin/sh", "-c"]
+  args: ["/scripts/shards.sh"]
+  env:
+    - name: SHARDS_CONFIGMAP
+      value: {{ printf "%s-shards" .Release.Name }}
+    - name: SHARDS_READY_TIMEOUT
+      value: {{ .Values.datamigrate.parallelOptions.shardsReadyTimeoutSeconds | default 600 | quote }}
+    - name: JOB_UID
+      valueFrom:
+        fieldRef:
+          fieldPath: metadata.labels['controller-uid']
+  volumeMounts:
+    - mountPath: /scripts
+      name: data-migrate-script
+    - mountPath: /shards
+      name: data-migrate-shards
+{{- end -}}
@@ -128,6 +128,14 @@ affinity:
 {{- end -}}
 {{- end -}}
 
+{{- define "fluid.helmEngine" -}}
+{{- if or (eq .Values.helmEngine "ddc-helm") (eq .Values.helmEngine "native") -}}
+{{ .Values.helmEngine | quote }}
+{{- else -}}
+{{ fail "helmEngine must be either ddc-helm or native" }}
+{{- end -}}
+{{- end -}}
+
 {{- define "fluid.helmDriver.rbacs" -}}
 {{- if eq .Values.helmDriver "secret" }}
   - apiGroups:
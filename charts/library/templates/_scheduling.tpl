@@ -0,0 +1,25 @@
+{{/*
+Scheduling constraints of the pods of a runtime component, which override the ones set by the runtime chart.
+The tolerations are not rendered because they are appended to the tolerations of the runtime chart.
+Usage: {{- include "library.fluid.scheduling" (.Values.master.scheduling | default dict) | nindent 6 }}
+*/}}
+{{- define "library.fluid.scheduling" -}}
+{{- with .schedulerName }}
+schedulerName: {{ . }}
+{{- end }}
+{{- with .priorityClassName }}
+priorityClassName: {{ . }}
+{{- end }}
+{{- with .affinity }}
+affinity:
+{{- toYaml . | nindent 2 }}
+{{- end }}
+{{- with .securityContext }}
+securityContext:
+{{- toYaml . | nindent 2 }}
+{{- end }}
+{{- with .topologySpreadConstraints }}
+topologySpreadConstraints:
+{{- toYaml . | nindent 2 }}
+{{- end }}
+{{- end }}
@@ -23,7 +23,7 @@ func NewAlluxioFSCommand() *cobra.Command {
 		Use:   "alluxioruntime-controller",
 		Short: "Controller for alluxioruntime",
 	}
-	cmd.AddCommand(versionCmd, alluxioCmd)
+	cmd.AddCommand(versionCmd, alluxioCmd, renderCmd)
 
 	return cmd
 }
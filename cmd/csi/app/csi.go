@@ -48,6 +48,7 @@ var (
 	pruneFs               []string
 	prunePath             string
 	kubeletKubeConfigPath string
+	controllerOnly        bool
 )
 
 var scheme = runtime.NewScheme()
@@ -85,6 +86,7 @@ func init() {
 	startCmd.Flags().StringVarP(&metricsAddr, "metrics-addr", "", ":8080", "The address the metrics endpoint binds to.")
 	startCmd.Flags().StringVarP(&pprofAddr, "pprof-addr", "", "", "The address for pprof to use while exporting profiling results")
 	startCmd.Flags().StringVarP(&kubeletKubeConfigPath, "kubelet-kube-config", "", "/etc/kubernetes/kubelet.conf", "The file path to kubelet kube config")
+	startCmd.Flags().BoolVarP(&controllerOnly, "controller-only", "", false, "Only serve the CSI controller service for dynamic provisioning, e.g. with the external-provisioner")
 	utilfeature.DefaultMutableFeatureGate.AddFlag(startCmd.Flags())
 	startCmd.Flags().AddGoFlagSet(flag.CommandLine)
 }
@@ -121,6 +123,7 @@ func handle() {
 			PruneFs:           pruneFs,
 			PrunePath:         prunePath,
 			KubeletConfigPath: kubeletKubeConfigPath,
+			ControllerOnly:    controllerOnly,
 		},
 		VolumeLocks: utils.NewVolumeLocks(),
 	}
@@ -17,6 +17,7 @@ limitations under the License.
 package app
 
 import (
+	"fmt"
 	"os"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"time"
@@ -42,12 +43,15 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/controllers"
 	databackupctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/databackup"
 	dataflowctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/dataflow"
+	datafreectl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/datafree"
 	dataloadctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/dataload"
 	datamigratectl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/datamigrate"
 	dataprocessctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/dataprocess"
 	datasetctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/dataset"
+	datasetquotactl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/datasetquota"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/alluxio"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/ufschange"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/compatibility"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/discovery"
@@ -65,6 +69,7 @@ var (
 	development             bool
 	pprofAddr               string
 	maxConcurrentReconciles int
+	ufsEventWebhookAddr     string
 
 	kubeClientQPS   float32
 	kubeClientBurst int
@@ -96,6 +101,7 @@ func init() {
 	datasetCmd.Flags().BoolVarP(&development, "development", "", true, "Enable development mode for fluid controller.")
 	datasetCmd.Flags().StringVarP(&pprofAddr, "pprof-addr", "", "", "The address for pprof to use while exporting profiling results")
 	datasetCmd.Flags().IntVar(&maxConcurrentReconciles, "reconcile-workers", 3, "Set the number of max concurrent workers for reconciling dataset and dataset operations")
+	datasetCmd.Flags().StringVar(&ufsEventWebhookAddr, "ufs-event-webhook-addr", "", "The address the webhook receiving S3 event notifications to re-trigger OnEvent DataLoads binds to, disabled if empty")
 	datasetCmd.Flags().Float32VarP(&kubeClientQPS, "kube-api-qps", "", 20, "QPS to use while talking with kubernetes apiserver.")   // 20 is the default qps in controller-runtime
 	datasetCmd.Flags().IntVarP(&kubeClientBurst, "kube-api-burst", "", 30, "Burst to use while talking with kubernetes apiserver.") // 30 is the default burst in controller-runtime
 	datasetCmd.Flags().StringVar(&controllerWorkqueueDefaultSyncBackoffStr, "workqueue-default-sync-backoff", "5ms", "base backoff period for failed reconciliation in controller's workqueue")
@@ -179,6 +185,24 @@ func handle() {
 			setupLog.Error(err, "unable to create controller", "controller", "DataLoad")
 			os.Exit(1)
 		}
+
+		if len(ufsEventWebhookAddr) > 0 {
+			token := os.Getenv(common.EnvUFSEventWebhookToken)
+			if len(token) == 0 {
+				setupLog.Error(fmt.Errorf("env %s is not set", common.EnvUFSEventWebhookToken), "the ufs event webhook requires a token")
+				os.Exit(1)
+			}
+
+			setupLog.Info("Registering ufs change sources to Fluid controller manager.")
+			if err = mgr.Add(ufschange.NewManager(
+				dataloadctl.NewUFSChangeHandler(mgr.GetClient(), ctrl.Log.WithName("dataloadctl").WithName("UFSChange")),
+				ctrl.Log.WithName("ufschange"),
+				ufschange.NewS3WebhookSource(ufsEventWebhookAddr, token, mgr.GetClient(), ctrl.Log.WithName("ufschange").WithName("S3Webhook")),
+			)); err != nil {
+				setupLog.Error(err, "unable to add ufs change sources")
+				os.Exit(1)
+			}
+		}
 	}
 
 	if fluidDiscovery.ResourceEnabled("databackup") {
@@ -217,6 +241,29 @@ func handle() {
 		}
 	}
 
+	if fluidDiscovery.ResourceEnabled("datafree") {
+		setupLog.Info("Registering DataFree reconciler to Fluid controller manager.")
+		if err = (datafreectl.NewDataFreeReconciler(mgr.GetClient(),
+			ctrl.Log.WithName("datafreectl").WithName("DataFree"),
+			mgr.GetScheme(),
+			mgr.GetEventRecorderFor("DataFree"),
+		)).SetupWithManager(mgr, controllerOptions); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "DataFree")
+			os.Exit(1)
+		}
+	}
+
+	if fluidDiscovery.ResourceEnabled("datasetquota") {
+		setupLog.Info("Registering DatasetQuota reconciler to Fluid controller manager.")
+		if err = (datasetquotactl.NewDatasetQuotaReconciler(mgr.GetClient(),
+			ctrl.Log.WithName("datasetquotactl").WithName("DatasetQuota"),
+			time.Duration(30*time.Second),
+		)).SetupWithManager(mgr, controllerOptions); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "DatasetQuota")
+			os.Exit(1)
+		}
+	}
+
 	if dataflowctl.DataFlowEnabled() {
 		setupLog.Info("Registering DataFlow reconciler to Fluid controller manager.")
 		if err = (dataflowctl.NewDataFlowReconciler(mgr.GetClient(),
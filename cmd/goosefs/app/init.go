@@ -24,6 +24,6 @@ func NewGooseFSCommand() *cobra.Command {
 		Short: "Controller for goosefsruntime",
 	}
 
-	cmd.AddCommand(versionCmd, startCmd)
+	cmd.AddCommand(versionCmd, startCmd, renderCmd)
 	return cmd
 }
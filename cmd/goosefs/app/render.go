@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/dryrun"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/goosefs"
+)
+
+var (
+	_ base.RuntimeValueGenerator = (*goosefs.GooseFSEngine)(nil)
+
+	renderOptions = dryrun.Options{
+		Runtime:          &datav1alpha1.GooseFSRuntime{},
+		RuntimeType:      common.GooseFSRuntime,
+		EngineImpl:       common.GooseFSEngineImpl,
+		GetReservedPorts: goosefs.GetReservedPorts,
+	}
+)
+
+var renderCmd = dryrun.NewRenderCommand(&renderOptions)
+
+func init() {
+	renderCmd.Flags().StringVar(&renderOptions.PortRange, "runtime-node-port-range", "20000-25000", "Set available port range for GooseFS")
+}
@@ -24,7 +24,7 @@ func NewJindoFSCommand() *cobra.Command {
 		Short: "Controller for jindoruntime",
 	}
 
-	command.AddCommand(versionCmd, jindoCmd)
+	command.AddCommand(versionCmd, jindoCmd, renderCmd)
 
 	return command
 }
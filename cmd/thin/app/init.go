@@ -25,5 +25,6 @@ func NewThinControllerCommand() *cobra.Command {
 	}
 	cmd.AddCommand(startCmd)
 	cmd.AddCommand(versionCmd)
+	cmd.AddCommand(renderCmd)
 	return cmd
 }
@@ -22,5 +22,6 @@ func NewVineyardControllerCommand() *cobra.Command {
 	}
 	cmd.AddCommand(startCmd)
 	cmd.AddCommand(versionCmd)
+	cmd.AddCommand(renderCmd)
 	return cmd
 }
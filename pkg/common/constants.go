@@ -32,6 +32,8 @@ const (
 
 	RuntimeScaleInFailed = "RuntimeScaleInFailed"
 
+	RuntimeAutoscaled = "RuntimeAutoscaled"
+
 	Succeed = "Succeed"
 
 	FuseRecoverFailed = "FuseRecoverFailed"
@@ -40,9 +42,17 @@ const (
 
 	FuseUmountDuplicate = "UnmountDuplicateMountpoint"
 
+	FuseDisconnected = "FuseDisconnected"
+
+	FuseRestarted = "FuseRestarted"
+
+	FuseRestartFailed = "FuseRestartFailed"
+
 	RuntimeDeprecated = "RuntimeDeprecated"
 
 	RuntimeWithSecretNotSupported = "RuntimeWithSecretNotSupported"
+
+	RuntimeMountsUpdateNotSupported = "RuntimeMountsUpdateNotSupported"
 )
 
 // Events related to all type of Data Operations
@@ -61,11 +71,19 @@ const (
 
 	DataOperationSucceed = "DataOperationSucceed"
 
+	DataOperationRetriggered = "DataOperationRetriggered"
+
 	DataOperationNotValid = "DataOperationNotValid"
 
 	DataOperationCollision = "DataOperationCollision"
 
 	TargetSSHSecretNameNotSet = "TargetSSHSecretNameNotSet"
+
+	CoordinatorImageNotSet = "CoordinatorImageNotSet"
+
+	ParallelModeNotSupported = "ParallelModeNotSupported"
+
+	PolicyNotSupported = "PolicyNotSupported"
 )
 
 // Events related to dataflow
@@ -201,6 +219,13 @@ const (
 	InjectServerfulFuse = "fuse" + injectServerful
 
 	InjectFuseSidecar = "fuse" + injectSidecar // [Deprecated] fuse.sidecar.fluid.io/inject
+
+	InjectWorkload     = "workload" + inject      // workload.fluid.io/inject
+	InjectWorkloadDone = "done." + InjectWorkload // done.workload.fluid.io/inject
+
+	// WorkloadOriginalTemplate is a pod template annotation records the pod template before it's mutated by the
+	// workload injection, so that the injection can be redone when the dataset volumes of the workload change.
+	WorkloadOriginalTemplate = "workload.fluid.io/original-template"
 )
 
 const (
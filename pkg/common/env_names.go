@@ -30,8 +30,22 @@ const (
 	EnvRuntimeInfoCacheTTL = "RUNTIMEINFO_CACHE_TTL"
 
 	EnvScheduleInfoExcludeNodeSelector = "FLUID_SCHEDULE_INFO_EXCLUDE_NODE_SELECTOR"
+
+	EnvUFSChangePollInterval = "FLUID_UFS_CHANGE_POLL_INTERVAL"
+
+	EnvUFSEventWebhookToken = "FLUID_UFS_EVENT_WEBHOOK_TOKEN"
+
+	EnvWorkerDrainTimeout = "FLUID_WORKER_DRAIN_TIMEOUT"
+
+	EnvDataLoadDuCacheTTL = "FLUID_DATALOAD_DU_CACHE_TTL"
 )
 
 const (
 	EnvFuseSidecarInjectionMode = "FUSE_SIDECAR_INJECTION_MODE"
 )
+
+const (
+	// EnvHelmEngine chooses the engine to manage the helm releases, "native" renders the charts in process,
+	// otherwise the releases are managed by the ddc-helm binary.
+	EnvHelmEngine = "FLUID_HELM_ENGINE"
+)
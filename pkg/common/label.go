@@ -50,6 +50,10 @@ const (
 	// i.e. fluid.io/managed-by
 	LabelAnnotationManagedBy = LabelAnnotationPrefix + "managed-by"
 
+	// LabelAnnotationProvisionedBy indicates a dataset and its runtime that are dynamically provisioned by the csi controller
+	// i.e. fluid.io/provisioned-by
+	LabelAnnotationProvisionedBy = LabelAnnotationPrefix + "provisioned-by"
+
 	// LabelAnnotationCopyFrom indicates a resource that is copied from another resource
 	// i.e. fluid.io/copied-from
 	LabelAnnotationCopyFrom = LabelAnnotationPrefix + "copied-from"
@@ -86,6 +90,21 @@ const (
 
 	// i.e. fuse.runtime.fluid.io/generation
 	LabelRuntimeFuseGeneration = "fuse.runtime." + LabelAnnotationPrefix + "generation"
+
+	// AnnotationWorkerDraining is a worker pod annotation indicates the worker is being drained before scaling in,
+	// the node of the worker is not regarded as a cache node of the runtime any more.
+	// i.e. runtime.fluid.io/worker-draining
+	AnnotationWorkerDraining = "runtime." + LabelAnnotationPrefix + "worker-draining"
+
+	// AnnotationWorkerDrainMinReplication is a worker pod annotation records the previous minimum replication of the
+	// files replicated off the draining workers, for internal use.
+	// i.e. runtime.fluid.io/worker-drain-min-replication
+	AnnotationWorkerDrainMinReplication = "runtime." + LabelAnnotationPrefix + "worker-drain-min-replication"
+
+	// AnnotationReplicateCacheOnScaleIn is a runtime annotation indicates if the cache on the draining workers should be
+	// replicated to the remaining workers before scaling in, only Alluxio and GooseFS support it.
+	// i.e. runtime.fluid.io/replicate-cache-on-scale-in
+	AnnotationReplicateCacheOnScaleIn = "runtime." + LabelAnnotationPrefix + "replicate-cache-on-scale-in"
 )
 
 const (
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Scheduling is the scheduling constraints of the pods of a runtime component in the helm values
+type Scheduling struct {
+	Tolerations               []corev1.Toleration               `json:"tolerations,omitempty" yaml:"tolerations,omitempty"`
+	Affinity                  *corev1.Affinity                  `json:"affinity,omitempty" yaml:"affinity,omitempty"`
+	PriorityClassName         string                            `json:"priorityClassName,omitempty" yaml:"priorityClassName,omitempty"`
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty" yaml:"topologySpreadConstraints,omitempty"`
+	SchedulerName             string                            `json:"schedulerName,omitempty" yaml:"schedulerName,omitempty"`
+	SecurityContext           *corev1.PodSecurityContext        `json:"securityContext,omitempty" yaml:"securityContext,omitempty"`
+}
+
+// MarshalYAML marshals the scheduling by the json tags of the core types, which are ignored by gopkg.in/yaml.v2
+// used by some runtimes to generate the helm values.
+func (s Scheduling) MarshalYAML() (interface{}, error) {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	var out map[string]interface{}
+	err = json.Unmarshal(data, &out)
+	return out, err
+}
+
+// UnmarshalYAML unmarshals the scheduling marshaled by MarshalYAML
+func (s *Scheduling) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var in interface{}
+	if err := unmarshal(&in); err != nil {
+		return err
+	}
+	data, err := json.Marshal(convertYAMLMaps(in))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, s)
+}
+
+// convertYAMLMaps converts the map[interface{}]interface{} decoded by gopkg.in/yaml.v2 into map[string]interface{}
+// so that it can be marshaled by encoding/json.
+func convertYAMLMaps(in interface{}) interface{} {
+	switch v := in.(type) {
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			out[fmt.Sprint(key)] = convertYAMLMaps(value)
+		}
+		return out
+	case []interface{}:
+		for i, value := range v {
+			v[i] = convertYAMLMaps(value)
+		}
+		return v
+	default:
+		return in
+	}
+}
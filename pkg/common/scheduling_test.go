@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestSchedulingYAML(t *testing.T) {
+	seconds := int64(30)
+	nonRoot := true
+	type value struct {
+		Master struct {
+			Scheduling *Scheduling `yaml:"scheduling,omitempty"`
+		} `yaml:"master"`
+	}
+	in := value{}
+	in.Master.Scheduling = &Scheduling{
+		Tolerations: []corev1.Toleration{{
+			Key:               "nvidia.com/gpu",
+			Operator:          corev1.TolerationOpExists,
+			Effect:            corev1.TaintEffectNoExecute,
+			TolerationSeconds: &seconds,
+		}},
+		PriorityClassName: "high-priority",
+		SecurityContext:   &corev1.PodSecurityContext{RunAsNonRoot: &nonRoot},
+	}
+
+	data, err := yaml.Marshal(in)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+	for _, want := range []string{"tolerationSeconds: 30", "priorityClassName: high-priority", "runAsNonRoot: true"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("yaml.Marshal() = %s, want to contain %q", data, want)
+		}
+	}
+
+	out := value{}
+	if err = yaml.Unmarshal(data, &out); err != nil {
+		t.Fatalf("yaml.Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(in, out) {
+		t.Errorf("yaml.Unmarshal() = %v, want %v", out, in)
+	}
+
+	data, err = yaml.Marshal(value{})
+	if err != nil || strings.Contains(string(data), "scheduling") {
+		t.Errorf("yaml.Marshal() = %s, %v, want the empty scheduling omitted", data, err)
+	}
+}
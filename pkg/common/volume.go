@@ -36,6 +36,43 @@ const (
 	NodePublishMethodSymlink = "symlink"
 )
 
+// Attributes of CSI ephemeral inline volumes
+const (
+	// VolumeAttrEphemeral and VolumeAttrPodNamespace are passed by kubelet because podInfoOnMount is enabled in the CSIDriver
+	VolumeAttrEphemeral = "csi.storage.k8s.io/ephemeral"
+
+	VolumeAttrPodNamespace = "csi.storage.k8s.io/pod.namespace"
+
+	// VolumeAttrDataset is the dataset to mount, in the format of <name> or <namespace>/<name>
+	VolumeAttrDataset = "dataset"
+
+	// VolumeAttrSubPath is the sub path in the dataset to mount
+	VolumeAttrSubPath = "subPath"
+
+	// VolumeAttrReadOnly mounts the dataset read-only if set to "true"
+	VolumeAttrReadOnly = "readOnly"
+)
+
+// Parameters of the StorageClass used to dynamically provision Fluid volumes
+const (
+	// VolumeParamRuntimeType is the type of the runtime to create, e.g. thin, alluxio, juicefs
+	VolumeParamRuntimeType = "runtimeType"
+
+	// VolumeParamProfileName is the name of the ThinRuntimeProfile used by the thin runtime
+	VolumeParamProfileName = "profileName"
+
+	// VolumeParamMountPoint is the URI of the under file system mounted by the dataset
+	VolumeParamMountPoint = "mountPoint"
+
+	// VolumeParamCacheCapacity is the cache capacity of the runtime, e.g. 10Gi
+	VolumeParamCacheCapacity = "cacheCapacity"
+
+	// VolumeParamPVCName and VolumeParamPVCNamespace are passed by the external-provisioner with --extra-create-metadata
+	VolumeParamPVCName = "csi.storage.k8s.io/pvc/name"
+
+	VolumeParamPVCNamespace = "csi.storage.k8s.io/pvc/namespace"
+)
+
 var (
 	FluidStorageClass = Fluid
 
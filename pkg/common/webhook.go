@@ -25,10 +25,15 @@ const (
 	WebhookName            = "fluid-pod-admission-webhook"
 	WebhookServiceName     = "fluid-pod-admission-webhook"
 	WebhookSchedulePodPath = "mutate-fluid-io-v1alpha1-schedulepod"
+	WebhookWorkloadPath    = "mutate-fluid-io-v1alpha1-workload"
+
+	WebhookValidateResourcesPath = "validate-fluid-io-v1alpha1-resources"
 
 	CertSecretName = "fluid-webhook-certs"
 
 	WebhookPluginFilePath = "/etc/fluid/plugins.profile"
+
+	WebhookWorkloadsFilePath = "/etc/fluid/workloads.profile"
 )
 
 // AdmissionHandler wrappers admission.Handler, but adding client-go capablities
@@ -36,6 +36,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/metrics"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
 	jindoutils "github.com/fluid-cloudnative/fluid/pkg/utils/jindo"
@@ -101,6 +102,10 @@ func (o *OperationReconciler) ReconcileDeletion(ctx dataoperation.ReconcileReque
 	}
 
 	object := implement.GetOperationObject()
+	if implement.GetOperationType() == dataoperation.DataLoadType {
+		metrics.GetOrCreateDataLoadMetrics(object.GetNamespace(), object.GetName()).Forget()
+	}
+
 	// 4. remove finalizer
 	if !object.GetDeletionTimestamp().IsZero() {
 		objectMeta, err := utils.GetObjectMeta(object)
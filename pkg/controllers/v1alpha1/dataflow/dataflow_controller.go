@@ -56,6 +56,7 @@ func NewDataFlowReconciler(client client.Client,
 
 var reconcileKinds = map[string]client.Object{
 	"databackup":  &datav1alpha1.DataBackup{},
+	"datafree":    &datav1alpha1.DataFree{},
 	"dataload":    &datav1alpha1.DataLoad{},
 	"datamigrate": &datav1alpha1.DataMigrate{},
 	"dataprocess": &datav1alpha1.DataProcess{},
@@ -103,6 +104,7 @@ var reconcileFuncs = []func(reconcileRequestContext) (bool, error){
 	reconcileDataMigrate,
 	reconcileDataProcess,
 	reconcileDataBackup,
+	reconcileDataFree,
 }
 
 func (r *DataFlowReconciler) Reconcile(context context.Context, req ctrl.Request) (ctrl.Result, error) {
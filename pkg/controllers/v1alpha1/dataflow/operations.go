@@ -92,6 +92,37 @@ func reconcileDataBackup(ctx reconcileRequestContext) (needRequeue bool, err err
 	return reconcileOperationDataFlow(ctx, dataBackup, dataBackup.Spec.RunAfter, dataBackup.Status, updateStatusFn)
 }
 
+func reconcileDataFree(ctx reconcileRequestContext) (needRequeue bool, err error) {
+	dataFree, err := utils.GetDataFree(ctx.Client, ctx.Name, ctx.Namespace)
+	if err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			ctx.Log.V(1).Info("DataFree not found, skip reconciling")
+			return false, nil
+		}
+		return true, errors.Wrap(err, "failed to get datafree")
+	}
+
+	updateStatusFn := func() error {
+		tmp, err := utils.GetDataFree(ctx.Client, ctx.Name, ctx.Namespace)
+		if err != nil {
+			if utils.IgnoreNotFound(err) == nil {
+				return nil
+			}
+			return err
+		}
+
+		toUpdate := tmp.DeepCopy()
+		toUpdate.Status.WaitingFor.OperationComplete = ptr.To(false)
+		if !reflect.DeepEqual(toUpdate.Status, tmp.Status) {
+			return ctx.Client.Status().Update(context.TODO(), toUpdate)
+		}
+
+		return nil
+	}
+
+	return reconcileOperationDataFlow(ctx, dataFree, dataFree.Spec.RunAfter, dataFree.Status, updateStatusFn)
+}
+
 func reconcileDataMigrate(ctx reconcileRequestContext) (needRequeue bool, err error) {
 	dataMigrate, err := utils.GetDataMigrate(ctx.Client, ctx.Name, ctx.Namespace)
 	if err != nil {
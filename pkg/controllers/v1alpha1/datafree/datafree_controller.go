@@ -0,0 +1,134 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datafree
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fluid-cloudnative/fluid/pkg/utils/compatibility"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/controllers"
+	cdatafree "github.com/fluid-cloudnative/fluid/pkg/datafree"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+const controllerName string = "DataFreeReconciler"
+
+// DataFreeReconciler reconciles a DataFree object
+type DataFreeReconciler struct {
+	Scheme *runtime.Scheme
+	*controllers.OperationReconciler
+}
+
+var _ dataoperation.OperationInterfaceBuilder = &DataFreeReconciler{}
+
+// NewDataFreeReconciler returns a DataFreeReconciler
+func NewDataFreeReconciler(client client.Client,
+	log logr.Logger,
+	scheme *runtime.Scheme,
+	recorder record.EventRecorder) *DataFreeReconciler {
+	r := &DataFreeReconciler{
+		Scheme: scheme,
+	}
+	r.OperationReconciler = controllers.NewDataOperationReconciler(r, client, log, recorder)
+	return r
+}
+
+func (r *DataFreeReconciler) Build(object client.Object) (dataoperation.OperationInterface, error) {
+	dataFree, ok := object.(*datav1alpha1.DataFree)
+	if !ok {
+		return nil, fmt.Errorf("object %v is not a DataFree", object)
+	}
+
+	return &dataFreeOperation{
+		Client:   r.Client,
+		Log:      r.Log,
+		Recorder: r.Recorder,
+		dataFree: dataFree,
+	}, nil
+}
+
+// +kubebuilder:rbac:groups=data.fluid.io,resources=datafrees,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=data.fluid.io,resources=datafrees/status,verbs=get;update;patch
+// Reconcile reconciles the DataFree object
+func (r *DataFreeReconciler) Reconcile(context context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx := dataoperation.ReconcileRequestContext{
+		// used for create engine
+		ReconcileRequestContext: cruntime.ReconcileRequestContext{
+			Context:  context,
+			Log:      r.Log.WithValues("DataFree", req.NamespacedName),
+			Recorder: r.Recorder,
+			Client:   r.Client,
+			Category: common.AccelerateCategory,
+		},
+		DataOpFinalizerName: cdatafree.DataFreeFinalizer,
+	}
+
+	// 1. Get DataFree object
+	dataFree, err := utils.GetDataFree(r.Client, req.Name, req.Namespace)
+	if err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			ctx.Log.Info("DataFree not found")
+			return utils.NoRequeue()
+		} else {
+			ctx.Log.Error(err, "failed to get DataFree")
+			return utils.RequeueIfError(errors.Wrap(err, "failed to get DataFree info"))
+		}
+	}
+	ctx.DataObject = dataFree
+	ctx.OpStatus = &dataFree.Status
+
+	return r.ReconcileInternal(ctx)
+}
+
+// SetupWithManager sets up the controller with the given controller manager
+func (r *DataFreeReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	if compatibility.IsBatchV1CronJobSupported() {
+		return ctrl.NewControllerManagedBy(mgr).
+			WithOptions(options).
+			For(&datav1alpha1.DataFree{}).
+			Owns(&batchv1.CronJob{}).
+			Complete(r)
+	} else {
+		ctrl.Log.Info("batch/v1 cronjobs cannnot be found in cluster, fallback to watch batch/v1beta1 cronjobs for compatibility")
+		return ctrl.NewControllerManagedBy(mgr).
+			WithOptions(options).
+			For(&datav1alpha1.DataFree{}).
+			Owns(&batchv1beta1.CronJob{}).
+			Complete(r)
+	}
+}
+
+func (r *DataFreeReconciler) ControllerName() string {
+	return controllerName
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datafree
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	"k8s.io/client-go/tools/record"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdatafree "github.com/fluid-cloudnative/fluid/pkg/datafree"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+type dataFreeOperation struct {
+	client.Client
+	Log      logr.Logger
+	Recorder record.EventRecorder
+
+	dataFree *datav1alpha1.DataFree
+}
+
+var _ dataoperation.OperationInterface = &dataFreeOperation{}
+
+func (r *dataFreeOperation) GetOperationObject() client.Object {
+	return r.dataFree
+}
+
+func (r *dataFreeOperation) HasPrecedingOperation() bool {
+	return r.dataFree.Spec.RunAfter != nil
+}
+
+func (r *dataFreeOperation) GetPossibleTargetDatasetNamespacedNames() []types.NamespacedName {
+	return []types.NamespacedName{
+		{Namespace: r.dataFree.Spec.Dataset.Namespace, Name: r.dataFree.Spec.Dataset.Name},
+	}
+}
+
+func (r *dataFreeOperation) GetTargetDataset() (*datav1alpha1.Dataset, error) {
+	return utils.GetDataset(r.Client, r.dataFree.Spec.Dataset.Name, r.dataFree.Spec.Dataset.Namespace)
+}
+
+func (r *dataFreeOperation) GetReleaseNameSpacedName() types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: r.dataFree.GetNamespace(),
+		Name:      utils.GetDataFreeReleaseName(r.dataFree.GetName()),
+	}
+}
+
+func (r *dataFreeOperation) GetChartsDirectory() string {
+	return utils.GetChartsDirectory() + "/" + cdatafree.DataFreeChart
+}
+
+func (r *dataFreeOperation) GetOperationType() dataoperation.OperationType {
+	return dataoperation.DataFreeType
+}
+
+func (r *dataFreeOperation) UpdateOperationApiStatus(opStatus *datav1alpha1.OperationStatus) error {
+	var dataFreeCpy = r.dataFree.DeepCopy()
+	dataFreeCpy.Status = *opStatus.DeepCopy()
+	return r.Status().Update(context.Background(), dataFreeCpy)
+}
+
+func (r *dataFreeOperation) Validate(ctx cruntime.ReconcileRequestContext) ([]datav1alpha1.Condition, error) {
+	dataFree := r.dataFree
+
+	// 1. Check the policy, no event triggers DataFree so that OnEvent is not supported
+	if dataFree.Spec.Policy != "" && dataFree.Spec.Policy != datav1alpha1.Once && dataFree.Spec.Policy != datav1alpha1.Cron {
+		err := fmt.Errorf("dataFree(%s) sets unsupported policy %s", dataFree.Name, dataFree.Spec.Policy)
+		return []datav1alpha1.Condition{
+			{
+				Type:               common.Failed,
+				Status:             v1.ConditionTrue,
+				Reason:             common.PolicyNotSupported,
+				Message:            fmt.Sprintf("the policy of dataFree must be %s or %s", datav1alpha1.Once, datav1alpha1.Cron),
+				LastProbeTime:      metav1.NewTime(time.Now()),
+				LastTransitionTime: metav1.NewTime(time.Now()),
+			},
+		}, err
+	}
+
+	// 2. Check dataFree namespace and dataset namespace need to be same
+	if dataFree.Namespace != dataFree.Spec.Dataset.Namespace {
+		r.Recorder.Eventf(dataFree,
+			v1.EventTypeWarning,
+			common.TargetDatasetNamespaceNotSame,
+			"dataFree(%s) namespace is not same as dataset",
+			dataFree.Name)
+		err := fmt.Errorf("dataFree(%s) namespace is not same as dataset", dataFree.Name)
+
+		return []datav1alpha1.Condition{
+			{
+				Type:               common.Failed,
+				Status:             v1.ConditionTrue,
+				Reason:             common.TargetDatasetNamespaceNotSame,
+				Message:            "dataFree namespace is not same as dataset",
+				LastProbeTime:      metav1.NewTime(time.Now()),
+				LastTransitionTime: metav1.NewTime(time.Now()),
+			},
+		}, err
+	}
+	return nil, nil
+}
+
+func (r *dataFreeOperation) UpdateStatusInfoForCompleted(infos map[string]string) error {
+	// DataFree does not need to update OperationStatus's Infos field.
+	return nil
+}
+
+func (r *dataFreeOperation) SetTargetDatasetStatusInProgress(dataset *datav1alpha1.Dataset) {
+	// DataFree does not need to update Dataset other field except for DataOperationRef.
+}
+
+func (r *dataFreeOperation) RemoveTargetDatasetStatusInProgress(dataset *datav1alpha1.Dataset) {
+	// DataFree does not need to update Dataset other field except for DataOperationRef.
+}
+
+func (r *dataFreeOperation) GetStatusHandler() dataoperation.StatusHandler {
+	policy := r.dataFree.Spec.Policy
+
+	switch policy {
+	case datav1alpha1.Once:
+		return &OnceStatusHandler{Client: r.Client, dataFree: r.dataFree}
+	case datav1alpha1.Cron:
+		return &CronStatusHandler{Client: r.Client, dataFree: r.dataFree}
+	default:
+		return nil
+	}
+}
+
+// GetTTL implements dataoperation.OperationInterface.
+func (r *dataFreeOperation) GetTTL() (ttl *int32, err error) {
+	dataFree := r.dataFree
+
+	policy := dataFree.Spec.Policy
+	switch policy {
+	case datav1alpha1.Once:
+		ttl = dataFree.Spec.TTLSecondsAfterFinished
+	case datav1alpha1.Cron:
+		// For Cron policy, no TTL is provided
+		ttl = nil
+	default:
+		err = fmt.Errorf("unknown policy type: %s", policy)
+	}
+
+	return
+}
+
+func (r *dataFreeOperation) GetParallelTaskNumber() int32 {
+	return 1
+}
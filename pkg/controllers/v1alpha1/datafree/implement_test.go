@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datafree
+
+import (
+	"testing"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+)
+
+func TestDataFreeOperation_Validate(t *testing.T) {
+	newDataFree := func(policy datav1alpha1.Policy, datasetNamespace string) *datav1alpha1.DataFree {
+		return &datav1alpha1.DataFree{
+			ObjectMeta: v1.ObjectMeta{Name: "test-datafree", Namespace: "default"},
+			Spec: datav1alpha1.DataFreeSpec{
+				Dataset: datav1alpha1.TargetDataset{Name: "hadoop", Namespace: datasetNamespace},
+				Policy:  policy,
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		dataFree *datav1alpha1.DataFree
+		reason   string
+		wantErr  bool
+	}{
+		{
+			name:     "once",
+			dataFree: newDataFree(datav1alpha1.Once, "default"),
+		},
+		{
+			name:     "cron",
+			dataFree: newDataFree(datav1alpha1.Cron, "default"),
+		},
+		{
+			name:     "on_event_not_supported",
+			dataFree: newDataFree(datav1alpha1.OnEvent, "default"),
+			reason:   common.PolicyNotSupported,
+			wantErr:  true,
+		},
+		{
+			name:     "namespace_not_same",
+			dataFree: newDataFree(datav1alpha1.Once, "other"),
+			reason:   common.TargetDatasetNamespaceNotSame,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &dataFreeOperation{Recorder: record.NewFakeRecorder(10), dataFree: tt.dataFree}
+			got, err := r.Validate(cruntime.ReconcileRequestContext{})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && got[0].Reason != tt.reason {
+				t.Errorf("Validate() error reason got = %v, want %v", got[0].Reason, tt.reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datafree
+
+import (
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+	"github.com/pkg/errors"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
+)
+
+type OnceStatusHandler struct {
+	client.Client
+	dataFree *datav1alpha1.DataFree
+}
+
+var _ dataoperation.StatusHandler = &OnceStatusHandler{}
+
+type CronStatusHandler struct {
+	client.Client
+	dataFree *datav1alpha1.DataFree
+}
+
+var _ dataoperation.StatusHandler = &CronStatusHandler{}
+
+func (r *OnceStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus) (result *datav1alpha1.OperationStatus, err error) {
+	result = opStatus.DeepCopy()
+	// 2. Check running status of the DataFree job
+	releaseName := utils.GetDataFreeReleaseName(r.dataFree.GetName())
+	jobName := utils.GetDataFreeJobName(releaseName)
+
+	ctx.Log.V(1).Info("DataFree chart already existed, check its running status")
+	job, err := kubeclient.GetJob(r.Client, jobName, ctx.Namespace)
+	if err != nil {
+		// helm release found but job missing, delete the helm release and requeue
+		if utils.IgnoreNotFound(err) == nil {
+			ctx.Log.Info("Related Job missing, will delete helm chart and retry", "namespace", ctx.Namespace, "jobName", jobName)
+			if err = helm.DeleteReleaseIfExists(releaseName, ctx.Namespace); err != nil {
+				ctx.Log.Error(err, "can't delete datafree release", "namespace", ctx.Namespace, "releaseName", releaseName)
+				return
+			}
+		}
+		// other error
+		ctx.Log.Error(err, "can't get datafree job", "namespace", ctx.Namespace, "jobName", jobName)
+		return
+	}
+
+	finishedJobCondition := kubeclient.GetFinishedJobCondition(job)
+	if finishedJobCondition == nil {
+		ctx.Log.V(1).Info("DataFree job still running", "namespace", ctx.Namespace, "jobName", jobName)
+		return
+	}
+	isJobSucceed := finishedJobCondition.Type == batchv1.JobComplete
+
+	// set the node labels in status when job succeed
+	if result.NodeAffinity == nil && isJobSucceed {
+		// generate the node labels
+		result.NodeAffinity, err = dataflow.GenerateNodeAffinity(job)
+		if err != nil {
+			return nil, errors.Wrap(err, "error to generate the node labels")
+		}
+	}
+
+	// job either failed or complete, update DataFree's phase status
+	result.Conditions = []datav1alpha1.Condition{
+		{
+			Type:               common.ConditionType(finishedJobCondition.Type),
+			Status:             finishedJobCondition.Status,
+			Reason:             finishedJobCondition.Reason,
+			Message:            finishedJobCondition.Message,
+			LastProbeTime:      finishedJobCondition.LastProbeTime,
+			LastTransitionTime: finishedJobCondition.LastTransitionTime,
+		},
+	}
+	if isJobSucceed {
+		result.Phase = common.PhaseComplete
+	} else {
+		result.Phase = common.PhaseFailed
+	}
+	result.Duration = utils.CalculateDuration(job.CreationTimestamp.Time, finishedJobCondition.LastTransitionTime.Time)
+
+	return
+}
+func (c *CronStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus) (result *datav1alpha1.OperationStatus, err error) {
+	result = opStatus.DeepCopy()
+
+	// 1. Check running status of the DataFree job
+	releaseName := utils.GetDataFreeReleaseName(c.dataFree.GetName())
+	cronjobName := utils.GetDataFreeJobName(releaseName)
+
+	cronjobStatus, err := kubeclient.GetCronJobStatus(c.Client, types.NamespacedName{Namespace: c.dataFree.GetNamespace(), Name: cronjobName})
+
+	if err != nil {
+		// helm release found but cronjob missing, delete the helm release and requeue
+		if utils.IgnoreNotFound(err) == nil {
+			ctx.Log.Info("Related Cronjob missing, will delete helm chart and retry", "namespace", ctx.Namespace, "cronjobName", cronjobName)
+			if err = helm.DeleteReleaseIfExists(releaseName, ctx.Namespace); err != nil {
+				ctx.Log.Error(err, "can't delete DataFree release", "namespace", ctx.Namespace, "releaseName", releaseName)
+				return
+			}
+			return
+		}
+		// other error
+		ctx.Log.Error(err, "can't get DataFree cronjob", "namespace", ctx.Namespace, "cronjobName", cronjobName)
+		return
+	}
+
+	// update LastScheduleTime and LastSuccessfulTime
+	result.LastScheduleTime = cronjobStatus.LastScheduleTime
+	result.LastSuccessfulTime = cronjobStatus.LastSuccessfulTime
+
+	jobs, err := utils.ListDataOperationJobByCronjob(c.Client, types.NamespacedName{Namespace: c.dataFree.GetNamespace(), Name: cronjobName})
+	if err != nil {
+		ctx.Log.Error(err, "can't list DataFree job by cronjob", "namespace", ctx.Namespace, "cronjobName", cronjobName)
+		return
+	}
+
+	var currentJob *batchv1.Job
+	for _, job := range jobs {
+		if job.CreationTimestamp == *cronjobStatus.LastScheduleTime || job.CreationTimestamp.After(cronjobStatus.LastScheduleTime.Time) {
+			currentJob = &job
+			break
+		}
+	}
+	if currentJob == nil {
+		ctx.Log.Info("can't get newest job by cronjob, skip", "namespace", ctx.Namespace, "cronjobName", cronjobName)
+		return
+	}
+
+	finishedJobCondition := kubeclient.GetFinishedJobCondition(currentJob)
+
+	if finishedJobCondition == nil {
+		ctx.Log.V(1).Info("DataFree job still running", "namespace", ctx.Namespace, "cronjobName", cronjobName)
+		if opStatus.Phase == common.PhaseComplete || opStatus.Phase == common.PhaseFailed {
+			// if datafree was complete or failed, but now job is running, set datafree pending first
+			// dataset will be locked only when datafree pending
+			result.Phase = common.PhasePending
+			result.Duration = "-"
+		}
+		return
+	}
+	// job either failed or complete, update datafree's phase status
+	result.Conditions = []datav1alpha1.Condition{
+		{
+			Type:               common.ConditionType(finishedJobCondition.Type),
+			Status:             finishedJobCondition.Status,
+			Reason:             finishedJobCondition.Reason,
+			Message:            finishedJobCondition.Message,
+			LastProbeTime:      finishedJobCondition.LastProbeTime,
+			LastTransitionTime: finishedJobCondition.LastTransitionTime,
+		},
+	}
+	if finishedJobCondition.Type == batchv1.JobFailed {
+		result.Phase = common.PhaseFailed
+	} else {
+		result.Phase = common.PhaseComplete
+	}
+	result.Duration = utils.CalculateDuration(currentJob.CreationTimestamp.Time, finishedJobCondition.LastTransitionTime.Time)
+	return
+}
@@ -47,6 +47,7 @@ type dataLoadOperation struct {
 }
 
 var _ dataoperation.OperationInterface = &dataLoadOperation{}
+var _ dataoperation.UFSChangeWatcher = &dataLoadOperation{}
 
 func (r *dataLoadOperation) GetOperationObject() client.Object {
 	return r.dataLoad
@@ -162,3 +163,8 @@ func (r *dataLoadOperation) GetTTL() (ttl *int32, err error) {
 func (r *dataLoadOperation) GetParallelTaskNumber() int32 {
 	return 1
 }
+
+// GetWatchedPaths implements dataoperation.UFSChangeWatcher.
+func (r *dataLoadOperation) GetWatchedPaths() []string {
+	return cdataload.GetWatchedPaths(r.dataLoad)
+}
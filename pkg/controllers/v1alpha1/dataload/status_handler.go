@@ -17,17 +17,24 @@
 package dataload
 
 import (
+	"context"
+	"time"
+
 	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
 	"github.com/pkg/errors"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	"github.com/fluid-cloudnative/fluid/pkg/metrics"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/ufschange"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
 )
@@ -51,6 +58,8 @@ type OnEventStatusHandler struct {
 	dataLoad *datav1alpha1.DataLoad
 }
 
+var _ dataoperation.StatusHandler = &OnEventStatusHandler{}
+
 func (r *OnceStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus) (result *datav1alpha1.OperationStatus, err error) {
 	result = opStatus.DeepCopy()
 	// 2. Check running status of the DataLoad job
@@ -73,6 +82,8 @@ func (r *OnceStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestCont
 		return
 	}
 
+	updateProgress(ctx, r.Client, r.dataLoad, job, result)
+
 	finishedJobCondition := kubeclient.GetFinishedJobCondition(job)
 	if finishedJobCondition == nil {
 		ctx.Log.V(1).Info("DataLoad job still running", "namespace", ctx.Namespace, "jobName", jobName)
@@ -102,6 +113,7 @@ func (r *OnceStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestCont
 	}
 	if isJobSucceed {
 		result.Phase = common.PhaseComplete
+		completeProgress(r.dataLoad, result)
 	} else {
 		result.Phase = common.PhaseFailed
 	}
@@ -155,6 +167,16 @@ func (c *CronStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestCont
 		return
 	}
 
+	// the progress of the last job is kept until a new job is scheduled
+	if !opStatus.LastScheduleTime.Equal(cronjobStatus.LastScheduleTime) {
+		if err = resetProgress(c.Client, c.dataLoad, result); err != nil {
+			ctx.Log.Error(err, "can't reset DataLoad progress", "namespace", ctx.Namespace, "cronjobName", cronjobName)
+			return
+		}
+	}
+
+	updateProgress(ctx, c.Client, c.dataLoad, currentJob, result)
+
 	finishedJobCondition := kubeclient.GetFinishedJobCondition(currentJob)
 
 	if finishedJobCondition == nil {
@@ -182,12 +204,94 @@ func (c *CronStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestCont
 		result.Phase = common.PhaseFailed
 	} else {
 		result.Phase = common.PhaseComplete
+		completeProgress(c.dataLoad, result)
 	}
 	result.Duration = utils.CalculateDuration(currentJob.CreationTimestamp.Time, finishedJobCondition.LastTransitionTime.Time)
 	return
 }
 
+// updateProgress updates the progress of target paths of the DataLoad. The progress reported by the DataLoad job in the
+// progress ConfigMap takes precedence over the one derived from the runtime, and the ETA is estimated from the start time
+// of the job if it's not reported. Failing to get the progress does not block the status update of the DataLoad.
+func updateProgress(ctx cruntime.ReconcileRequestContext, c client.Client, dataLoad *datav1alpha1.DataLoad, job *batchv1.Job, result *datav1alpha1.OperationStatus) {
+	configMapName := utils.GetDataLoadProgressConfigMapName(utils.GetDataLoadReleaseName(dataLoad.GetName()))
+	configMap, err := kubeclient.GetConfigmapByName(c, configMapName, dataLoad.GetNamespace())
+	if err != nil {
+		ctx.Log.Error(err, "can't get DataLoad progress configmap, skip", "namespace", dataLoad.GetNamespace(), "configMapName", configMapName)
+	} else if progress, err := cdataload.ParseProgress(configMap); err != nil {
+		ctx.Log.Error(err, "can't parse DataLoad progress, skip", "namespace", dataLoad.GetNamespace(), "configMapName", configMapName)
+	} else if len(progress) > 0 {
+		result.Progress = progress
+	}
+
+	if len(result.Progress) == 0 {
+		return
+	}
+
+	if job.Status.StartTime != nil {
+		cdataload.EstimateETA(result.Progress, time.Since(job.Status.StartTime.Time))
+	}
+	metrics.GetOrCreateDataLoadMetrics(dataLoad.GetNamespace(), dataLoad.GetName()).SetProgress(result.Progress)
+}
+
+// completeProgress marks all the target paths as loaded when the DataLoad job succeeds.
+func completeProgress(dataLoad *datav1alpha1.DataLoad, result *datav1alpha1.OperationStatus) {
+	if len(result.Progress) == 0 {
+		return
+	}
+
+	cdataload.CompleteProgress(result.Progress)
+	metrics.GetOrCreateDataLoadMetrics(dataLoad.GetNamespace(), dataLoad.GetName()).SetProgress(result.Progress)
+}
+
+// resetProgress clears the progress of the last job, including the one reported in the progress ConfigMap,
+// so that a newly scheduled job of the cron DataLoad starts reporting from scratch.
+func resetProgress(c client.Client, dataLoad *datav1alpha1.DataLoad, result *datav1alpha1.OperationStatus) error {
+	result.Progress = nil
+	metrics.GetOrCreateDataLoadMetrics(dataLoad.GetNamespace(), dataLoad.GetName()).Forget()
+
+	configMapName := utils.GetDataLoadProgressConfigMapName(utils.GetDataLoadReleaseName(dataLoad.GetName()))
+	configMap, err := kubeclient.GetConfigmapByName(c, configMapName, dataLoad.GetNamespace())
+	if err != nil || configMap == nil || len(configMap.Data[cdataload.DataloadProgressKey]) == 0 {
+		return err
+	}
+
+	configMapToUpdate := configMap.DeepCopy()
+	configMapToUpdate.Data[cdataload.DataloadProgressKey] = ""
+	return c.Update(context.TODO(), configMapToUpdate)
+}
+
+// GetOperationStatus tracks the DataLoad job in the same way as the once DataLoad. After the job finishes, the DataLoad
+// is re-triggered to reload the changed target paths once any change of the under file system is pending.
 func (o *OnEventStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus) (result *datav1alpha1.OperationStatus, err error) {
-	//TODO implement me
-	return nil, nil
+	if opStatus.Phase != common.PhaseComplete && opStatus.Phase != common.PhaseFailed {
+		onceStatusHandler := &OnceStatusHandler{Client: o.Client, dataLoad: o.dataLoad}
+		return onceStatusHandler.GetOperationStatus(ctx, opStatus)
+	}
+
+	result = opStatus.DeepCopy()
+	changedPaths := ufschange.GetPathsInfo(result.Infos, ufschange.PendingChangedPathsInfoKey)
+	if len(changedPaths) == 0 {
+		return
+	}
+
+	// delete the finished job so that a new one is installed for the changed target paths
+	releaseName := utils.GetDataLoadReleaseName(o.dataLoad.GetName())
+	if err = helm.DeleteReleaseIfExists(releaseName, ctx.Namespace); err != nil {
+		ctx.Log.Error(err, "can't delete DataLoad release", "namespace", ctx.Namespace, "releaseName", releaseName)
+		return
+	}
+	if err = resetProgress(o.Client, o.dataLoad, result); err != nil {
+		ctx.Log.Error(err, "can't reset DataLoad progress", "namespace", ctx.Namespace, "releaseName", releaseName)
+		return
+	}
+
+	ufschange.SetPathsInfo(result.Infos, ufschange.ChangedPathsInfoKey, changedPaths)
+	ufschange.SetPathsInfo(result.Infos, ufschange.PendingChangedPathsInfoKey, nil)
+	result.Phase = common.PhasePending
+	result.Conditions = []datav1alpha1.Condition{}
+	result.Duration = "-"
+	ctx.Recorder.Eventf(o.dataLoad, corev1.EventTypeNormal, common.DataOperationRetriggered,
+		"DataLoad %s re-triggered to reload the changed target paths %v", o.dataLoad.GetName(), changedPaths)
+	return
 }
@@ -17,18 +17,24 @@
 package dataload
 
 import (
+	"context"
 	"reflect"
 	"testing"
 	"time"
 
+	"github.com/agiledragon/gomonkey/v2"
 	"github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/ufschange"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 )
 
 func TestOnceGetOperationStatus(t *testing.T) {
@@ -118,6 +124,128 @@ func TestOnceGetOperationStatus(t *testing.T) {
 	}
 }
 
+func TestOnceGetOperationStatusWithProgress(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = v1alpha1.AddToScheme(testScheme)
+	_ = batchv1.AddToScheme(testScheme)
+	_ = corev1.AddToScheme(testScheme)
+
+	mockDataload := v1alpha1.DataLoad{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-dataload",
+			Namespace: "default",
+		},
+		Spec: v1alpha1.DataLoadSpec{
+			Dataset: v1alpha1.TargetDataset{
+				Name:      "hadoop",
+				Namespace: "default",
+			},
+		},
+	}
+
+	mockRunningJob := batchv1.Job{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-dataload-loader-job",
+			Namespace: "default",
+		},
+	}
+
+	mockProgressConfigMap := corev1.ConfigMap{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-dataload-loader-progress",
+			Namespace: "default",
+		},
+		Data: map[string]string{
+			"progress": `[{"path":"/a","loadedBytes":1024,"totalBytes":4096,"eta":"3m"}]`,
+		},
+	}
+
+	mockStartedJob := batchv1.Job{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-dataload-loader-job",
+			Namespace: "default",
+		},
+		Status: batchv1.JobStatus{
+			StartTime: &v1.Time{Time: time.Now().Add(-10 * time.Minute)},
+		},
+	}
+
+	mockCompleteJob := batchv1.Job{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-dataload-loader-job",
+			Namespace: "default",
+		},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{
+				{
+					Type:               batchv1.JobComplete,
+					LastProbeTime:      v1.NewTime(time.Now()),
+					LastTransitionTime: v1.NewTime(time.Now()),
+				},
+			},
+		},
+	}
+
+	testcases := []struct {
+		name             string
+		objs             []runtime.Object
+		progress         []v1alpha1.TargetPathProgress
+		expectedProgress []v1alpha1.TargetPathProgress
+	}{
+		{
+			name:             "progress reported",
+			objs:             []runtime.Object{&mockDataload, &mockRunningJob, &mockProgressConfigMap},
+			expectedProgress: []v1alpha1.TargetPathProgress{{Path: "/a", LoadedBytes: 1024, TotalBytes: 4096, ETA: "3m"}},
+		},
+		{
+			name:             "progress not reported",
+			objs:             []runtime.Object{&mockDataload, &mockRunningJob},
+			expectedProgress: nil,
+		},
+		{
+			name:             "progress derived from runtime",
+			objs:             []runtime.Object{&mockDataload, &mockStartedJob},
+			progress:         []v1alpha1.TargetPathProgress{{Path: "/a", LoadedBytes: 1024, TotalBytes: 4096}},
+			expectedProgress: []v1alpha1.TargetPathProgress{{Path: "/a", LoadedBytes: 1024, TotalBytes: 4096, ETA: "30m0s"}},
+		},
+		{
+			name:             "job complete",
+			objs:             []runtime.Object{&mockDataload, &mockCompleteJob},
+			progress:         []v1alpha1.TargetPathProgress{{Path: "/a", TotalBytes: 4096, TotalFiles: 4}},
+			expectedProgress: []v1alpha1.TargetPathProgress{{Path: "/a", LoadedBytes: 4096, TotalBytes: 4096, LoadedFiles: 4, TotalFiles: 4, ETA: "0s"}},
+		},
+	}
+
+	for _, testcase := range testcases {
+		client := fake.NewFakeClientWithScheme(testScheme, testcase.objs...)
+		onceStatusHandler := &OnceStatusHandler{Client: client, dataLoad: &mockDataload}
+		ctx := cruntime.ReconcileRequestContext{
+			NamespacedName: types.NamespacedName{
+				Namespace: "default",
+				Name:      "",
+			},
+			Log: fake.NullLogger(),
+		}
+		opStatus, err := onceStatusHandler.GetOperationStatus(ctx, &v1alpha1.OperationStatus{Progress: testcase.progress})
+		if err != nil {
+			t.Errorf("testcase %s: fail to GetOperationStatus with error %v", testcase.name, err)
+		}
+		// the start time of the job is truncated to seconds, so the estimated ETA may be a few seconds longer
+		if len(opStatus.Progress) == len(testcase.expectedProgress) {
+			for i := range opStatus.Progress {
+				got, _ := time.ParseDuration(opStatus.Progress[i].ETA)
+				want, _ := time.ParseDuration(testcase.expectedProgress[i].ETA)
+				if got-want >= 0 && got-want < 5*time.Second {
+					opStatus.Progress[i].ETA = testcase.expectedProgress[i].ETA
+				}
+			}
+		}
+		if !reflect.DeepEqual(opStatus.Progress, testcase.expectedProgress) {
+			t.Errorf("testcase %s: expected progress %v, get %v", testcase.name, testcase.expectedProgress, opStatus.Progress)
+		}
+	}
+}
+
 func TestCronGetOperationStatus(t *testing.T) {
 	testScheme := runtime.NewScheme()
 	_ = v1alpha1.AddToScheme(testScheme)
@@ -224,3 +352,138 @@ func TestCronGetOperationStatus(t *testing.T) {
 		}
 	}
 }
+
+func TestResetProgress(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = v1alpha1.AddToScheme(testScheme)
+	_ = corev1.AddToScheme(testScheme)
+
+	mockDataload := v1alpha1.DataLoad{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-dataload",
+			Namespace: "default",
+		},
+	}
+	mockProgressConfigMap := corev1.ConfigMap{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-dataload-loader-progress",
+			Namespace: "default",
+		},
+		Data: map[string]string{
+			"progress": `[{"path":"/a","loadedBytes":4096,"totalBytes":4096}]`,
+		},
+	}
+
+	client := fake.NewFakeClientWithScheme(testScheme, &mockDataload, &mockProgressConfigMap)
+	result := &v1alpha1.OperationStatus{Progress: []v1alpha1.TargetPathProgress{{Path: "/a", LoadedBytes: 4096, TotalBytes: 4096}}}
+	if err := resetProgress(client, &mockDataload, result); err != nil {
+		t.Fatalf("fail to reset progress: %v", err)
+	}
+	if result.Progress != nil {
+		t.Errorf("expect progress in status to be reset, got %v", result.Progress)
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := client.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "test-dataload-loader-progress"}, configMap); err != nil {
+		t.Fatalf("fail to get progress configmap: %v", err)
+	}
+	if configMap.Data["progress"] != "" {
+		t.Errorf("expect progress in configmap to be reset, got %s", configMap.Data["progress"])
+	}
+
+	// the progress configmap doesn't exist when the runtime does not report it
+	if err := resetProgress(fake.NewFakeClientWithScheme(testScheme, &mockDataload), &mockDataload, result); err != nil {
+		t.Errorf("expect no error when the progress configmap doesn't exist, got %v", err)
+	}
+}
+
+func TestOnEventGetOperationStatus(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = v1alpha1.AddToScheme(testScheme)
+	_ = corev1.AddToScheme(testScheme)
+
+	mockDataload := v1alpha1.DataLoad{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-dataload",
+			Namespace: "default",
+		},
+		Spec: v1alpha1.DataLoadSpec{
+			Dataset: v1alpha1.TargetDataset{
+				Name:      "hadoop",
+				Namespace: "default",
+			},
+			Policy: v1alpha1.OnEvent,
+			Target: []v1alpha1.TargetPath{{Path: "/a"}, {Path: "/b"}},
+		},
+	}
+
+	patches := gomonkey.ApplyFunc(helm.DeleteReleaseIfExists, func(name string, namespace string) error {
+		return nil
+	})
+	defer patches.Reset()
+
+	testcases := []struct {
+		name          string
+		opStatus      v1alpha1.OperationStatus
+		expectedPhase common.Phase
+		expectedInfos map[string]string
+	}{
+		{
+			name: "no pending changes",
+			opStatus: v1alpha1.OperationStatus{
+				Phase: common.PhaseComplete,
+				Infos: map[string]string{},
+			},
+			expectedPhase: common.PhaseComplete,
+			expectedInfos: map[string]string{},
+		},
+		{
+			name: "re-triggered by pending changes",
+			opStatus: v1alpha1.OperationStatus{
+				Phase: common.PhaseComplete,
+				Infos: map[string]string{
+					ufschange.ChangedPathsInfoKey:        `["/a"]`,
+					ufschange.PendingChangedPathsInfoKey: `["/b"]`,
+				},
+			},
+			expectedPhase: common.PhasePending,
+			expectedInfos: map[string]string{
+				ufschange.ChangedPathsInfoKey: `["/b"]`,
+			},
+		},
+		{
+			name: "re-triggered after failure",
+			opStatus: v1alpha1.OperationStatus{
+				Phase: common.PhaseFailed,
+				Infos: map[string]string{
+					ufschange.PendingChangedPathsInfoKey: `["/a","/b"]`,
+				},
+			},
+			expectedPhase: common.PhasePending,
+			expectedInfos: map[string]string{
+				ufschange.ChangedPathsInfoKey: `["/a","/b"]`,
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		client := fake.NewFakeClientWithScheme(testScheme, &mockDataload)
+		handler := &OnEventStatusHandler{Client: client, dataLoad: &mockDataload}
+		ctx := cruntime.ReconcileRequestContext{
+			NamespacedName: types.NamespacedName{Namespace: "default", Name: ""},
+			Log:            fake.NullLogger(),
+			Recorder:       record.NewFakeRecorder(1),
+		}
+
+		result, err := handler.GetOperationStatus(ctx, &testcase.opStatus)
+		if err != nil {
+			t.Fatalf("testcase %s: fail to get operation status: %v", testcase.name, err)
+		}
+		if result.Phase != testcase.expectedPhase {
+			t.Errorf("testcase %s: expect phase %s, got %s", testcase.name, testcase.expectedPhase, result.Phase)
+		}
+		if !reflect.DeepEqual(result.Infos, testcase.expectedInfos) {
+			t.Errorf("testcase %s: expect infos %v, got %v", testcase.name, testcase.expectedInfos, result.Infos)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+/*
+  Copyright 2025 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package dataload
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
+	"github.com/fluid-cloudnative/fluid/pkg/ufschange"
+)
+
+// NewUFSChangeHandler returns the handler which records the changed target paths as pending in the status of the
+// OnEvent DataLoads of the changed dataset, the DataLoads reload them once their current runs finish.
+func NewUFSChangeHandler(c client.Client, log logr.Logger) ufschange.Handler {
+	return func(ctx context.Context, event ufschange.Event) error {
+		dataLoadList := &datav1alpha1.DataLoadList{}
+		if err := c.List(ctx, dataLoadList, client.InNamespace(event.Dataset.Namespace)); err != nil {
+			return fmt.Errorf("failed to list DataLoads in namespace %s: %v", event.Dataset.Namespace, err)
+		}
+
+		for _, dataLoad := range dataLoadList.Items {
+			if dataLoad.Spec.Dataset.Name != event.Dataset.Name || len(cdataload.GetWatchedPaths(&dataLoad)) == 0 {
+				continue
+			}
+
+			key := types.NamespacedName{Namespace: dataLoad.Namespace, Name: dataLoad.Name}
+			err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+				latest := &datav1alpha1.DataLoad{}
+				if err := c.Get(ctx, key, latest); err != nil {
+					return err
+				}
+
+				changedPaths := ufschange.MatchChangedPaths(cdataload.GetWatchedPaths(latest), event.Paths)
+				dataLoadToUpdate := latest.DeepCopy()
+				if !ufschange.AddPendingChangedPaths(&dataLoadToUpdate.Status, changedPaths) {
+					return nil
+				}
+				log.Info("DataLoad re-triggered by ufs changes", "dataload", key, "changedPaths", changedPaths)
+				return c.Status().Update(ctx, dataLoadToUpdate)
+			})
+			if err != nil {
+				return fmt.Errorf("failed to re-trigger DataLoad %s: %v", key, err)
+			}
+		}
+		return nil
+	}
+}
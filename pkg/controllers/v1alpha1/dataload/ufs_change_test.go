@@ -0,0 +1,80 @@
+/*
+  Copyright 2025 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package dataload
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/ufschange"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestUFSChangeHandler(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = v1alpha1.AddToScheme(testScheme)
+
+	newDataLoad := func(name string, dataset string, policy v1alpha1.Policy, infos map[string]string) *v1alpha1.DataLoad {
+		return &v1alpha1.DataLoad{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: v1alpha1.DataLoadSpec{
+				Dataset: v1alpha1.TargetDataset{Name: dataset, Namespace: "default"},
+				Policy:  policy,
+				Target:  []v1alpha1.TargetPath{{Path: "/a"}, {Path: "/b"}},
+			},
+			Status: v1alpha1.OperationStatus{Infos: infos},
+		}
+	}
+
+	client := fake.NewFakeClientWithScheme(testScheme,
+		newDataLoad("onevent", "hadoop", v1alpha1.OnEvent, map[string]string{ufschange.PendingChangedPathsInfoKey: `["/b"]`}),
+		newDataLoad("once", "hadoop", v1alpha1.Once, nil),
+		newDataLoad("other-dataset", "spark", v1alpha1.OnEvent, nil),
+	)
+
+	handler := NewUFSChangeHandler(client, fake.NullLogger())
+	err := handler(context.TODO(), ufschange.Event{
+		Dataset: types.NamespacedName{Namespace: "default", Name: "hadoop"},
+		Paths:   []string{"/a/data.csv", "/c"},
+	})
+	if err != nil {
+		t.Fatalf("fail to handle ufs change event: %v", err)
+	}
+
+	testcases := map[string]string{
+		"onevent":       `["/a","/b"]`,
+		"once":          "",
+		"other-dataset": "",
+	}
+	for name, expected := range testcases {
+		dataLoad := &v1alpha1.DataLoad{}
+		if err = client.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: name}, dataLoad); err != nil {
+			t.Fatalf("fail to get DataLoad %s: %v", name, err)
+		}
+		if got := dataLoad.Status.Infos[ufschange.PendingChangedPathsInfoKey]; got != expected {
+			t.Errorf("DataLoad %s: expect pending changed paths %q, got %q", name, expected, got)
+		}
+	}
+}
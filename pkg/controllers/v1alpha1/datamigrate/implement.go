@@ -101,9 +101,56 @@ func (r *dataMigrateOperation) UpdateOperationApiStatus(opStatus *datav1alpha1.O
 func (r *dataMigrateOperation) Validate(ctx cruntime.ReconcileRequestContext) ([]datav1alpha1.Condition, error) {
 	targetDataSet := ctx.Dataset
 
-	// parallel data migration must specify the ssh secret name
+	// the files changed since the last successful migration are only tracked across the jobs of the cronjob,
+	// so the Incremental mode is rejected for the other policies instead of behaving like Copy
+	if r.dataMigrate.Spec.Mode == datav1alpha1.IncrementalMigrateMode && r.dataMigrate.Spec.Policy != datav1alpha1.Cron {
+		err := fmt.Errorf("DataMigrate(%s) sets mode %s with policy %s", r.dataMigrate.GetName(), datav1alpha1.IncrementalMigrateMode, r.dataMigrate.Spec.Policy)
+		return []datav1alpha1.Condition{
+			{
+				Type:               common.Failed,
+				Status:             v1.ConditionTrue,
+				Reason:             common.PolicyNotSupported,
+				Message:            fmt.Sprintf("the policy must be %s in %s mode", datav1alpha1.Cron, datav1alpha1.IncrementalMigrateMode),
+				LastProbeTime:      metav1.NewTime(time.Now()),
+				LastTransitionTime: metav1.NewTime(time.Now()),
+			},
+		}, err
+	}
+
+	// the generic migrator runs a single task, the parallel options are rejected so as not to be ignored silently
+	if cdatamigrate.UseGenericMigrator(ctx.EngineImpl) &&
+		(r.dataMigrate.Spec.Parallelism > 1 || len(r.dataMigrate.Spec.ParallelOptions[cdatamigrate.ParallelMode]) > 0) {
+		err := fmt.Errorf("DataMigrate(%s) sets parallel tasks or parallel mode, which is not supported by %s engine", r.dataMigrate.GetName(), ctx.EngineImpl)
+		return []datav1alpha1.Condition{
+			{
+				Type:               common.Failed,
+				Status:             v1.ConditionTrue,
+				Reason:             common.ParallelModeNotSupported,
+				Message:            fmt.Sprintf("the parallelism must be 1 and the parallel mode must not be set for %s engine", ctx.EngineImpl),
+				LastProbeTime:      metav1.NewTime(time.Now()),
+				LastTransitionTime: metav1.NewTime(time.Now()),
+			},
+		}, err
+	}
+
 	if r.dataMigrate.Spec.Parallelism > 1 {
-		if r.dataMigrate.Spec.ParallelOptions == nil || len(r.dataMigrate.Spec.ParallelOptions[cdatamigrate.SSHSecretName]) == 0 {
+		mode := cdatamigrate.GetParallelMode(r.dataMigrate.Spec.ParallelOptions)
+		if mode != cdatamigrate.ParallelModeSSH && mode != cdatamigrate.ParallelModeSharded {
+			err := fmt.Errorf("DataMigrate(%s) with parallel tasks sets unsupported parallel mode %s", r.dataMigrate.GetName(), mode)
+			return []datav1alpha1.Condition{
+				{
+					Type:               common.Failed,
+					Status:             v1.ConditionTrue,
+					Reason:             common.ParallelModeNotSupported,
+					Message:            fmt.Sprintf("the mode in the parallelOptions must be %s or %s", cdatamigrate.ParallelModeSSH, cdatamigrate.ParallelModeSharded),
+					LastProbeTime:      metav1.NewTime(time.Now()),
+					LastTransitionTime: metav1.NewTime(time.Now()),
+				},
+			}, err
+		}
+
+		// parallel data migration in ssh mode must specify the ssh secret name
+		if mode == cdatamigrate.ParallelModeSSH && len(r.dataMigrate.Spec.ParallelOptions[cdatamigrate.SSHSecretName]) == 0 {
 			err := fmt.Errorf("DataMigrate(%s) with parallel tasks does not set the SSHSecretName", r.dataMigrate.GetName())
 			return []datav1alpha1.Condition{
 				{
@@ -116,6 +163,21 @@ func (r *dataMigrateOperation) Validate(ctx cruntime.ReconcileRequestContext) ([
 				},
 			}, err
 		}
+
+		// Fluid does not ship an image with kubectl, so the coordinator image of sharded mode must be specified
+		if mode == cdatamigrate.ParallelModeSharded && len(r.dataMigrate.Spec.ParallelOptions[cdatamigrate.CoordinatorImage]) == 0 {
+			err := fmt.Errorf("DataMigrate(%s) in sharded mode does not set the coordinatorImage", r.dataMigrate.GetName())
+			return []datav1alpha1.Condition{
+				{
+					Type:               common.Failed,
+					Status:             v1.ConditionTrue,
+					Reason:             common.CoordinatorImageNotSet,
+					Message:            "the coordinatorImage key is not set in the parallelOptions",
+					LastProbeTime:      metav1.NewTime(time.Now()),
+					LastTransitionTime: metav1.NewTime(time.Now()),
+				},
+			}, err
+		}
 	}
 
 	if r.dataMigrate.GetNamespace() != targetDataSet.Namespace {
@@ -61,6 +61,150 @@ func TestDataMigrateOperation_Validate(t *testing.T) {
 			reason:  common.TargetSSHSecretNameNotSet,
 			wantErr: true,
 		},
+		{
+			name: "unsupported parallel mode",
+			fields: fields{
+				dataMigrate: &datav1alpha1.DataMigrate{
+					Spec: datav1alpha1.DataMigrateSpec{
+						Parallelism:     2,
+						ParallelOptions: map[string]string{"mode": "mpi"},
+					},
+				},
+			},
+			args: args{
+				ctx: runtime.ReconcileRequestContext{
+					Dataset: nil,
+				},
+			},
+			reason:  common.ParallelModeNotSupported,
+			wantErr: true,
+		},
+		{
+			name: "coordinator image not set in sharded mode",
+			fields: fields{
+				dataMigrate: &datav1alpha1.DataMigrate{
+					Spec: datav1alpha1.DataMigrateSpec{
+						Parallelism:     2,
+						ParallelOptions: map[string]string{"mode": "sharded"},
+					},
+				},
+			},
+			args: args{
+				ctx: runtime.ReconcileRequestContext{
+					Dataset: &datav1alpha1.Dataset{},
+				},
+			},
+			reason:  common.CoordinatorImageNotSet,
+			wantErr: true,
+		},
+		{
+			name: "ssh secret not required in sharded mode",
+			fields: fields{
+				dataMigrate: &datav1alpha1.DataMigrate{
+					Spec: datav1alpha1.DataMigrateSpec{
+						Parallelism:     2,
+						ParallelOptions: map[string]string{"mode": "sharded", "coordinatorImage": "kubectl:test"},
+					},
+				},
+			},
+			args: args{
+				ctx: runtime.ReconcileRequestContext{
+					Dataset: &datav1alpha1.Dataset{},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "parallel tasks not supported by generic migrator",
+			fields: fields{
+				dataMigrate: &datav1alpha1.DataMigrate{
+					Spec: datav1alpha1.DataMigrateSpec{
+						Parallelism:     2,
+						ParallelOptions: map[string]string{"mode": "sharded"},
+					},
+				},
+			},
+			args: args{
+				ctx: runtime.ReconcileRequestContext{
+					Dataset:    &datav1alpha1.Dataset{},
+					EngineImpl: common.AlluxioEngineImpl,
+				},
+			},
+			reason:  common.ParallelModeNotSupported,
+			wantErr: true,
+		},
+		{
+			name: "parallel mode not supported by generic migrator",
+			fields: fields{
+				dataMigrate: &datav1alpha1.DataMigrate{
+					Spec: datav1alpha1.DataMigrateSpec{
+						Parallelism:     1,
+						ParallelOptions: map[string]string{"mode": "sharded"},
+					},
+				},
+			},
+			args: args{
+				ctx: runtime.ReconcileRequestContext{
+					Dataset:    &datav1alpha1.Dataset{},
+					EngineImpl: common.JindoCacheEngineImpl,
+				},
+			},
+			reason:  common.ParallelModeNotSupported,
+			wantErr: true,
+		},
+		{
+			name: "incremental mode with once policy",
+			fields: fields{
+				dataMigrate: &datav1alpha1.DataMigrate{
+					Spec: datav1alpha1.DataMigrateSpec{
+						Policy: datav1alpha1.Once,
+						Mode:   datav1alpha1.IncrementalMigrateMode,
+					},
+				},
+			},
+			args: args{
+				ctx: runtime.ReconcileRequestContext{
+					Dataset: &datav1alpha1.Dataset{},
+				},
+			},
+			reason:  common.PolicyNotSupported,
+			wantErr: true,
+		},
+		{
+			name: "incremental mode with cron policy",
+			fields: fields{
+				dataMigrate: &datav1alpha1.DataMigrate{
+					Spec: datav1alpha1.DataMigrateSpec{
+						Policy:   datav1alpha1.Cron,
+						Schedule: "0 * * * *",
+						Mode:     datav1alpha1.IncrementalMigrateMode,
+					},
+				},
+			},
+			args: args{
+				ctx: runtime.ReconcileRequestContext{
+					Dataset: &datav1alpha1.Dataset{},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "single task by generic migrator",
+			fields: fields{
+				dataMigrate: &datav1alpha1.DataMigrate{
+					Spec: datav1alpha1.DataMigrateSpec{
+						Parallelism: 1,
+					},
+				},
+			},
+			args: args{
+				ctx: runtime.ReconcileRequestContext{
+					Dataset:    &datav1alpha1.Dataset{},
+					EngineImpl: common.GooseFSEngineImpl,
+				},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -17,15 +17,22 @@
 package datamigrate
 
 import (
+	"context"
+	"sort"
+	"strconv"
+
 	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdatamigrate "github.com/fluid-cloudnative/fluid/pkg/datamigrate"
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
@@ -112,6 +119,7 @@ func (m *OnceStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestCont
 		result.Phase = common.PhaseFailed
 	}
 	result.Duration = utils.CalculateDuration(job.CreationTimestamp.Time, finishedJobCondition.LastTransitionTime.Time)
+	updateReport(ctx, m.Client, m.dataMigrate, job, result)
 	return
 }
 
@@ -207,8 +215,15 @@ func (c *CronStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestCont
 		result.Phase = common.PhaseFailed
 	} else {
 		result.Phase = common.PhaseComplete
+		if c.dataMigrate.Spec.Mode == datav1alpha1.IncrementalMigrateMode {
+			if err = updateIncrementalState(c.Client, c.dataMigrate, currentJob); err != nil {
+				ctx.Log.Error(err, "can't update the state of incremental DataMigrate", "namespace", ctx.Namespace, "cronjobName", cronjobName)
+				return
+			}
+		}
 	}
 	result.Duration = utils.CalculateDuration(currentJob.CreationTimestamp.Time, finishedJobCondition.LastTransitionTime.Time)
+	updateReport(ctx, c.Client, c.dataMigrate, currentJob, result)
 	return
 }
 
@@ -216,3 +231,101 @@ func (o *OnEventStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestC
 	//TODO implement me
 	return nil, nil
 }
+
+// updateReport sums up the results reported by the pods of the finished DataMigrate job into the status infos. For each
+// completion index, the succeeded pod takes precedence over the failed ones, and the latest one is used if all the pods
+// failed. Failing to get the reports does not block the status update of the DataMigrate.
+func updateReport(ctx cruntime.ReconcileRequestContext, c client.Client, dataMigrate *datav1alpha1.DataMigrate, job *batchv1.Job, result *datav1alpha1.OperationStatus) {
+	pods, err := kubeclient.GetPodsForJob(c, job)
+	if err != nil {
+		ctx.Log.Error(err, "can't get DataMigrate pods, skip reporting the result", "namespace", job.Namespace, "jobName", job.Name)
+		return
+	}
+
+	reportedPods := map[string]*corev1.Pod{}
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed {
+			continue
+		}
+		index := pod.Annotations[batchv1.JobCompletionIndexAnnotation]
+		if reported, found := reportedPods[index]; found {
+			if reported.Status.Phase == corev1.PodSucceeded ||
+				(pod.Status.Phase == corev1.PodFailed && !pod.CreationTimestamp.After(reported.CreationTimestamp.Time)) {
+				continue
+			}
+		}
+		reportedPods[index] = pod
+	}
+	if len(reportedPods) == 0 {
+		return
+	}
+
+	// merge the reports in the order of completion index to keep the mismatched paths stable
+	indexes := make([]string, 0, len(reportedPods))
+	for index := range reportedPods {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		a, _ := strconv.Atoi(indexes[i])
+		b, _ := strconv.Atoi(indexes[j])
+		return a < b
+	})
+
+	report := cdatamigrate.Report{}
+	for _, index := range indexes {
+		for _, status := range reportedPods[index].Status.ContainerStatuses {
+			if status.Name == cdatamigrate.DataMigrateContainerName && status.State.Terminated != nil {
+				report.Merge(cdatamigrate.ParseReport(status.State.Terminated.Message))
+			}
+		}
+	}
+
+	if result.Infos == nil {
+		result.Infos = map[string]string{}
+	}
+	report.SetInfos(dataMigrate.Spec.Mode, result.Infos)
+}
+
+// updateIncrementalState records the start time of the succeeded job in the state ConfigMap, and the next job of the
+// incremental DataMigrate only copies the files changed since then. The start time instead of the completion time is
+// recorded, so that the files changed during the migration are copied again rather than missed.
+func updateIncrementalState(c client.Client, dataMigrate *datav1alpha1.DataMigrate, job *batchv1.Job) error {
+	if job.Status.StartTime == nil {
+		return nil
+	}
+	since := strconv.FormatInt(job.Status.StartTime.Unix(), 10)
+
+	name := utils.GetDataMigrateStateConfigMapName(utils.GetDataMigrateReleaseName(dataMigrate.GetName()))
+	configMap, err := kubeclient.GetConfigmapByName(c, name, dataMigrate.GetNamespace())
+	if err != nil {
+		return err
+	}
+
+	if configMap == nil {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: dataMigrate.GetNamespace(),
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: datav1alpha1.GroupVersion.String(),
+					Kind:       string(dataoperation.DataMigrateType),
+					Name:       dataMigrate.GetName(),
+					UID:        dataMigrate.GetUID(),
+				}},
+			},
+			Data: map[string]string{cdatamigrate.StateSinceKey: since},
+		}
+		return c.Create(context.TODO(), configMap)
+	}
+
+	if configMap.Data[cdatamigrate.StateSinceKey] == since {
+		return nil
+	}
+	configMapToUpdate := configMap.DeepCopy()
+	if configMapToUpdate.Data == nil {
+		configMapToUpdate.Data = map[string]string{}
+	}
+	configMapToUpdate.Data[cdatamigrate.StateSinceKey] = since
+	return kubeclient.UpdateConfigMap(c, configMapToUpdate)
+}
@@ -29,6 +29,7 @@ import (
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -363,3 +364,124 @@ func TestCronGetOperationStatusWithParallelTasks(t1 *testing.T) {
 		})
 	}
 }
+
+func TestUpdateReport(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(testScheme)
+
+	job := &batchv1.Job{
+		ObjectMeta: v1.ObjectMeta{Name: "test-migrate-migrate", Namespace: "default"},
+		Spec: batchv1.JobSpec{
+			Selector: &v1.LabelSelector{MatchLabels: map[string]string{"job-name": "test-migrate-migrate"}},
+		},
+	}
+	newPod := func(name, index string, phase corev1.PodPhase, created time.Time, message string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: v1.ObjectMeta{
+				Name:              name,
+				Namespace:         "default",
+				Labels:            map[string]string{"job-name": "test-migrate-migrate"},
+				Annotations:       map[string]string{batchv1.JobCompletionIndexAnnotation: index},
+				CreationTimestamp: v1.NewTime(created),
+			},
+			Status: corev1.PodStatus{
+				Phase: phase,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:  "datamigrate",
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: message}},
+				}},
+			},
+		}
+	}
+	now := time.Now()
+
+	testcases := []struct {
+		name      string
+		mode      v1alpha1.MigrateMode
+		pods      []*corev1.Pod
+		wantInfos map[string]string
+	}{
+		{
+			name: "copy",
+			pods: []*corev1.Pod{
+				newPod("pod-0-failed", "0", corev1.PodFailed, now.Add(-time.Minute), "copied=1\nskipped=0\nfailed=3"),
+				newPod("pod-0", "0", corev1.PodSucceeded, now, "copied=4\nskipped=1\nfailed=0"),
+				newPod("pod-1", "1", corev1.PodSucceeded, now, "copied=2\nskipped=2\nfailed=0"),
+				newPod("pod-2", "2", corev1.PodRunning, now, ""),
+			},
+			wantInfos: map[string]string{"filesCopied": "6", "filesSkipped": "3", "filesFailed": "0"},
+		},
+		{
+			name: "all_failed",
+			mode: v1alpha1.IncrementalMigrateMode,
+			pods: []*corev1.Pod{
+				newPod("pod-0-first", "0", corev1.PodFailed, now.Add(-time.Minute), "copied=1\nskipped=0\nfailed=3"),
+				newPod("pod-0-last", "0", corev1.PodFailed, now, "copied=3\nskipped=0\nfailed=1"),
+			},
+			wantInfos: map[string]string{"filesCopied": "3", "filesSkipped": "0", "filesFailed": "1"},
+		},
+		{
+			name: "verify",
+			mode: v1alpha1.VerifyMigrateMode,
+			pods: []*corev1.Pod{
+				newPod("pod-1", "1", corev1.PodSucceeded, now, "checked=5\nmismatched=1\nmismatch=b/c"),
+				newPod("pod-0", "0", corev1.PodSucceeded, now, "checked=5\nmismatched=1\nmismatch=a"),
+			},
+			wantInfos: map[string]string{"filesChecked": "10", "filesMismatched": "2", "mismatchedPaths": `["a","b/c"]`},
+		},
+		{
+			name:      "no_finished_pods",
+			pods:      []*corev1.Pod{newPod("pod-0", "0", corev1.PodRunning, now, "")},
+			wantInfos: nil,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			objs := []runtime.Object{}
+			for _, pod := range testcase.pods {
+				objs = append(objs, pod)
+			}
+			client := fake.NewFakeClientWithScheme(testScheme, objs...)
+			dataMigrate := &v1alpha1.DataMigrate{Spec: v1alpha1.DataMigrateSpec{Mode: testcase.mode}}
+			result := &v1alpha1.OperationStatus{}
+
+			updateReport(cruntime.ReconcileRequestContext{Log: fake.NullLogger()}, client, dataMigrate, job, result)
+			if !reflect.DeepEqual(result.Infos, testcase.wantInfos) {
+				t.Errorf("updateReport() infos = %v, want %v", result.Infos, testcase.wantInfos)
+			}
+		})
+	}
+}
+
+func TestUpdateIncrementalState(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(testScheme)
+
+	dataMigrate := &v1alpha1.DataMigrate{
+		ObjectMeta: v1.ObjectMeta{Name: "test", Namespace: "default", UID: "uid"},
+		Spec:       v1alpha1.DataMigrateSpec{Mode: v1alpha1.IncrementalMigrateMode},
+	}
+	startTime := v1.NewTime(time.Unix(1700000000, 0))
+	job := &batchv1.Job{Status: batchv1.JobStatus{StartTime: &startTime}}
+	client := fake.NewFakeClientWithScheme(testScheme)
+
+	for _, since := range []string{"1700000000", "1700003600"} {
+		if err := updateIncrementalState(client, dataMigrate, job); err != nil {
+			t.Fatalf("updateIncrementalState() error = %v", err)
+		}
+		configMap, err := kubeclient.GetConfigmapByName(client, "test-migrate-state", "default")
+		if err != nil || configMap == nil {
+			t.Fatalf("failed to get the state configmap: %v", err)
+		}
+		if got := configMap.Data["since"]; got != since {
+			t.Errorf("updateIncrementalState() since = %s, want %s", got, since)
+		}
+		if len(configMap.OwnerReferences) != 1 || configMap.OwnerReferences[0].UID != "uid" {
+			t.Errorf("updateIncrementalState() owner references = %v, want the DataMigrate", configMap.OwnerReferences)
+		}
+
+		nextStartTime := v1.NewTime(startTime.Add(time.Hour))
+		job.Status.StartTime = &nextStartTime
+	}
+}
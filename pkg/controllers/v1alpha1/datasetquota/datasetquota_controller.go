@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasetquota
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+const controllerName string = "DatasetQuotaReconciler"
+
+// DatasetQuotaReconciler keeps the cache capacity requested by the runtimes in the status of the DatasetQuotas,
+// the limits are enforced by the runtime controllers when scaling out the workers.
+type DatasetQuotaReconciler struct {
+	client.Client
+	Log          logr.Logger
+	ResyncPeriod time.Duration
+}
+
+func NewDatasetQuotaReconciler(client client.Client,
+	log logr.Logger,
+	resyncPeriod time.Duration) *DatasetQuotaReconciler {
+	return &DatasetQuotaReconciler{
+		Client:       client,
+		Log:          log,
+		ResyncPeriod: resyncPeriod,
+	}
+}
+
+// +kubebuilder:rbac:groups=data.fluid.io,resources=datasetquotas,verbs=get;list;watch
+// +kubebuilder:rbac:groups=data.fluid.io,resources=datasetquotas/status,verbs=get;update;patch
+
+func (r *DatasetQuotaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("datasetquota", req.NamespacedName)
+	log.V(1).Info("reconcile starts")
+	defer log.V(1).Info("reconcile ends")
+
+	err := base.UpdateDatasetQuotaStatus(r.Client, req.NamespacedName)
+	if utils.IgnoreNotFound(err) != nil {
+		log.Error(err, "failed to update the status of the DatasetQuota")
+		return utils.RequeueIfError(err)
+	}
+	if err != nil {
+		return utils.NoRequeue()
+	}
+
+	// the workers are scaled in without notifying the DatasetQuota, so the usage is recalculated periodically
+	return utils.RequeueAfterInterval(r.ResyncPeriod)
+}
+
+// enqueueDatasetQuotas enqueues the DatasetQuotas in the namespace of the dataset
+func (r *DatasetQuotaReconciler) enqueueDatasetQuotas(ctx context.Context, obj client.Object) (requests []reconcile.Request) {
+	quotaList := &datav1alpha1.DatasetQuotaList{}
+	if err := r.List(ctx, quotaList, client.InNamespace(obj.GetNamespace())); err != nil {
+		r.Log.Error(err, "failed to list DatasetQuotas", "namespace", obj.GetNamespace())
+		return
+	}
+	for _, quota := range quotaList.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: quota.Namespace, Name: quota.Name},
+		})
+	}
+	return
+}
+
+func (r *DatasetQuotaReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		For(&datav1alpha1.DatasetQuota{}).
+		Watches(&datav1alpha1.Dataset{}, handler.EnqueueRequestsFromMapFunc(r.enqueueDatasetQuotas)).
+		Complete(r)
+}
+
+func (r *DatasetQuotaReconciler) ControllerName() string {
+	return controllerName
+}
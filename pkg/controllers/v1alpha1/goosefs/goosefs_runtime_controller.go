@@ -69,6 +69,8 @@ func NewRuntimeReconciler(client client.Client,
 // Reconcile reconciles goosefs runtime
 // +kubebuilder:rbac:groups=data.fluid.io,resources=goosefsruntimes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=data.fluid.io,resources=goosefsruntimes/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=data.fluid.io,resources=datasetquotas,verbs=get;list;watch
+// +kubebuilder:rbac:groups=data.fluid.io,resources=datasetquotas/status,verbs=get;update;patch
 
 func (r *RuntimeReconciler) Reconcile(context context.Context, req ctrl.Request) (ctrl.Result, error) {
 	ctx := cruntime.ReconcileRequestContext{
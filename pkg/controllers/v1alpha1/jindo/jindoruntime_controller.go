@@ -72,6 +72,8 @@ func NewRuntimeReconciler(client client.Client,
 //Reconcile reconciles jindo runtime
 // +kubebuilder:rbac:groups=data.fluid.io,resources=jindoruntimes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=data.fluid.io,resources=jindoruntimes/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=data.fluid.io,resources=datasetquotas,verbs=get;list;watch
+// +kubebuilder:rbac:groups=data.fluid.io,resources=datasetquotas/status,verbs=get;update;patch
 
 func (r *RuntimeReconciler) Reconcile(context context.Context, req ctrl.Request) (ctrl.Result, error) {
 	defer utils.TimeTrack(time.Now(), "Reconcile JindoRuntime", "request", req)
@@ -87,6 +87,8 @@ func NewRuntimeReconciler(client client.Client,
 
 //+kubebuilder:rbac:groups=data.fluid.io,resources=juicefsruntimes,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=data.fluid.io,resources=juicefsruntimes/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=data.fluid.io,resources=datasetquotas,verbs=get;list;watch
+//+kubebuilder:rbac:groups=data.fluid.io,resources=datasetquotas/status,verbs=get;update;patch
 
 func (r *JuiceFSRuntimeReconciler) Reconcile(context context.Context, req ctrl.Request) (ctrl.Result, error) {
 	defer utils.TimeTrack(time.Now(), "Reconcile", "request", req)
@@ -37,5 +37,10 @@ func (r *WebhookReconciler) Reconcile(context.Context, ctrl.Request) (ctrl.Resul
 	if err != nil {
 		return utils.RequeueAfterInterval(10 * time.Second)
 	}
+	// patch ca of ValidatingWebhookConfiguration
+	err = r.CertBuilder.PatchValidatingCABundle(r.WebhookName, r.CaCert)
+	if err != nil {
+		return utils.RequeueAfterInterval(10 * time.Second)
+	}
 	return utils.NoRequeue()
 }
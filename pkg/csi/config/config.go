@@ -29,4 +29,7 @@ type Config struct {
 	PruneFs           []string
 	PrunePath         string
 	KubeletConfigPath string
+	// ControllerOnly serves the controller service alone for dynamic provisioning, without the node service and
+	// the components working on the node
+	ControllerOnly bool
 }
@@ -25,10 +25,15 @@ import (
 const (
 	// FuseRecovery enables FUSE recovery automatically in fluid agent
 	FuseRecovery featuregate.Feature = "FuseRecovery"
+
+	// FuseSelfHealing enables FUSE recovery to restart the FUSE pod when its mount point is disconnected,
+	// which takes effect only when FuseRecovery is enabled
+	FuseSelfHealing featuregate.Feature = "FuseSelfHealing"
 )
 
 var defaultFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
-	FuseRecovery: {Default: false, PreRelease: featuregate.Beta},
+	FuseRecovery:    {Default: false, PreRelease: featuregate.Beta},
+	FuseSelfHealing: {Default: false, PreRelease: featuregate.Alpha},
 }
 
 func init() {
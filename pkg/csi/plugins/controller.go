@@ -28,10 +28,23 @@ import (
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/dataset/volume"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
 )
 
 type controllerServer struct {
 	*csicommon.DefaultControllerServer
+	client    client.Client
+	apiReader client.Reader
 }
 
 func (cs *controllerServer) ControllerGetVolume(ctx context.Context, request *csi.ControllerGetVolumeRequest) (*csi.ControllerGetVolumeResponse, error) {
@@ -39,9 +52,9 @@ func (cs *controllerServer) ControllerGetVolume(ctx context.Context, request *cs
 }
 
 func (cs *controllerServer) CreateVolume(ctx context.Context, req *csi.CreateVolumeRequest) (*csi.CreateVolumeResponse, error) {
-	volumeID := sanitizeVolumeID(req.GetName())
+	name := sanitizeVolumeID(req.GetName())
 
-	glog.Infof("volumeID %v", volumeID)
+	glog.Infof("volume name %v", name)
 
 	if err := cs.Driver.ValidateControllerServiceRequest(csi.ControllerServiceCapability_RPC_CREATE_DELETE_VOLUME); err != nil {
 		glog.Infof("invalid create volume req: %v", req)
@@ -49,25 +62,115 @@ func (cs *controllerServer) CreateVolume(ctx context.Context, req *csi.CreateVol
 	}
 
 	// Check arguments
-	if len(volumeID) == 0 {
+	if len(name) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "Name missing in request")
 	}
 	if req.GetVolumeCapabilities() == nil {
 		return nil, status.Error(codes.InvalidArgument, "Volume Capabilities missing in request")
 	}
 
+	params, err := parseProvisionParameters(req.GetParameters())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	capacityBytes := int64(req.GetCapacityRange().GetRequiredBytes())
 
-	glog.V(4).Infof("Creating volume %s", volumeID)
+	glog.V(4).Infof("Creating volume %s with %s runtime in namespace %s", name, params.runtimeType, params.namespace)
+	// 1. create the dataset and the runtime, which are expected to be created in the previous calls when retrying
+	dataset := newDatasetForVolume(name, params, getAccessModes(req.GetVolumeCapabilities()))
+	if err = cs.client.Create(ctx, dataset); utils.IgnoreAlreadyExists(err) != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create dataset %s/%s: %v", params.namespace, name, err)
+	}
+
+	runtime, err := newRuntimeForVolume(name, params)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err = cs.client.Create(ctx, runtime); utils.IgnoreAlreadyExists(err) != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create %s runtime %s/%s: %v", params.runtimeType, params.namespace, name, err)
+	}
+
+	// 2. wait for the dataset bound and its persistent volume created by the runtime controller
+	pv, err := cs.waitForVolumeReady(ctx, name, params.namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.DeadlineExceeded, "dataset %s/%s is not ready: %v", params.namespace, name, err)
+	}
+
+	// 3. label the claim with the dataset, so that it is recognized as a dataset pvc like the ones
+	// wrapped by the thin runtime, e.g. by the webhook injecting the fuse
+	if err = cs.labelClaimWithDataset(params.pvcName, params.namespace, name); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to label pvc %s/%s with dataset %s: %v", params.namespace, params.pvcName, name, err)
+	}
+
+	// 4. reuse the volume attributes of the persistent volume of the dataset, so that the node server
+	// publishes the dynamically provisioned volume in the same way
+	volumeContext := map[string]string{}
+	for key, value := range pv.Spec.CSI.VolumeAttributes {
+		volumeContext[key] = value
+	}
+
 	return &csi.CreateVolumeResponse{
 		Volume: &csi.Volume{
-			VolumeId:      volumeID,
+			// The volume id is the same as the volume handle of the persistent volume of the dataset, so that
+			// the dataset is resolved by volume id in the same way as the statically bound volumes.
+			VolumeId:      pv.Spec.CSI.VolumeHandle,
 			CapacityBytes: capacityBytes,
-			VolumeContext: req.GetParameters(),
+			VolumeContext: volumeContext,
 		},
 	}, nil
 }
 
+// waitForVolumeReady waits until the dataset is bound and the persistent volume of the dataset is created
+func (cs *controllerServer) waitForVolumeReady(ctx context.Context, name, namespace string) (pv *corev1.PersistentVolume, err error) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, volumeProvisionTimeout)
+	defer cancel()
+
+	pollErr := wait.PollUntilContextCancel(timeoutCtx, volumeProvisionInterval, true, func(ctx context.Context) (done bool, err error) {
+		dataset, err := utils.GetDataset(cs.apiReader, name, namespace)
+		if err != nil {
+			return false, utils.IgnoreNotFound(err)
+		}
+		if dataset.Status.Phase != datav1alpha1.BoundDatasetPhase {
+			glog.V(4).Infof("dataset %s/%s is in phase %q, waiting for it bound", namespace, name, dataset.Status.Phase)
+			return false, nil
+		}
+
+		runtimeInfo, err := base.GetRuntimeInfo(cs.apiReader, name, namespace)
+		if err != nil {
+			return false, err
+		}
+		pv, err = kubeclient.GetPersistentVolume(cs.apiReader, runtimeInfo.GetPersistentVolumeName())
+		if err != nil {
+			return false, utils.IgnoreNotFound(err)
+		}
+		return pv.Spec.CSI != nil, nil
+	})
+
+	return pv, pollErr
+}
+
+// labelClaimWithDataset labels the claim of the dynamically provisioned volume with the name of the dataset
+func (cs *controllerServer) labelClaimWithDataset(pvcName, namespace, datasetName string) error {
+	if len(pvcName) == 0 {
+		return nil
+	}
+
+	pvc, err := kubeclient.GetPersistentVolumeClaim(cs.apiReader, pvcName, namespace)
+	if err != nil {
+		return err
+	}
+
+	if pvc.Labels[common.LabelAnnotationManagedBy] == datasetName {
+		return nil
+	}
+
+	labelsToModify := common.LabelsToModify{}
+	labelsToModify.Add(common.LabelAnnotationManagedBy, datasetName)
+	_, err = utils.PatchLabels(cs.client, pvc, labelsToModify)
+	return err
+}
+
 func (cs *controllerServer) DeleteVolume(ctx context.Context, req *csi.DeleteVolumeRequest) (*csi.DeleteVolumeResponse, error) {
 	volumeID := req.GetVolumeId()
 
@@ -82,6 +185,46 @@ func (cs *controllerServer) DeleteVolume(ctx context.Context, req *csi.DeleteVol
 	}
 	glog.V(4).Infof("Deleting volume %s", volumeID)
 
+	namespace, name, err := volume.GetNamespacedNameByVolumeId(cs.apiReader, volumeID)
+	if err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			glog.Infof("persistent volume %s of the dataset is already deleted", volumeID)
+			return &csi.DeleteVolumeResponse{}, nil
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get dataset by volume id %s: %v", volumeID, err)
+	}
+
+	dataset, err := utils.GetDataset(cs.apiReader, name, namespace)
+	if err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			glog.Infof("dataset %s/%s of volume %s is already deleted", namespace, name, volumeID)
+			return &csi.DeleteVolumeResponse{}, nil
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get dataset %s/%s: %v", namespace, name, err)
+	}
+
+	// Only tear down the dataset and the runtime provisioned by the csi driver
+	if dataset.Labels[common.LabelAnnotationProvisionedBy] != driverName {
+		glog.Warningf("dataset %s/%s is not provisioned by %s, skip deleting it", namespace, name, driverName)
+		return &csi.DeleteVolumeResponse{}, nil
+	}
+
+	for _, runtimeType := range provisionableRuntimeTypes {
+		runtime, err := newRuntimeObject(runtimeType)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		runtime.SetName(name)
+		runtime.SetNamespace(namespace)
+		if err = cs.client.Delete(ctx, runtime); utils.IgnoreNotFound(err) != nil && !meta.IsNoMatchError(err) {
+			return nil, status.Errorf(codes.Internal, "failed to delete %s runtime %s/%s: %v", runtimeType, namespace, name, err)
+		}
+	}
+
+	if err = cs.client.Delete(ctx, dataset); utils.IgnoreNotFound(err) != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete dataset %s/%s: %v", namespace, name, err)
+	}
+
 	return &csi.DeleteVolumeResponse{}, nil
 }
 
@@ -0,0 +1,317 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+	"testing"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	csicommon "github.com/kubernetes-csi/drivers/pkg/csi-common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+var testScheme *runtime.Scheme
+
+func init() {
+	testScheme = runtime.NewScheme()
+	_ = corev1.AddToScheme(testScheme)
+	_ = datav1alpha1.AddToScheme(testScheme)
+}
+
+func newTestControllerServer(objs ...runtime.Object) *controllerServer {
+	csiDriver := csicommon.NewCSIDriver(driverName, version, "test-node")
+	csiDriver.AddControllerServiceCapabilities([]csi.ControllerServiceCapability_RPC_Type{csi.ControllerServiceCapability_RPC_CREATE_DELETE_VOLUME})
+
+	c := fake.NewFakeClientWithScheme(testScheme, objs...)
+	return &controllerServer{
+		DefaultControllerServer: csicommon.NewDefaultControllerServer(csiDriver),
+		client:                  c,
+		apiReader:               c,
+	}
+}
+
+func TestParseProvisionParameters(t *testing.T) {
+	tests := []struct {
+		name            string
+		params          map[string]string
+		wantErr         bool
+		wantRuntimeType string
+	}{
+		{
+			name: "thin_runtime_by_default",
+			params: map[string]string{
+				common.VolumeParamPVCNamespace: "default",
+				common.VolumeParamProfileName:  "nfs",
+				common.VolumeParamMountPoint:   "nfs://nfs-server/data",
+			},
+			wantRuntimeType: common.ThinRuntime,
+		},
+		{
+			name: "alluxio_runtime",
+			params: map[string]string{
+				common.VolumeParamPVCNamespace:  "default",
+				common.VolumeParamRuntimeType:   common.AlluxioRuntime,
+				common.VolumeParamMountPoint:    "oss://bucket/data",
+				common.VolumeParamCacheCapacity: "2Gi",
+			},
+			wantRuntimeType: common.AlluxioRuntime,
+		},
+		{
+			name: "missing_pvc_namespace",
+			params: map[string]string{
+				common.VolumeParamProfileName: "nfs",
+				common.VolumeParamMountPoint:  "nfs://nfs-server/data",
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing_profile_name",
+			params: map[string]string{
+				common.VolumeParamPVCNamespace: "default",
+				common.VolumeParamMountPoint:   "nfs://nfs-server/data",
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing_cache_capacity",
+			params: map[string]string{
+				common.VolumeParamPVCNamespace: "default",
+				common.VolumeParamRuntimeType:  common.JuiceFSRuntime,
+				common.VolumeParamMountPoint:   "juicefs:///data",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid_cache_capacity",
+			params: map[string]string{
+				common.VolumeParamPVCNamespace:  "default",
+				common.VolumeParamRuntimeType:   common.AlluxioRuntime,
+				common.VolumeParamMountPoint:    "oss://bucket/data",
+				common.VolumeParamCacheCapacity: "2xx",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unsupported_runtime",
+			params: map[string]string{
+				common.VolumeParamPVCNamespace:  "default",
+				common.VolumeParamRuntimeType:   common.VineyardRuntime,
+				common.VolumeParamMountPoint:    "oss://bucket/data",
+				common.VolumeParamCacheCapacity: "2Gi",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseProvisionParameters(tt.params)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseProvisionParameters() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.runtimeType != tt.wantRuntimeType {
+				t.Errorf("parseProvisionParameters() runtime type = %v, want %v", got.runtimeType, tt.wantRuntimeType)
+			}
+		})
+	}
+}
+
+func TestCreateVolume(t *testing.T) {
+	req := &csi.CreateVolumeRequest{
+		Name: "pvc-0e6a0e41",
+		VolumeCapabilities: []*csi.VolumeCapability{{
+			AccessMode: &csi.VolumeCapability_AccessMode{Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_READER_ONLY},
+		}},
+		CapacityRange: &csi.CapacityRange{RequiredBytes: 1024},
+		Parameters: map[string]string{
+			common.VolumeParamPVCNamespace: "default",
+			common.VolumeParamPVCName:      "data",
+			common.VolumeParamProfileName:  "nfs",
+			common.VolumeParamMountPoint:   "nfs://nfs-server/data",
+		},
+	}
+
+	t.Run("bound", func(t *testing.T) {
+		volumeAttributes := map[string]string{
+			common.VolumeAttrFluidPath: "/runtime-mnt/thin/default/pvc-0e6a0e41/thin-fuse",
+			common.VolumeAttrMountType: common.ThinRuntime,
+			common.VolumeAttrNamespace: "default",
+			common.VolumeAttrName:      "pvc-0e6a0e41",
+		}
+		cs := newTestControllerServer(
+			&datav1alpha1.Dataset{
+				ObjectMeta: metav1.ObjectMeta{Name: "pvc-0e6a0e41", Namespace: "default"},
+				Status: datav1alpha1.DatasetStatus{
+					Phase:    datav1alpha1.BoundDatasetPhase,
+					Runtimes: []datav1alpha1.Runtime{{Name: "pvc-0e6a0e41", Namespace: "default", Type: common.ThinRuntime, Category: common.AccelerateCategory}},
+				},
+			},
+			&datav1alpha1.ThinRuntime{ObjectMeta: metav1.ObjectMeta{Name: "pvc-0e6a0e41", Namespace: "default"}},
+			&corev1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: "default-pvc-0e6a0e41", Annotations: common.GetExpectedFluidAnnotations()},
+				Spec: corev1.PersistentVolumeSpec{
+					PersistentVolumeSource: corev1.PersistentVolumeSource{
+						CSI: &corev1.CSIPersistentVolumeSource{Driver: common.CSIDriver, VolumeHandle: "default-pvc-0e6a0e41", VolumeAttributes: volumeAttributes},
+					},
+				},
+			},
+			&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "default"}},
+		)
+
+		resp, err := cs.CreateVolume(context.TODO(), req)
+		if err != nil {
+			t.Fatalf("CreateVolume() error = %v", err)
+		}
+		if resp.Volume.VolumeId != "default-pvc-0e6a0e41" {
+			t.Errorf("CreateVolume() volume id = %v, want default-pvc-0e6a0e41", resp.Volume.VolumeId)
+		}
+		for key, value := range volumeAttributes {
+			if resp.Volume.VolumeContext[key] != value {
+				t.Errorf("CreateVolume() volume context %s = %v, want %v", key, resp.Volume.VolumeContext[key], value)
+			}
+		}
+
+		pvc := &corev1.PersistentVolumeClaim{}
+		if err = cs.client.Get(context.TODO(), types.NamespacedName{Name: "data", Namespace: "default"}, pvc); err != nil {
+			t.Fatalf("failed to get pvc: %v", err)
+		}
+		if !kubeclient.CheckIfPVCIsDataset(pvc) || pvc.Labels[common.LabelAnnotationManagedBy] != "pvc-0e6a0e41" {
+			t.Errorf("pvc labels = %v, want managed by dataset pvc-0e6a0e41", pvc.Labels)
+		}
+	})
+
+	t.Run("not_bound", func(t *testing.T) {
+		cs := newTestControllerServer()
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+
+		_, err := cs.CreateVolume(ctx, req)
+		if status.Code(err) != codes.DeadlineExceeded {
+			t.Fatalf("CreateVolume() error = %v, want DeadlineExceeded", err)
+		}
+
+		dataset, err := utils.GetDataset(cs.client, "pvc-0e6a0e41", "default")
+		if err != nil {
+			t.Fatalf("failed to get dataset: %v", err)
+		}
+		if dataset.Labels[common.LabelAnnotationProvisionedBy] != driverName {
+			t.Errorf("dataset labels = %v, want provisioned by %s", dataset.Labels, driverName)
+		}
+		if len(dataset.Spec.AccessModes) != 1 || dataset.Spec.AccessModes[0] != corev1.ReadOnlyMany {
+			t.Errorf("dataset access modes = %v, want [ReadOnlyMany]", dataset.Spec.AccessModes)
+		}
+
+		runtime := &datav1alpha1.ThinRuntime{}
+		if err = cs.client.Get(context.TODO(), types.NamespacedName{Name: "pvc-0e6a0e41", Namespace: "default"}, runtime); err != nil {
+			t.Fatalf("failed to get runtime: %v", err)
+		}
+		if runtime.Spec.ThinRuntimeProfileName != "nfs" {
+			t.Errorf("runtime profile = %v, want nfs", runtime.Spec.ThinRuntimeProfileName)
+		}
+	})
+
+	t.Run("invalid_parameters", func(t *testing.T) {
+		cs := newTestControllerServer()
+		_, err := cs.CreateVolume(context.TODO(), &csi.CreateVolumeRequest{
+			Name:               "pvc-0e6a0e41",
+			VolumeCapabilities: req.VolumeCapabilities,
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("CreateVolume() error = %v, want InvalidArgument", err)
+		}
+	})
+}
+
+func TestDeleteVolume(t *testing.T) {
+	provisionedLabels := map[string]string{common.LabelAnnotationProvisionedBy: driverName}
+	// the persistent volume and claim created by the runtime controller for the dataset
+	datasetPV := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-pvc-0e6a0e41"},
+		Spec: corev1.PersistentVolumeSpec{
+			ClaimRef: &corev1.ObjectReference{Name: "pvc-0e6a0e41", Namespace: "default"},
+		},
+	}
+	datasetPVC := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pvc-0e6a0e41",
+			Namespace: "default",
+			Labels:    map[string]string{common.LabelAnnotationStorageCapacityPrefix + "default-pvc-0e6a0e41": "true"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		volumeID    string
+		objs        []runtime.Object
+		wantDeleted bool
+	}{
+		{
+			name:     "provisioned",
+			volumeID: "default-pvc-0e6a0e41",
+			objs: []runtime.Object{
+				datasetPV.DeepCopy(),
+				datasetPVC.DeepCopy(),
+				&datav1alpha1.Dataset{ObjectMeta: metav1.ObjectMeta{Name: "pvc-0e6a0e41", Namespace: "default", Labels: provisionedLabels}},
+				&datav1alpha1.ThinRuntime{ObjectMeta: metav1.ObjectMeta{Name: "pvc-0e6a0e41", Namespace: "default", Labels: provisionedLabels}},
+			},
+			wantDeleted: true,
+		},
+		{
+			name:     "not_provisioned",
+			volumeID: "default-pvc-0e6a0e41",
+			objs: []runtime.Object{
+				datasetPV.DeepCopy(),
+				datasetPVC.DeepCopy(),
+				&datav1alpha1.Dataset{ObjectMeta: metav1.ObjectMeta{Name: "pvc-0e6a0e41", Namespace: "default"}},
+				&datav1alpha1.ThinRuntime{ObjectMeta: metav1.ObjectMeta{Name: "pvc-0e6a0e41", Namespace: "default"}},
+			},
+			wantDeleted: false,
+		},
+		{
+			name:        "already_deleted",
+			volumeID:    "default-pvc-0e6a0e41",
+			wantDeleted: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs := newTestControllerServer(tt.objs...)
+			if _, err := cs.DeleteVolume(context.TODO(), &csi.DeleteVolumeRequest{VolumeId: tt.volumeID}); err != nil {
+				t.Fatalf("DeleteVolume() error = %v", err)
+			}
+
+			for _, obj := range []client.Object{&datav1alpha1.Dataset{}, &datav1alpha1.ThinRuntime{}} {
+				err := cs.client.Get(context.TODO(), types.NamespacedName{Name: "pvc-0e6a0e41", Namespace: "default"}, obj)
+				if deleted := utils.IgnoreNotFound(err) == nil && err != nil; deleted != tt.wantDeleted {
+					t.Errorf("%T deleted = %v, want %v", obj, deleted, tt.wantDeleted)
+				}
+			}
+		})
+	}
+}
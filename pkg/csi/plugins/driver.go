@@ -44,6 +44,8 @@ type driver struct {
 	nodeAuthorizedClient *kubernetes.Clientset
 	csiDriver            *csicommon.CSIDriver
 	nodeId, endpoint     string
+	// controllerOnly serves the identity and controller services without the node service
+	controllerOnly bool
 
 	locks *utils.VolumeLocks
 }
@@ -85,6 +87,8 @@ func NewDriver(nodeID, endpoint string, client client.Client, apiReader client.R
 func (d *driver) newControllerServer() *controllerServer {
 	return &controllerServer{
 		DefaultControllerServer: csicommon.NewDefaultControllerServer(d.csiDriver),
+		client:                  d.client,
+		apiReader:               d.apiReader,
 	}
 }
 
@@ -100,12 +104,17 @@ func (d *driver) newNodeServer() *nodeServer {
 }
 
 func (d *driver) run() {
+	var ns csi.NodeServer
+	if !d.controllerOnly {
+		ns = d.newNodeServer()
+	}
+
 	s := csicommon.NewNonBlockingGRPCServer()
 	s.Start(
 		d.endpoint,
 		csicommon.NewDefaultIdentityServer(d.csiDriver),
 		d.newControllerServer(),
-		d.newNodeServer(),
+		ns,
 	)
 	s.Wait()
 }
@@ -0,0 +1,275 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/dataset/volume"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+// ephemeralVolumeStateDir keeps the dataset of each published ephemeral volume, because NodeUnpublishVolume is not
+// given the volume context. It is in the plugin directory on the host, so the states survive restarts of the plugin.
+var ephemeralVolumeStateDir = "/plugin/ephemeral-volumes"
+
+// isEphemeralVolume checks if the volume is a CSI ephemeral inline volume
+func isEphemeralVolume(volumeContext map[string]string) bool {
+	return volumeContext[common.VolumeAttrEphemeral] == "true"
+}
+
+// getEphemeralDatasetNamespacedName parses the namespace and name of the dataset referred by the ephemeral volume.
+// The dataset defaults to the namespace of the pod, and datasets in other namespaces are not allowed to be mounted,
+// which is the same as mounting the dataset through its persistent volume claim.
+func getEphemeralDatasetNamespacedName(volumeContext map[string]string) (namespace string, name string, err error) {
+	podNamespace := volumeContext[common.VolumeAttrPodNamespace]
+	dataset := volumeContext[common.VolumeAttrDataset]
+	if len(dataset) == 0 {
+		return "", "", status.Errorf(codes.InvalidArgument, "volume attribute %s is required by ephemeral volume", common.VolumeAttrDataset)
+	}
+
+	namespace, name = podNamespace, dataset
+	if items := strings.Split(dataset, "/"); len(items) == 2 {
+		namespace, name = items[0], items[1]
+	}
+	if len(namespace) == 0 || len(name) == 0 {
+		return "", "", status.Errorf(codes.InvalidArgument, "invalid dataset %q of ephemeral volume, expect <name> or <namespace>/<name>", dataset)
+	}
+
+	if namespace != podNamespace {
+		return "", "", status.Errorf(codes.PermissionDenied, "dataset %s/%s is not allowed to be mounted by the pod in namespace %q", namespace, name, podNamespace)
+	}
+
+	return namespace, name, nil
+}
+
+// prepareEphemeralVolume resolves the dataset of the ephemeral volume, labels the node to launch the FUSE pod,
+// and returns the volume context in the same format as the persistent volume of the dataset.
+func (ns *nodeServer) prepareEphemeralVolume(volumeId string, volumeContext map[string]string) (map[string]string, error) {
+	namespace, name, err := getEphemeralDatasetNamespacedName(volumeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	// reject sub paths escaping from the mount point of the dataset
+	subPath := strings.Trim(volumeContext[common.VolumeAttrSubPath], "/")
+	for _, item := range strings.Split(subPath, "/") {
+		if item == ".." {
+			return nil, status.Errorf(codes.InvalidArgument, "sub path %q of ephemeral volume must not contain \"..\"", subPath)
+		}
+	}
+
+	runtimeInfo, err := base.GetRuntimeInfo(ns.client, name, namespace)
+	if err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			return nil, status.Errorf(codes.NotFound, "dataset %s/%s of ephemeral volume %s is not found or not bound", namespace, name, volumeId)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get runtime info for %s/%s: %v", namespace, name, err)
+	}
+
+	// The persistent volume of the dataset is created by the runtime controller once the dataset is bound
+	pv, err := kubeclient.GetPersistentVolume(ns.apiReader, runtimeInfo.GetPersistentVolumeName())
+	if err != nil || pv.Spec.CSI == nil {
+		return nil, status.Errorf(codes.Unavailable, "persistent volume of dataset %s/%s is not ready: %v", namespace, name, err)
+	}
+
+	// The lock is the one of the persistent volume of the dataset, so that labeling the node is serialized with
+	// NodeUnstageVolume and the cleanup of the other ephemeral volumes of the dataset
+	pvName := runtimeInfo.GetPersistentVolumeName()
+	if lock := ns.locks.TryAcquire(pvName); !lock {
+		return nil, status.Errorf(codes.Aborted, "operation on volume %s of dataset %s/%s already exists", pvName, namespace, name)
+	}
+	defer ns.locks.Release(pvName)
+
+	// the state is saved before labeling the node, so that the FUSE is always cleaned up by NodeUnpublishVolume
+	if err = saveEphemeralVolumeState(volumeId, namespace, name); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	fuseLabelKey, err := ns.labelNodeForFuse(runtimeInfo)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	glog.Infof("NodePublishVolume: prepared ephemeral volume %s of dataset %s/%s, and added NodeLabel: %s", volumeId, namespace, name, fuseLabelKey)
+
+	resolved := map[string]string{}
+	for key, value := range pv.Spec.CSI.VolumeAttributes {
+		resolved[key] = value
+	}
+	if len(subPath) > 0 {
+		resolved[common.VolumeAttrFluidSubPath] = subPath
+	}
+	resolved[common.VolumeAttrReadOnly] = volumeContext[common.VolumeAttrReadOnly]
+
+	return resolved, nil
+}
+
+// cleanEphemeralVolume cleans the FUSE of the dataset referred by the ephemeral volume in the same way as
+// NodeUnstageVolume, which is not called for CSI ephemeral inline volumes. It does nothing for persistent volumes.
+func (ns *nodeServer) cleanEphemeralVolume(volumeId string) error {
+	namespace, name, found, err := loadEphemeralVolumeState(volumeId)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil
+	}
+
+	runtimeInfo, err := base.GetRuntimeInfo(ns.client, name, namespace)
+	if err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			// For cases like the dataset or runtime has been deleted, the FUSE is cleaned up with the runtime
+			glog.Warningf("NodeUnpublishVolume: dataset or runtime %s/%s of ephemeral volume %s not found, maybe it's already cleaned up", namespace, name, volumeId)
+			return removeEphemeralVolumeState(volumeId)
+		}
+		return status.Errorf(codes.Internal, "NodeUnpublishVolume: failed to get runtime info for %s/%s: %v", namespace, name, err)
+	}
+
+	pvName := runtimeInfo.GetPersistentVolumeName()
+	if lock := ns.locks.TryAcquire(pvName); !lock {
+		return status.Errorf(codes.Aborted, "operation on volume %s of dataset %s/%s already exists", pvName, namespace, name)
+	}
+	defer ns.locks.Release(pvName)
+
+	var latestFuseGeneration string
+	if pvc, err := volume.GetFusePVCOfDataset(ns.apiReader, name, namespace); err == nil {
+		latestFuseGeneration = pvc.Labels[common.LabelRuntimeFuseGeneration]
+	}
+
+	shouldCleanFuse, err := needCleanFuse(runtimeInfo, latestFuseGeneration)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if shouldCleanFuse {
+		// the FUSE is kept for the pods still mounting the dataset on the node, the last one cleans it up
+		inUse, err := checkMountInUse(pvName)
+		if err != nil {
+			return status.Errorf(codes.Internal, "NodeUnpublishVolume: can't check mount in use: %v", err)
+		}
+		if !inUse {
+			inUse, err = hasOtherEphemeralVolumes(namespace, name, volumeId)
+			if err != nil {
+				return status.Errorf(codes.Internal, "NodeUnpublishVolume: can't check ephemeral volumes in use: %v", err)
+			}
+		}
+
+		if inUse {
+			glog.Infof("NodeUnpublishVolume: dataset %s/%s is still in use on the node, skip cleaning its fuse", namespace, name)
+		} else if err = ns.removeFuseLabel(runtimeInfo); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return removeEphemeralVolumeState(volumeId)
+}
+
+// getEphemeralVolumeStatePath returns the path of the state file of the ephemeral volume
+func getEphemeralVolumeStatePath(volumeId string) (string, error) {
+	if len(volumeId) == 0 || volumeId == "." || volumeId == ".." || strings.ContainsAny(volumeId, "/\\") {
+		return "", errors.Errorf("invalid volume id %q of ephemeral volume", volumeId)
+	}
+	return filepath.Join(ephemeralVolumeStateDir, volumeId), nil
+}
+
+// saveEphemeralVolumeState records the namespace and name of the dataset referred by the ephemeral volume
+func saveEphemeralVolumeState(volumeId, namespace, name string) error {
+	statePath, err := getEphemeralVolumeStatePath(volumeId)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(ephemeralVolumeStateDir, 0750); err != nil {
+		return errors.Wrapf(err, "failed to create the state directory of ephemeral volumes")
+	}
+	if err = os.WriteFile(statePath, []byte(namespace+"/"+name), 0600); err != nil {
+		return errors.Wrapf(err, "failed to save the state of ephemeral volume %s", volumeId)
+	}
+	return nil
+}
+
+// loadEphemeralVolumeState returns the namespace and name of the dataset referred by the ephemeral volume,
+// found is false if the volume is not an ephemeral volume published by the node.
+func loadEphemeralVolumeState(volumeId string) (namespace, name string, found bool, err error) {
+	statePath, err := getEphemeralVolumeStatePath(volumeId)
+	if err != nil {
+		// the volume id of persistent volumes is not limited, which is never an ephemeral volume
+		return "", "", false, nil
+	}
+
+	content, err := os.ReadFile(statePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", "", false, nil
+		}
+		return "", "", false, errors.Wrapf(err, "failed to load the state of ephemeral volume %s", volumeId)
+	}
+
+	items := strings.Split(string(content), "/")
+	if len(items) != 2 {
+		return "", "", false, errors.Errorf("invalid state %q of ephemeral volume %s", string(content), volumeId)
+	}
+	return items[0], items[1], true, nil
+}
+
+// removeEphemeralVolumeState removes the state of the ephemeral volume once its FUSE is cleaned up
+func removeEphemeralVolumeState(volumeId string) error {
+	statePath, err := getEphemeralVolumeStatePath(volumeId)
+	if err != nil {
+		return err
+	}
+
+	if err = os.Remove(statePath); err != nil && !os.IsNotExist(err) {
+		return status.Errorf(codes.Internal, "failed to remove the state of ephemeral volume %s: %v", volumeId, err)
+	}
+	return nil
+}
+
+// hasOtherEphemeralVolumes checks if the dataset is referred by ephemeral volumes other than the given one on the node
+func hasOtherEphemeralVolumes(namespace, name, volumeId string) (bool, error) {
+	entries, err := os.ReadDir(ephemeralVolumeStateDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || entry.Name() == volumeId {
+			continue
+		}
+		otherNamespace, otherName, found, err := loadEphemeralVolumeState(entry.Name())
+		if err != nil {
+			return false, err
+		}
+		if found && otherNamespace == namespace && otherName == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
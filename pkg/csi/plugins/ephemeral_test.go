@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestGetEphemeralDatasetNamespacedName(t *testing.T) {
+	tests := []struct {
+		name          string
+		volumeContext map[string]string
+		wantNamespace string
+		wantName      string
+		wantCode      codes.Code
+	}{
+		{
+			name:          "dataset_name",
+			volumeContext: map[string]string{common.VolumeAttrPodNamespace: "default", common.VolumeAttrDataset: "hbase"},
+			wantNamespace: "default",
+			wantName:      "hbase",
+			wantCode:      codes.OK,
+		},
+		{
+			name:          "dataset_namespaced_name",
+			volumeContext: map[string]string{common.VolumeAttrPodNamespace: "default", common.VolumeAttrDataset: "default/hbase"},
+			wantNamespace: "default",
+			wantName:      "hbase",
+			wantCode:      codes.OK,
+		},
+		{
+			name:          "missing_dataset",
+			volumeContext: map[string]string{common.VolumeAttrPodNamespace: "default"},
+			wantCode:      codes.InvalidArgument,
+		},
+		{
+			name:          "invalid_dataset",
+			volumeContext: map[string]string{common.VolumeAttrPodNamespace: "default", common.VolumeAttrDataset: "default/"},
+			wantCode:      codes.InvalidArgument,
+		},
+		{
+			name:          "dataset_in_other_namespace",
+			volumeContext: map[string]string{common.VolumeAttrPodNamespace: "default", common.VolumeAttrDataset: "fluid/hbase"},
+			wantCode:      codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			namespace, name, err := getEphemeralDatasetNamespacedName(tt.volumeContext)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("getEphemeralDatasetNamespacedName() error = %v, want code %v", err, tt.wantCode)
+			}
+			if namespace != tt.wantNamespace || name != tt.wantName {
+				t.Errorf("getEphemeralDatasetNamespacedName() = %s/%s, want %s/%s", namespace, name, tt.wantNamespace, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestPrepareEphemeralVolume(t *testing.T) {
+	volumeAttributes := map[string]string{
+		common.VolumeAttrFluidPath: "/runtime-mnt/thin/default/hbase/thin-fuse",
+		common.VolumeAttrMountType: common.ThinRuntime,
+		common.VolumeAttrNamespace: "default",
+		common.VolumeAttrName:      "hbase",
+	}
+	c := fake.NewFakeClientWithScheme(testScheme,
+		&datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"},
+			Status: datav1alpha1.DatasetStatus{
+				Phase:    datav1alpha1.BoundDatasetPhase,
+				Runtimes: []datav1alpha1.Runtime{{Name: "hbase", Namespace: "default", Type: common.ThinRuntime, Category: common.AccelerateCategory}},
+			},
+		},
+		&datav1alpha1.ThinRuntime{ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"}},
+		&corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: "default-hbase", Annotations: common.GetExpectedFluidAnnotations()},
+			Spec: corev1.PersistentVolumeSpec{
+				PersistentVolumeSource: corev1.PersistentVolumeSource{
+					CSI: &corev1.CSIPersistentVolumeSource{Driver: common.CSIDriver, VolumeAttributes: volumeAttributes},
+				},
+			},
+		},
+	)
+	ns := &nodeServer{client: c, apiReader: c, locks: utils.NewVolumeLocks()}
+	ephemeralVolumeStateDir = t.TempDir()
+
+	var labeled bool
+	patches := gomonkey.ApplyPrivateMethod(reflect.TypeOf(ns), "labelNodeForFuse", func(_ *nodeServer, _ base.RuntimeInfoInterface) (string, error) {
+		labeled = true
+		return "fluid.io/f-default-hbase", nil
+	})
+	defer patches.Reset()
+
+	t.Run("bound_dataset", func(t *testing.T) {
+		labeled = false
+		got, err := ns.prepareEphemeralVolume("csi-0e6a0e41", map[string]string{
+			common.VolumeAttrEphemeral:    "true",
+			common.VolumeAttrPodNamespace: "default",
+			common.VolumeAttrDataset:      "hbase",
+			common.VolumeAttrSubPath:      "/spark/",
+			common.VolumeAttrReadOnly:     "true",
+		})
+		if err != nil {
+			t.Fatalf("prepareEphemeralVolume() error = %v", err)
+		}
+		if !labeled {
+			t.Errorf("prepareEphemeralVolume() is expected to label the node for fuse")
+		}
+		for key, value := range volumeAttributes {
+			if got[key] != value {
+				t.Errorf("prepareEphemeralVolume() %s = %v, want %v", key, got[key], value)
+			}
+		}
+		if got[common.VolumeAttrFluidSubPath] != "spark" || got[common.VolumeAttrReadOnly] != "true" {
+			t.Errorf("prepareEphemeralVolume() = %v, want sub path spark and read only", got)
+		}
+		if namespace, name, found, _ := loadEphemeralVolumeState("csi-0e6a0e41"); !found || namespace != "default" || name != "hbase" {
+			t.Errorf("prepareEphemeralVolume() is expected to save the dataset of the volume, got %s/%s", namespace, name)
+		}
+	})
+
+	t.Run("dataset_not_found", func(t *testing.T) {
+		_, err := ns.prepareEphemeralVolume("csi-0e6a0e41", map[string]string{
+			common.VolumeAttrPodNamespace: "default",
+			common.VolumeAttrDataset:      "spark",
+		})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("prepareEphemeralVolume() error = %v, want NotFound", err)
+		}
+	})
+
+	t.Run("sub_path_escaped", func(t *testing.T) {
+		labeled = false
+		_, err := ns.prepareEphemeralVolume("csi-0e6a0e41", map[string]string{
+			common.VolumeAttrPodNamespace: "default",
+			common.VolumeAttrDataset:      "hbase",
+			common.VolumeAttrSubPath:      "spark/../../",
+		})
+		if status.Code(err) != codes.InvalidArgument || labeled {
+			t.Errorf("prepareEphemeralVolume() error = %v, want InvalidArgument without labeling the node", err)
+		}
+	})
+}
+
+func TestCleanEphemeralVolume(t *testing.T) {
+	c := fake.NewFakeClientWithScheme(testScheme,
+		&datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"},
+			Status: datav1alpha1.DatasetStatus{
+				Phase:    datav1alpha1.BoundDatasetPhase,
+				Runtimes: []datav1alpha1.Runtime{{Name: "hbase", Namespace: "default", Type: common.ThinRuntime, Category: common.AccelerateCategory}},
+			},
+		},
+		&datav1alpha1.ThinRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"},
+			Spec:       datav1alpha1.ThinRuntimeSpec{Fuse: datav1alpha1.ThinFuseSpec{CleanPolicy: datav1alpha1.OnDemandCleanPolicy}},
+		},
+	)
+	ns := &nodeServer{client: c, apiReader: c, locks: utils.NewVolumeLocks()}
+
+	var unlabeled bool
+	patches := gomonkey.ApplyPrivateMethod(reflect.TypeOf(ns), "removeFuseLabel", func(_ *nodeServer, _ base.RuntimeInfoInterface) error {
+		unlabeled = true
+		return nil
+	})
+	defer patches.Reset()
+	patches.ApplyFunc(checkMountInUse, func(_ string) (bool, error) {
+		return false, nil
+	})
+
+	tests := []struct {
+		name          string
+		states        map[string]string
+		volumeId      string
+		wantUnlabeled bool
+	}{
+		{
+			name:          "persistent_volume",
+			volumeId:      "default-hbase",
+			wantUnlabeled: false,
+		},
+		{
+			name:          "last_ephemeral_volume",
+			states:        map[string]string{"csi-0e6a0e41": "default/hbase", "csi-5d3c7f2a": "default/spark"},
+			volumeId:      "csi-0e6a0e41",
+			wantUnlabeled: true,
+		},
+		{
+			name:          "dataset_still_in_use",
+			states:        map[string]string{"csi-0e6a0e41": "default/hbase", "csi-5d3c7f2a": "default/hbase"},
+			volumeId:      "csi-0e6a0e41",
+			wantUnlabeled: false,
+		},
+		{
+			name:          "dataset_deleted",
+			states:        map[string]string{"csi-0e6a0e41": "default/spark"},
+			volumeId:      "csi-0e6a0e41",
+			wantUnlabeled: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ephemeralVolumeStateDir = t.TempDir()
+			for volumeId, dataset := range tt.states {
+				items := strings.Split(dataset, "/")
+				if err := saveEphemeralVolumeState(volumeId, items[0], items[1]); err != nil {
+					t.Fatalf("failed to save state: %v", err)
+				}
+			}
+			unlabeled = false
+
+			if err := ns.cleanEphemeralVolume(tt.volumeId); err != nil {
+				t.Fatalf("cleanEphemeralVolume() error = %v", err)
+			}
+			if unlabeled != tt.wantUnlabeled {
+				t.Errorf("cleanEphemeralVolume() unlabeled = %v, want %v", unlabeled, tt.wantUnlabeled)
+			}
+			if _, _, found, _ := loadEphemeralVolumeState(tt.volumeId); found {
+				t.Errorf("cleanEphemeralVolume() is expected to remove the state of volume %s", tt.volumeId)
+			}
+		})
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/utils/cmdguard"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/dataset/volume"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/mountinfo"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -54,6 +55,10 @@ const (
 	AllowPatchStaleNodeEnv = "ALLOW_PATCH_STALE_NODE"
 )
 
+// volumeStatsTimeout is how long NodeGetVolumeStats waits for statfs on the FUSE mount point,
+// statfs never returns if the FUSE process hangs.
+var volumeStatsTimeout = 10 * time.Second
+
 type nodeServer struct {
 	nodeId string
 	*csicommon.DefaultNodeServer
@@ -121,9 +126,22 @@ func (ns *nodeServer) NodePublishVolume(ctx context.Context, req *csi.NodePublis
 	   https://github.com/Alluxio/alluxio/blob/master/integration/fuse/bin/alluxio-fuse
 	*/
 
-	fluidPath := req.GetVolumeContext()[common.VolumeAttrFluidPath]
-	mountType := req.GetVolumeContext()[common.VolumeAttrMountType]
-	subPath := req.GetVolumeContext()[common.VolumeAttrFluidSubPath]
+	volumeContext := req.GetVolumeContext()
+	if isEphemeralVolume(volumeContext) {
+		// NodeStageVolume is not called for CSI ephemeral inline volumes, so resolve the dataset and prepare the FUSE here
+		volumeContext, err = ns.prepareEphemeralVolume(req.GetVolumeId(), volumeContext)
+		if err != nil {
+			return nil, err
+		}
+		if volumeContext[common.VolumeAttrReadOnly] == "true" {
+			readOnly = true
+			glog.Infof("NodePublishVolume: set the mount option readonly=%v for ephemeral volume", readOnly)
+		}
+	}
+
+	fluidPath := volumeContext[common.VolumeAttrFluidPath]
+	mountType := volumeContext[common.VolumeAttrMountType]
+	subPath := volumeContext[common.VolumeAttrFluidSubPath]
 
 	if fluidPath == "" {
 		// fluidPath = fmt.Sprintf("/mnt/%s", req.)
@@ -140,7 +158,7 @@ func (ns *nodeServer) NodePublishVolume(ctx context.Context, req *csi.NodePublis
 	}
 
 	// 1. Wait the runtime fuse ready and check the sub path existence
-	skipCheckMountReadyMountModeSelector, err := base.ParseMountModeSelectorFromStr(req.GetVolumeContext()[common.AnnotationSkipCheckMountReadyTarget])
+	skipCheckMountReadyMountModeSelector, err := base.ParseMountModeSelectorFromStr(volumeContext[common.AnnotationSkipCheckMountReadyTarget])
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -160,7 +178,7 @@ func (ns *nodeServer) NodePublishVolume(ctx context.Context, req *csi.NodePublis
 	}
 
 	// use symlink
-	if useSymlink(req) {
+	if useSymlink(volumeContext) {
 		if err := utils.CreateSymlink(targetPath, mountPath); err != nil {
 			return nil, err
 		}
@@ -216,6 +234,20 @@ func (ns *nodeServer) NodeUnpublishVolume(ctx context.Context, req *csi.NodeUnpu
 	}
 	defer ns.locks.Release(targetPath)
 
+	if err := unmountTargetPath(targetPath); err != nil {
+		return nil, err
+	}
+
+	// NodeUnstageVolume is not called for CSI ephemeral inline volumes, so clean the FUSE here
+	if err := ns.cleanEphemeralVolume(req.GetVolumeId()); err != nil {
+		return nil, err
+	}
+
+	return &csi.NodeUnpublishVolumeResponse{}, nil
+}
+
+// unmountTargetPath removes the symlink or umounts the bind mounts on the target path until it's cleaned up.
+func unmountTargetPath(targetPath string) error {
 	exists, err := utils.MountPathExists(targetPath)
 	// Four cases are possible here, CSI plugin should continue to umount target path for the first two cases:
 	// 1. exists=true, err=nil => meaning path exists.
@@ -224,21 +256,21 @@ func (ns *nodeServer) NodeUnpublishVolume(ctx context.Context, req *csi.NodeUnpu
 	// 4. exists=false, err!=nil => meaning failure to check whether path exists, return ERR.
 	if !exists {
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "NodeUnpublishVolume: failed to check if path %s exists: %v", targetPath, err)
+			return status.Errorf(codes.Internal, "NodeUnpublishVolume: failed to check if path %s exists: %v", targetPath, err)
 		}
 		glog.V(0).Infof("NodeUnpublishVolume: succeed because target path %s doesn't exist", targetPath)
-		return &csi.NodeUnpublishVolumeResponse{}, nil
+		return nil
 	}
 
 	// try to remove if targetPath is a symlink
 	symlinkRemove, err := utils.RemoveSymlink(targetPath)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "NodeUnpublishVolume: remove symlink error %v", err)
+		return status.Errorf(codes.Internal, "NodeUnpublishVolume: remove symlink error %v", err)
 	}
 	if symlinkRemove {
 		// targetPath is a symlink and has been remove successfully
 		glog.V(3).Infof("Remove symlink targetPath %s successfully", targetPath)
-		return &csi.NodeUnpublishVolumeResponse{}, nil
+		return nil
 	}
 
 	// targetPath may be bind mount many times when mount point recovered.
@@ -248,7 +280,7 @@ func (ns *nodeServer) NodeUnpublishVolume(ctx context.Context, req *csi.NodeUnpu
 		needUnmount, err := isLikelyNeedUnmount(mounter, targetPath)
 		if err != nil {
 			glog.Errorf("NodeUnpublishVolume: fail to check if targetPath %s needs unmount: %v", targetPath, err)
-			return nil, status.Errorf(codes.Internal, "NodeUnpublishVolume: fail to check if targetPath %s needs unmount: %v", targetPath, err)
+			return status.Errorf(codes.Internal, "NodeUnpublishVolume: fail to check if targetPath %s needs unmount: %v", targetPath, err)
 		}
 
 		if !needUnmount {
@@ -264,19 +296,19 @@ func (ns *nodeServer) NodeUnpublishVolume(ctx context.Context, req *csi.NodeUnpu
 		}
 		if err != nil {
 			glog.Errorf("NodeUnpublishVolume: umount targetPath %s with error: %v", targetPath, err)
-			return nil, status.Errorf(codes.Internal, "NodeUnpublishVolume: umount targetPath %s: %v", targetPath, err)
+			return status.Errorf(codes.Internal, "NodeUnpublishVolume: umount targetPath %s: %v", targetPath, err)
 		}
 	}
 
 	err = mount.CleanupMountPoint(targetPath, mounter, false)
 	if err != nil {
 		glog.Errorf("NodeUnpublishVolume: failed when cleanupMountPoint on path %s: %v", targetPath, err)
-		return nil, status.Errorf(codes.Internal, "NodeUnpublishVolume: failed when cleanupMountPoint on path %s: %v", targetPath, err)
+		return status.Errorf(codes.Internal, "NodeUnpublishVolume: failed when cleanupMountPoint on path %s: %v", targetPath, err)
 	} else {
 		glog.V(4).Infof("NodeUnpublishVolume: succeed in umounting %s", targetPath)
 	}
 
-	return &csi.NodeUnpublishVolumeResponse{}, nil
+	return nil
 }
 
 func (ns *nodeServer) NodeUnstageVolume(ctx context.Context, req *csi.NodeUnstageVolumeRequest) (*csi.NodeUnstageVolumeResponse, error) {
@@ -345,31 +377,140 @@ func (ns *nodeServer) NodeStageVolume(ctx context.Context, req *csi.NodeStageVol
 	if err != nil {
 		return nil, errors.Wrapf(err, "NodeStageVolume: failed to get runtime info for %s/%s", namespace, name)
 	}
-	fuseLabelKey := utils.GetFuseLabelName(namespace, name, runtimeInfo.GetOwnerDatasetUID())
+	fuseLabelKey, err := ns.labelNodeForFuse(runtimeInfo)
+	if err != nil {
+		return nil, errors.Wrap(err, "NodeStageVolume")
+	}
+
+	glog.Infof("NodeStageVolume: NodeStage succeeded with VolumeId: %s, and added NodeLabel: %s", volumeId, fuseLabelKey)
+	return &csi.NodeStageVolumeResponse{}, nil
+}
+
+// labelNodeForFuse labels the node so that the FUSE pod of the runtime is launched on it
+func (ns *nodeServer) labelNodeForFuse(runtimeInfo base.RuntimeInfoInterface) (fuseLabelKey string, err error) {
+	fuseLabelKey = utils.GetFuseLabelName(runtimeInfo.GetNamespace(), runtimeInfo.GetName(), runtimeInfo.GetOwnerDatasetUID())
 	var labelsToModify common.LabelsToModify
 	labelsToModify.Add(fuseLabelKey, "true")
 
 	node, err := ns.getNode()
 	if err != nil {
-		glog.Errorf("NodeStageVolume: can't get node %s: %v", ns.nodeId, err)
-		return nil, errors.Wrapf(err, "NodeStageVolume: can't get node %s", ns.nodeId)
+		glog.Errorf("can't get node %s: %v", ns.nodeId, err)
+		return "", errors.Wrapf(err, "can't get node %s", ns.nodeId)
 	}
 
 	// _, err = utils.ChangeNodeLabelWithPatchMode(ns.client, node, labelsToModify)
 	err = ns.patchNodeWithLabel(node, labelsToModify)
 	if err != nil {
-		glog.Errorf("NodeStageVolume: error when patching labels on node %s: %v", ns.nodeId, err)
-		return nil, errors.Wrapf(err, "NodeStageVolume: error when patching labels on node %s", ns.nodeId)
+		glog.Errorf("error when patching labels on node %s: %v", ns.nodeId, err)
+		return "", errors.Wrapf(err, "error when patching labels on node %s", ns.nodeId)
 	}
 
-	glog.Infof("NodeStageVolume: NodeStage succeeded with VolumeId: %s, and added NodeLabel: %s", volumeId, fuseLabelKey)
-	return &csi.NodeStageVolumeResponse{}, nil
+	return fuseLabelKey, nil
 }
 
 func (ns *nodeServer) NodeExpandVolume(ctx context.Context, req *csi.NodeExpandVolumeRequest) (*csi.NodeExpandVolumeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "")
 }
 
+// NodeGetVolumeStats reports the capacity and usage of the FUSE mount point backing the volume,
+// and an abnormal volume condition if the mount point is broken.
+func (ns *nodeServer) NodeGetVolumeStats(ctx context.Context, req *csi.NodeGetVolumeStatsRequest) (*csi.NodeGetVolumeStatsResponse, error) {
+	volumeId := req.GetVolumeId()
+	volumePath := req.GetVolumePath()
+	if len(volumeId) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "NodeGetVolumeStats operation requires volumeId but is not provided")
+	}
+	if len(volumePath) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "NodeGetVolumeStats operation requires volumePath but is not provided")
+	}
+
+	// 1. check if the mount point is corrupted, e.g. the FUSE process crashed
+	broken, err := utils.CheckMountPointBroken(volumePath)
+	if err != nil {
+		if exists, _ := utils.MountPathExists(volumePath); !exists {
+			return nil, status.Errorf(codes.NotFound, "NodeGetVolumeStats: volume path %s of volume %s does not exist", volumePath, volumeId)
+		}
+		return nil, status.Errorf(codes.Internal, "NodeGetVolumeStats: failed to check volume path %s: %v", volumePath, err)
+	}
+	if broken {
+		return abnormalVolumeStats(fmt.Sprintf("the mount point %s is corrupted, the FUSE of the volume may be crashed", volumePath)), nil
+	}
+
+	// 2. check if the bind mount point is disconnected from the global FUSE mount point, e.g. the FUSE pod is restarted
+	broken, err = mountinfo.IsBrokenMountPoint(volumePath)
+	if err != nil {
+		glog.Warningf("NodeGetVolumeStats: failed to check if volume path %s is broken: %v", volumePath, err)
+	} else if broken {
+		return abnormalVolumeStats(fmt.Sprintf("the mount point %s is disconnected from the FUSE mount point, the FUSE of the volume may be restarted", volumePath)), nil
+	}
+
+	// 3. report the capacity and usage from the FUSE mount point
+	statfs, err := statfsWithTimeout(ctx, volumePath, volumeStatsTimeout)
+	if err != nil {
+		return abnormalVolumeStats(fmt.Sprintf("failed to statfs the mount point %s: %v", volumePath, err)), nil
+	}
+
+	blockSize := int64(statfs.Bsize)
+	return &csi.NodeGetVolumeStatsResponse{
+		Usage: []*csi.VolumeUsage{
+			{
+				Unit:      csi.VolumeUsage_BYTES,
+				Total:     int64(statfs.Blocks) * blockSize,
+				Available: int64(statfs.Bavail) * blockSize,
+				Used:      int64(statfs.Blocks-statfs.Bfree) * blockSize,
+			},
+			{
+				Unit:      csi.VolumeUsage_INODES,
+				Total:     int64(statfs.Files),
+				Available: int64(statfs.Ffree),
+				Used:      int64(statfs.Files - statfs.Ffree),
+			},
+		},
+		VolumeCondition: &csi.VolumeCondition{
+			Abnormal: false,
+			Message:  "volume is healthy",
+		},
+	}, nil
+}
+
+// statfsWithTimeout runs statfs on the path in another goroutine and gives up after the timeout, the goroutine
+// stays blocked until the hung FUSE process is killed.
+func statfsWithTimeout(ctx context.Context, path string, timeout time.Duration) (*syscall.Statfs_t, error) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	type statfsResult struct {
+		statfs syscall.Statfs_t
+		err    error
+	}
+	resultCh := make(chan statfsResult, 1)
+	go func() {
+		var result statfsResult
+		result.err = syscall.Statfs(path, &result.statfs)
+		resultCh <- result
+	}()
+
+	select {
+	case result := <-resultCh:
+		if result.err != nil {
+			return nil, result.err
+		}
+		return &result.statfs, nil
+	case <-timeoutCtx.Done():
+		return nil, fmt.Errorf("statfs timed out after %v, the FUSE of the volume may be hung", timeout)
+	}
+}
+
+func abnormalVolumeStats(message string) *csi.NodeGetVolumeStatsResponse {
+	glog.Warningf("NodeGetVolumeStats: %s", message)
+	return &csi.NodeGetVolumeStatsResponse{
+		VolumeCondition: &csi.VolumeCondition{
+			Abnormal: true,
+			Message:  message,
+		},
+	}
+}
+
 func (ns *nodeServer) NodeGetCapabilities(ctx context.Context, req *csi.NodeGetCapabilitiesRequest) (*csi.NodeGetCapabilitiesResponse, error) {
 	glog.V(5).Infof("Using default NodeGetCapabilities")
 
@@ -382,6 +523,20 @@ func (ns *nodeServer) NodeGetCapabilities(ctx context.Context, req *csi.NodeGetC
 					},
 				},
 			},
+			{
+				Type: &csi.NodeServiceCapability_Rpc{
+					Rpc: &csi.NodeServiceCapability_RPC{
+						Type: csi.NodeServiceCapability_RPC_GET_VOLUME_STATS,
+					},
+				},
+			},
+			{
+				Type: &csi.NodeServiceCapability_Rpc{
+					Rpc: &csi.NodeServiceCapability_RPC{
+						Type: csi.NodeServiceCapability_RPC_VOLUME_CONDITION,
+					},
+				},
+			},
 		},
 	}, nil
 }
@@ -588,8 +743,8 @@ func (ns *nodeServer) prepareSessMgr(workDir string) error {
 }
 
 // useSymlink for nodePublishVolume if environment variable has been set or pv has attribute
-func useSymlink(req *csi.NodePublishVolumeRequest) bool {
-	return os.Getenv("NODEPUBLISH_METHOD") == common.NodePublishMethodSymlink || req.GetVolumeContext()[common.NodePublishMethod] == common.NodePublishMethodSymlink
+func useSymlink(volumeContext map[string]string) bool {
+	return os.Getenv("NODEPUBLISH_METHOD") == common.NodePublishMethodSymlink || volumeContext[common.NodePublishMethod] == common.NodePublishMethodSymlink
 }
 
 // isLikelyNeedUnmount checks if path is likely a mount point that needs to be unmount.
@@ -671,20 +826,9 @@ func (ns *nodeServer) getCleanFuseFunc(volumeId string) (func() error, error) {
 		return nil, errors.Wrapf(err, "NodeUnstageVolume: failed to get runtime info for %s/%s", namespace, name)
 	}
 
-	var shouldCleanFuse bool
-	cleanPolicy := runtimeInfo.GetFuseCleanPolicy()
-	glog.Infof("NodeUnstageVolume: Using %s clean policy for runtime %s in namespace %s", cleanPolicy, runtimeInfo.GetName(), runtimeInfo.GetNamespace())
-	switch cleanPolicy {
-	case v1alpha1.OnDemandCleanPolicy:
-		shouldCleanFuse = true
-	case v1alpha1.OnRuntimeDeletedCleanPolicy:
-		shouldCleanFuse = false
-	case v1alpha1.OnFuseChangedCleanPolicy:
-		if checkIfFuseNeedUpdate(runtimeInfo, latestFuseGeneration) {
-			shouldCleanFuse = true
-		}
-	default:
-		return nil, errors.Errorf("NodeUnstageVolume: unknown Fuse clean policy: %s", cleanPolicy)
+	shouldCleanFuse, err := needCleanFuse(runtimeInfo, latestFuseGeneration)
+	if err != nil {
+		return nil, err
 	}
 
 	//if getCleanFuseFunc == true, fuse pod will be deleted and recreate by NodeStage
@@ -693,35 +837,65 @@ func (ns *nodeServer) getCleanFuseFunc(volumeId string) (func() error, error) {
 	}
 
 	return func() error {
-		// check if the path is mounted
+		// check if the path is mounted, by the persistent volume or by the ephemeral volumes of the dataset
 		inUse, err := checkMountInUse(volumeId)
 		if err != nil {
 			return errors.Wrap(err, "NodeUnstageVolume: can't check mount in use")
 		}
+		if !inUse {
+			inUse, err = hasOtherEphemeralVolumes(namespace, name, "")
+			if err != nil {
+				return errors.Wrap(err, "NodeUnstageVolume: can't check ephemeral volumes in use")
+			}
+		}
 		if inUse {
 			return fmt.Errorf("NodeUnstageVolume: can't stop fuse cause it's in use")
 		}
 
-		// remove label on node
-		// Once the label is removed, fuse pod on corresponding node will be terminated
-		// since node selector in the fuse daemonSet no longer matches.
-		fuseLabelKey := utils.GetFuseLabelName(runtimeInfo.GetNamespace(), runtimeInfo.GetName(), runtimeInfo.GetOwnerDatasetUID())
-		var labelsToModify common.LabelsToModify
-		labelsToModify.Delete(fuseLabelKey)
+		return ns.removeFuseLabel(runtimeInfo)
+	}, nil
+}
 
-		node, err := ns.getNode()
-		if err != nil {
-			glog.Errorf("NodeUnstageVolume: can't get node %s: %v", ns.nodeId, err)
-			return errors.Wrapf(err, "NodeUnstageVolume: can't get node %s", ns.nodeId)
+// needCleanFuse checks the fuse clean policy of the runtime. If clean policy is set to OnRuntimeDeleted, there is no
+// need to clean fuse eagerly.
+func needCleanFuse(runtimeInfo base.RuntimeInfoInterface, latestFuseGeneration string) (shouldCleanFuse bool, err error) {
+	cleanPolicy := runtimeInfo.GetFuseCleanPolicy()
+	glog.Infof("NodeUnstageVolume: Using %s clean policy for runtime %s in namespace %s", cleanPolicy, runtimeInfo.GetName(), runtimeInfo.GetNamespace())
+	switch cleanPolicy {
+	case v1alpha1.OnDemandCleanPolicy:
+		shouldCleanFuse = true
+	case v1alpha1.OnRuntimeDeletedCleanPolicy:
+		shouldCleanFuse = false
+	case v1alpha1.OnFuseChangedCleanPolicy:
+		if checkIfFuseNeedUpdate(runtimeInfo, latestFuseGeneration) {
+			shouldCleanFuse = true
 		}
+	default:
+		return false, errors.Errorf("NodeUnstageVolume: unknown Fuse clean policy: %s", cleanPolicy)
+	}
+	return shouldCleanFuse, nil
+}
 
-		if err := ns.patchNodeWithLabel(node, labelsToModify); err != nil {
-			glog.Errorf("NodeUnstageVolume: error when patching labels on node %s: %v", ns.nodeId, err)
-			return errors.Wrapf(err, "NodeUnstageVolume: error when patching labels on node %s", ns.nodeId)
-		}
+// removeFuseLabel removes the fuse label of the runtime on the node.
+// Once the label is removed, fuse pod on corresponding node will be terminated
+// since node selector in the fuse daemonSet no longer matches.
+func (ns *nodeServer) removeFuseLabel(runtimeInfo base.RuntimeInfoInterface) error {
+	fuseLabelKey := utils.GetFuseLabelName(runtimeInfo.GetNamespace(), runtimeInfo.GetName(), runtimeInfo.GetOwnerDatasetUID())
+	var labelsToModify common.LabelsToModify
+	labelsToModify.Delete(fuseLabelKey)
 
-		return nil
-	}, nil
+	node, err := ns.getNode()
+	if err != nil {
+		glog.Errorf("NodeUnstageVolume: can't get node %s: %v", ns.nodeId, err)
+		return errors.Wrapf(err, "NodeUnstageVolume: can't get node %s", ns.nodeId)
+	}
+
+	if err := ns.patchNodeWithLabel(node, labelsToModify); err != nil {
+		glog.Errorf("NodeUnstageVolume: error when patching labels on node %s: %v", ns.nodeId, err)
+		return errors.Wrapf(err, "NodeUnstageVolume: error when patching labels on node %s", ns.nodeId)
+	}
+
+	return nil
 }
 
 func checkIfFuseNeedUpdate(runtimeInfo base.RuntimeInfoInterface, latestFuseImageVersion string) (needUpdate bool) {
@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/mountinfo"
+)
+
+func TestNodeGetCapabilities(t *testing.T) {
+	resp, err := (&nodeServer{}).NodeGetCapabilities(context.TODO(), &csi.NodeGetCapabilitiesRequest{})
+	if err != nil {
+		t.Fatalf("NodeGetCapabilities() error = %v", err)
+	}
+
+	capabilities := map[csi.NodeServiceCapability_RPC_Type]bool{}
+	for _, capability := range resp.GetCapabilities() {
+		capabilities[capability.GetRpc().GetType()] = true
+	}
+	for _, want := range []csi.NodeServiceCapability_RPC_Type{
+		csi.NodeServiceCapability_RPC_STAGE_UNSTAGE_VOLUME,
+		csi.NodeServiceCapability_RPC_GET_VOLUME_STATS,
+		csi.NodeServiceCapability_RPC_VOLUME_CONDITION,
+	} {
+		if !capabilities[want] {
+			t.Errorf("NodeGetCapabilities() doesn't contain %v", want)
+		}
+	}
+}
+
+func TestNodeGetVolumeStats(t *testing.T) {
+	volumePath := t.TempDir()
+
+	t.Run("invalid_arguments", func(t *testing.T) {
+		_, err := (&nodeServer{}).NodeGetVolumeStats(context.TODO(), &csi.NodeGetVolumeStatsRequest{VolumeId: "default-hbase"})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("NodeGetVolumeStats() error = %v, want InvalidArgument", err)
+		}
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		_, err := (&nodeServer{}).NodeGetVolumeStats(context.TODO(), &csi.NodeGetVolumeStatsRequest{
+			VolumeId:   "default-hbase",
+			VolumePath: filepath.Join(volumePath, "not-exist"),
+		})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("NodeGetVolumeStats() error = %v, want NotFound", err)
+		}
+	})
+
+	t.Run("healthy", func(t *testing.T) {
+		patches := gomonkey.ApplyFunc(mountinfo.IsBrokenMountPoint, func(mountPath string) (bool, error) {
+			return false, nil
+		})
+		defer patches.Reset()
+
+		resp, err := (&nodeServer{}).NodeGetVolumeStats(context.TODO(), &csi.NodeGetVolumeStatsRequest{
+			VolumeId:   "default-hbase",
+			VolumePath: volumePath,
+		})
+		if err != nil {
+			t.Fatalf("NodeGetVolumeStats() error = %v", err)
+		}
+		if resp.GetVolumeCondition().GetAbnormal() {
+			t.Errorf("NodeGetVolumeStats() condition = %v, want normal", resp.GetVolumeCondition())
+		}
+		if len(resp.GetUsage()) != 2 {
+			t.Fatalf("NodeGetVolumeStats() usage = %v, want bytes and inodes", resp.GetUsage())
+		}
+		if bytesUsage := resp.GetUsage()[0]; bytesUsage.GetUnit() != csi.VolumeUsage_BYTES || bytesUsage.GetTotal() <= 0 {
+			t.Errorf("NodeGetVolumeStats() bytes usage = %v, want positive total bytes", bytesUsage)
+		}
+	})
+
+	t.Run("broken_bind_mount", func(t *testing.T) {
+		patches := gomonkey.ApplyFunc(mountinfo.IsBrokenMountPoint, func(mountPath string) (bool, error) {
+			return true, nil
+		})
+		defer patches.Reset()
+
+		resp, err := (&nodeServer{}).NodeGetVolumeStats(context.TODO(), &csi.NodeGetVolumeStatsRequest{
+			VolumeId:   "default-hbase",
+			VolumePath: volumePath,
+		})
+		if err != nil {
+			t.Fatalf("NodeGetVolumeStats() error = %v", err)
+		}
+		if !resp.GetVolumeCondition().GetAbnormal() {
+			t.Errorf("NodeGetVolumeStats() condition = %v, want abnormal", resp.GetVolumeCondition())
+		}
+	})
+
+	t.Run("statfs_timeout", func(t *testing.T) {
+		unblock := make(chan struct{})
+		defer close(unblock)
+		patches := gomonkey.ApplyFunc(mountinfo.IsBrokenMountPoint, func(mountPath string) (bool, error) {
+			return false, nil
+		})
+		defer patches.Reset()
+		patches.ApplyFunc(syscall.Statfs, func(path string, buf *syscall.Statfs_t) error {
+			<-unblock
+			return nil
+		})
+		patches.ApplyGlobalVar(&volumeStatsTimeout, 100*time.Millisecond)
+
+		resp, err := (&nodeServer{}).NodeGetVolumeStats(context.TODO(), &csi.NodeGetVolumeStatsRequest{
+			VolumeId:   "default-hbase",
+			VolumePath: volumePath,
+		})
+		if err != nil {
+			t.Fatalf("NodeGetVolumeStats() error = %v", err)
+		}
+		if !resp.GetVolumeCondition().GetAbnormal() || len(resp.GetUsage()) != 0 {
+			t.Errorf("NodeGetVolumeStats() = %v, want abnormal condition without usage", resp)
+		}
+	})
+
+	t.Run("corrupted_mount", func(t *testing.T) {
+		patches := gomonkey.ApplyFunc(utils.CheckMountPointBroken, func(mountPath string) (bool, error) {
+			return true, nil
+		})
+		defer patches.Reset()
+
+		resp, err := (&nodeServer{}).NodeGetVolumeStats(context.TODO(), &csi.NodeGetVolumeStatsRequest{
+			VolumeId:   "default-hbase",
+			VolumePath: volumePath,
+		})
+		if err != nil {
+			t.Fatalf("NodeGetVolumeStats() error = %v", err)
+		}
+		if !resp.GetVolumeCondition().GetAbnormal() || len(resp.GetUsage()) != 0 {
+			t.Errorf("NodeGetVolumeStats() = %v, want abnormal condition without usage", resp)
+		}
+	})
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+const (
+	// volumeProvisionTimeout is how long a single CreateVolume call waits for the dataset to be bound.
+	// The external-provisioner retries the call if the volume is not ready in time.
+	volumeProvisionTimeout = 30 * time.Second
+
+	volumeProvisionInterval = 2 * time.Second
+
+	// defaultCachePath is the cache path of the runtimes created for dynamically provisioned volumes
+	defaultCachePath = "/dev/shm"
+)
+
+// provisionableRuntimeTypes are the runtime types supported by dynamic provisioning
+var provisionableRuntimeTypes = []string{
+	common.ThinRuntime,
+	common.AlluxioRuntime,
+	common.JindoRuntime,
+	common.JuiceFSRuntime,
+	common.GooseFSRuntime,
+}
+
+// provisionParameters are the parameters parsed from the StorageClass of the volume
+type provisionParameters struct {
+	namespace     string
+	pvcName       string
+	runtimeType   string
+	profileName   string
+	mountPoint    string
+	cacheCapacity *resource.Quantity
+}
+
+// parseProvisionParameters parses and validates the parameters of CreateVolumeRequest
+func parseProvisionParameters(params map[string]string) (*provisionParameters, error) {
+	p := &provisionParameters{
+		namespace:   params[common.VolumeParamPVCNamespace],
+		pvcName:     params[common.VolumeParamPVCName],
+		runtimeType: params[common.VolumeParamRuntimeType],
+		profileName: params[common.VolumeParamProfileName],
+		mountPoint:  params[common.VolumeParamMountPoint],
+	}
+
+	if len(p.namespace) == 0 {
+		return nil, fmt.Errorf("parameter %s is missing, please enable --extra-create-metadata of the external-provisioner", common.VolumeParamPVCNamespace)
+	}
+
+	if len(p.runtimeType) == 0 {
+		p.runtimeType = common.ThinRuntime
+	}
+
+	if capacity, found := params[common.VolumeParamCacheCapacity]; found {
+		quantity, err := resource.ParseQuantity(capacity)
+		if err != nil {
+			return nil, fmt.Errorf("invalid parameter %s=%s: %v", common.VolumeParamCacheCapacity, capacity, err)
+		}
+		p.cacheCapacity = &quantity
+	}
+
+	switch p.runtimeType {
+	case common.ThinRuntime:
+		if len(p.profileName) == 0 {
+			return nil, fmt.Errorf("parameter %s is required by %s runtime", common.VolumeParamProfileName, p.runtimeType)
+		}
+	case common.AlluxioRuntime, common.JindoRuntime, common.JuiceFSRuntime, common.GooseFSRuntime:
+		if p.cacheCapacity == nil {
+			return nil, fmt.Errorf("parameter %s is required by %s runtime", common.VolumeParamCacheCapacity, p.runtimeType)
+		}
+	default:
+		return nil, fmt.Errorf("runtime type %s is not supported for dynamic provisioning", p.runtimeType)
+	}
+
+	if len(p.mountPoint) == 0 {
+		return nil, fmt.Errorf("parameter %s is required", common.VolumeParamMountPoint)
+	}
+
+	return p, nil
+}
+
+// getAccessModes converts the access modes of volume capabilities to the ones of the dataset
+func getAccessModes(volumeCaps []*csi.VolumeCapability) []corev1.PersistentVolumeAccessMode {
+	for _, volumeCap := range volumeCaps {
+		if volumeCap.GetAccessMode().GetMode() != csi.VolumeCapability_AccessMode_MULTI_NODE_READER_ONLY {
+			return []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}
+		}
+	}
+	return []corev1.PersistentVolumeAccessMode{corev1.ReadOnlyMany}
+}
+
+// provisionedObjectMeta returns the object meta of the dataset and runtime provisioned for the volume
+func provisionedObjectMeta(name string, p *provisionParameters) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: p.namespace,
+		Labels: map[string]string{
+			common.LabelAnnotationProvisionedBy: driverName,
+		},
+	}
+}
+
+// newDatasetForVolume builds the dataset to provision the volume
+func newDatasetForVolume(name string, p *provisionParameters, accessModes []corev1.PersistentVolumeAccessMode) *datav1alpha1.Dataset {
+	return &datav1alpha1.Dataset{
+		ObjectMeta: provisionedObjectMeta(name, p),
+		Spec: datav1alpha1.DatasetSpec{
+			Mounts: []datav1alpha1.Mount{{
+				Name:       name,
+				MountPoint: p.mountPoint,
+			}},
+			AccessModes: accessModes,
+		},
+	}
+}
+
+// newRuntimeForVolume builds the runtime to provision the volume according to the runtime type
+func newRuntimeForVolume(name string, p *provisionParameters) (client.Object, error) {
+	var tieredStore datav1alpha1.TieredStore
+	if p.cacheCapacity != nil {
+		tieredStore.Levels = []datav1alpha1.Level{{
+			MediumType: common.Memory,
+			Path:       defaultCachePath,
+			Quota:      p.cacheCapacity,
+		}}
+	}
+
+	objectMeta := provisionedObjectMeta(name, p)
+	switch p.runtimeType {
+	case common.ThinRuntime:
+		return &datav1alpha1.ThinRuntime{
+			ObjectMeta: objectMeta,
+			Spec: datav1alpha1.ThinRuntimeSpec{
+				ThinRuntimeProfileName: p.profileName,
+				TieredStore:            tieredStore,
+				Replicas:               1,
+			},
+		}, nil
+	case common.AlluxioRuntime:
+		return &datav1alpha1.AlluxioRuntime{
+			ObjectMeta: objectMeta,
+			Spec: datav1alpha1.AlluxioRuntimeSpec{
+				TieredStore: tieredStore,
+				Replicas:    1,
+			},
+		}, nil
+	case common.JindoRuntime:
+		return &datav1alpha1.JindoRuntime{
+			ObjectMeta: objectMeta,
+			Spec: datav1alpha1.JindoRuntimeSpec{
+				TieredStore: tieredStore,
+				Replicas:    1,
+			},
+		}, nil
+	case common.JuiceFSRuntime:
+		return &datav1alpha1.JuiceFSRuntime{
+			ObjectMeta: objectMeta,
+			Spec: datav1alpha1.JuiceFSRuntimeSpec{
+				TieredStore: tieredStore,
+				Replicas:    1,
+			},
+		}, nil
+	case common.GooseFSRuntime:
+		return &datav1alpha1.GooseFSRuntime{
+			ObjectMeta: objectMeta,
+			Spec: datav1alpha1.GooseFSRuntimeSpec{
+				TieredStore: tieredStore,
+				Replicas:    1,
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("runtime type %s is not supported for dynamic provisioning", p.runtimeType)
+	}
+}
+
+// newRuntimeObject returns an empty runtime object of the runtime type, used to get or delete the runtime
+func newRuntimeObject(runtimeType string) (client.Object, error) {
+	switch runtimeType {
+	case common.ThinRuntime:
+		return &datav1alpha1.ThinRuntime{}, nil
+	case common.AlluxioRuntime:
+		return &datav1alpha1.AlluxioRuntime{}, nil
+	case common.JindoRuntime:
+		return &datav1alpha1.JindoRuntime{}, nil
+	case common.JuiceFSRuntime:
+		return &datav1alpha1.JuiceFSRuntime{}, nil
+	case common.GooseFSRuntime:
+		return &datav1alpha1.GooseFSRuntime{}, nil
+	default:
+		return nil, fmt.Errorf("runtime type %s is not supported for dynamic provisioning", runtimeType)
+	}
+}
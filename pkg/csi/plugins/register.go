@@ -45,6 +45,13 @@ func getNodeAuthorizedClientFromKubeletConfig(kubeletConfigPath string) (*kubern
 
 // Register initializes the csi driver and registers it to the controller manager.
 func Register(mgr manager.Manager, ctx config.RunningContext) error {
+	if ctx.ControllerOnly {
+		// the controller service does not run on behalf of the node, so the kubelet config is not required
+		csiDriver := NewDriver(ctx.NodeId, ctx.Endpoint, mgr.GetClient(), mgr.GetAPIReader(), nil, ctx.VolumeLocks)
+		csiDriver.controllerOnly = true
+		return mgr.Add(csiDriver)
+	}
+
 	client, err := getNodeAuthorizedClientFromKubeletConfig(ctx.KubeletConfigPath)
 	if err != nil {
 		return err
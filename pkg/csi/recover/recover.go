@@ -24,8 +24,11 @@ import (
 	"time"
 
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/csi/features"
+	"github.com/fluid-cloudnative/fluid/pkg/metrics"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/dataset/volume"
+	utilfeature "github.com/fluid-cloudnative/fluid/pkg/utils/feature"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubelet"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/mountinfo"
 	"github.com/golang/glog"
@@ -34,6 +37,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/flowcontrol"
 	k8sexec "k8s.io/utils/exec"
 	"k8s.io/utils/mount"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -47,6 +51,12 @@ const (
 	serviceAccountTokenFile        = "/var/run/secrets/kubernetes.io/serviceaccount/token"
 	FuseRecoveryPeriod             = "RECOVER_FUSE_PERIOD"
 	RecoverWarningThreshold        = "RECOVER_WARNING_THRESHOLD"
+
+	// backoff of recovering the same mount point and restarting the FUSE pod of the same dataset
+	defaultRecoverInitialBackoff = 5 * time.Second
+	defaultRecoverMaxBackoff     = 2 * time.Minute
+	defaultRestartInitialBackoff = 10 * time.Second
+	defaultRestartMaxBackoff     = 5 * time.Minute
 )
 
 var _ manager.Runnable = &FuseRecover{}
@@ -62,6 +72,13 @@ type FuseRecover struct {
 	recoverWarningThreshold int
 
 	locks *utils.VolumeLocks
+
+	// nodeName is the node where the csi plugin is running
+	nodeName string
+	// selfHealing restarts the FUSE pod when its mount points are disconnected
+	selfHealing        bool
+	recoverBackoff     *flowcontrol.Backoff
+	fuseRestartBackoff *flowcontrol.Backoff
 }
 
 func initializeKubeletClient() (*kubelet.KubeletClient, error) {
@@ -104,7 +121,7 @@ func initializeKubeletClient() (*kubelet.KubeletClient, error) {
 	return kubeletClient, nil
 }
 
-func NewFuseRecover(kubeClient client.Client, recorder record.EventRecorder, apiReader client.Reader, locks *utils.VolumeLocks) (*FuseRecover, error) {
+func NewFuseRecover(kubeClient client.Client, recorder record.EventRecorder, apiReader client.Reader, locks *utils.VolumeLocks, nodeName string) (*FuseRecover, error) {
 	glog.V(3).Infoln("start csi recover")
 	mountRoot, err := utils.GetMountRoot()
 	if err != nil {
@@ -132,6 +149,10 @@ func NewFuseRecover(kubeClient client.Client, recorder record.EventRecorder, api
 		recoverFusePeriod:       recoverFusePeriod,
 		recoverWarningThreshold: recoverWarningThreshold,
 		locks:                   locks,
+		nodeName:                nodeName,
+		selfHealing:             utilfeature.DefaultFeatureGate.Enabled(features.FuseSelfHealing),
+		recoverBackoff:          flowcontrol.NewBackOff(defaultRecoverInitialBackoff, defaultRecoverMaxBackoff),
+		fuseRestartBackoff:      flowcontrol.NewBackOff(defaultRestartInitialBackoff, defaultRestartMaxBackoff),
 	}, nil
 }
 
@@ -155,12 +176,18 @@ func (r *FuseRecover) runOnce() {
 }
 
 func (r *FuseRecover) recover() {
+	if r.selfHealing {
+		r.restartDisconnectedFuse()
+	}
+
 	brokenMounts, err := mountinfo.GetBrokenMountPoints()
 	if err != nil {
 		glog.Error(err)
 		return
 	}
 
+	// recover the volume mount points before the sub path mount points of the application pods
+	sortByMountDependency(brokenMounts)
 	for _, point := range brokenMounts {
 		r.doRecover(point)
 	}
@@ -174,7 +201,7 @@ func (r *FuseRecover) recoverBrokenMount(point mountinfo.MountPoint) (err error)
 	}
 
 	glog.V(3).Infof("FuseRecovery: Start exec cmd: mount %s %s -o %v \n", point.SourcePath, point.MountPath, mountOption)
-	if err := r.Mount(point.SourcePath, point.MountPath, "none", mountOption); err != nil {
+	if err = r.Mount(point.SourcePath, point.MountPath, "none", mountOption); err != nil {
 		glog.Errorf("FuseRecovery: exec cmd: mount -o bind %s %s with err :%v", point.SourcePath, point.MountPath, err)
 	}
 	return
@@ -254,6 +281,17 @@ func (r *FuseRecover) doRecover(point mountinfo.MountPoint) {
 		return
 	}
 
+	if r.selfHealing {
+		if r.recoverBackoff.IsInBackOffSinceUpdate(point.MountPath, r.recoverBackoff.Clock.Now()) {
+			glog.V(3).Infof("FuseRecovery: path %s is in backoff, skip recovering it", point.MountPath)
+			return
+		}
+		if !isFuseReady(point) {
+			glog.V(3).Infof("FuseRecovery: FUSE mount point %s is not ready, wait for it before recovering path %s", point.SourcePath, point.MountPath)
+			return
+		}
+	}
+
 	glog.V(3).Infof("FuseRecovery: recovering broken mount point: %v", point)
 	// if app container restart, umount duplicate mount may lead to recover successes but can not access data
 	// so we only umountDuplicate when it has mounted more than the recoverWarningThreshold
@@ -264,8 +302,19 @@ func (r *FuseRecover) doRecover(point mountinfo.MountPoint) {
 		r.umountDuplicate(point)
 	}
 	if err := r.recoverBrokenMount(point); err != nil {
+		metrics.FuseRecoveryInc(point.NamespacedDatasetName, false)
 		r.eventRecord(point, corev1.EventTypeWarning, common.FuseRecoverFailed)
+		if r.selfHealing {
+			r.recoverBackoff.Next(point.MountPath, r.recoverBackoff.Clock.Now())
+			r.podEventRecord(point, corev1.EventTypeWarning, common.FuseRecoverFailed, "Fuse recover %s failed: %v", point.MountPath, err)
+		}
 		return
 	}
+	metrics.FuseRecoveryInc(point.NamespacedDatasetName, true)
 	r.eventRecord(point, corev1.EventTypeNormal, common.FuseRecoverSucceed)
+	if r.selfHealing {
+		r.recoverBackoff.Reset(point.MountPath)
+		r.fuseRestartBackoff.Reset(point.NamespacedDatasetName)
+		r.podEventRecord(point, corev1.EventTypeNormal, common.FuseRecoverSucceed, "Fuse recover %s succeed", point.MountPath)
+	}
 }
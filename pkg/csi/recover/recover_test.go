@@ -34,6 +34,7 @@ import (
 	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/flowcontrol"
 	k8sexec "k8s.io/utils/exec"
 	"k8s.io/utils/mount"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -331,6 +332,9 @@ func TestNewFuseRecover(t *testing.T) {
 				recoverFusePeriod:       defaultFuseRecoveryPeriod,
 				recoverWarningThreshold: defaultRecoverWarningThreshold,
 				locks:                   volumeLocks,
+				nodeName:                "test-node",
+				recoverBackoff:          flowcontrol.NewBackOff(defaultRecoverInitialBackoff, defaultRecoverMaxBackoff),
+				fuseRestartBackoff:      flowcontrol.NewBackOff(defaultRestartInitialBackoff, defaultRestartMaxBackoff),
 			},
 			wantErr: false,
 		},
@@ -340,7 +344,7 @@ func TestNewFuseRecover(t *testing.T) {
 			t.Setenv(utils.MountRoot, "/runtime-mnt")
 			t.Setenv(FuseRecoveryPeriod, tt.args.recoverFusePeriod)
 
-			got, err := NewFuseRecover(tt.args.kubeClient, tt.args.recorder, tt.args.kubeClient, tt.args.locks)
+			got, err := NewFuseRecover(tt.args.kubeClient, tt.args.recorder, tt.args.kubeClient, tt.args.locks, "test-node")
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NewFuseRecover() error = %v, wantErr %v", err, tt.wantErr)
 				return
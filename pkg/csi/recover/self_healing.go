@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recover
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/metrics"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/dataset/volume"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/mountinfo"
+)
+
+// restartDisconnectedFuse restarts the FUSE pods on the node whose mount points are disconnected.
+// The bind mount points are recovered in the following rounds once the restarted FUSE is ready.
+func (r *FuseRecover) restartDisconnectedFuse() {
+	disconnectedMounts, err := mountinfo.GetDisconnectedMountPoints()
+	if err != nil {
+		glog.Error(err)
+		return
+	}
+
+	pointsByDataset := map[string][]mountinfo.MountPoint{}
+	for _, point := range disconnectedMounts {
+		pointsByDataset[point.NamespacedDatasetName] = append(pointsByDataset[point.NamespacedDatasetName], point)
+	}
+
+	for namespacedDatasetName, points := range pointsByDataset {
+		now := r.fuseRestartBackoff.Clock.Now()
+		if r.fuseRestartBackoff.IsInBackOffSinceUpdate(namespacedDatasetName, now) {
+			glog.V(3).Infof("FuseRecovery: FUSE of %s is in backoff, skip restarting it", namespacedDatasetName)
+			continue
+		}
+		r.fuseRestartBackoff.Next(namespacedDatasetName, now)
+
+		glog.Warningf("FuseRecovery: found %d disconnected mount points of %s, restarting its FUSE pod", len(points), namespacedDatasetName)
+		err := r.restartFuse(namespacedDatasetName)
+		metrics.FuseRestartInc(namespacedDatasetName, err == nil)
+		for _, point := range points {
+			if err != nil {
+				glog.Errorf("FuseRecovery: failed to restart FUSE of %s: %v", namespacedDatasetName, err)
+				r.podEventRecord(point, corev1.EventTypeWarning, common.FuseRestartFailed, "Mountpoint %s is disconnected, failed to restart the FUSE pod: %v", point.MountPath, err)
+				continue
+			}
+			r.podEventRecord(point, corev1.EventTypeWarning, common.FuseDisconnected, "Mountpoint %s is disconnected, the FUSE pod is restarted and the mountpoint will be recovered once the FUSE is ready", point.MountPath)
+		}
+	}
+}
+
+// restartFuse deletes the FUSE pod of the dataset on the node, so that the DaemonSet recreates it
+func (r *FuseRecover) restartFuse(namespacedDatasetName string) error {
+	namespace, name, err := volume.GetNamespacedNameByVolumeId(r.ApiReader, namespacedDatasetName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get dataset by volume id %s", namespacedDatasetName)
+	}
+
+	runtimeInfo, err := base.GetRuntimeInfo(r.ApiReader, name, namespace)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get runtime info for %s/%s", namespace, name)
+	}
+	fuseLabelKey := utils.GetFuseLabelName(namespace, name, runtimeInfo.GetOwnerDatasetUID())
+
+	pods, err := r.listPodsOnNode(namespace)
+	if err != nil {
+		return err
+	}
+
+	restarted := false
+	for i := range pods {
+		pod := &pods[i]
+		if !utils.IsFusePod(*pod) || pod.Spec.NodeSelector[fuseLabelKey] != "true" {
+			continue
+		}
+		glog.Infof("FuseRecovery: deleting FUSE pod %s/%s to restart it", pod.Namespace, pod.Name)
+		if err = r.KubeClient.Delete(context.TODO(), pod); utils.IgnoreNotFound(err) != nil {
+			return errors.Wrapf(err, "failed to delete FUSE pod %s/%s", pod.Namespace, pod.Name)
+		}
+		r.Recorder.Eventf(pod, corev1.EventTypeWarning, common.FuseRestarted, "FUSE pod is restarted because its mount points on node %s are disconnected", r.nodeName)
+		restarted = true
+	}
+
+	if !restarted {
+		return fmt.Errorf("no FUSE pod of %s/%s is found on node %s", namespace, name, r.nodeName)
+	}
+	return nil
+}
+
+// listPodsOnNode lists the pods running on the node in the namespace, or in all namespaces if namespace is empty
+func (r *FuseRecover) listPodsOnNode(namespace string) ([]corev1.Pod, error) {
+	podList := &corev1.PodList{}
+	if err := r.ApiReader.List(context.TODO(), podList, &client.ListOptions{
+		Namespace:     namespace,
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", r.nodeName),
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to list pods on node %s", r.nodeName)
+	}
+	return podList.Items, nil
+}
+
+// podEventRecord records the event on the application pod which the mount point belongs to
+func (r *FuseRecover) podEventRecord(point mountinfo.MountPoint, eventType, eventReason, messageFmt string, args ...interface{}) {
+	podUID := getPodUIDFromMountPath(point.MountPath)
+	if len(podUID) == 0 {
+		glog.V(3).Infof("can't parse pod uid from mount path %s", point.MountPath)
+		return
+	}
+
+	pods, err := r.listPodsOnNode("")
+	if err != nil {
+		glog.Errorf("error list pods to record event for mount path %s: %v", point.MountPath, err)
+		return
+	}
+	for i := range pods {
+		if string(pods[i].UID) == podUID {
+			r.Recorder.Eventf(&pods[i], eventType, eventReason, messageFmt, args...)
+			return
+		}
+	}
+	glog.V(3).Infof("pod %s of mount path %s is not found on node %s", podUID, point.MountPath, r.nodeName)
+}
+
+// getPodUIDFromMountPath parses the pod uid from the bind mount path, which is
+// /{kubeletRootDir}/pods/{podUID}/volumes/... or /{kubeletRootDir}/pods/{podUID}/volume-subpaths/...
+func getPodUIDFromMountPath(mountPath string) string {
+	items := strings.Split(mountPath, "/")
+	for i := 0; i < len(items)-1; i++ {
+		if items[i] == "pods" {
+			return items[i+1]
+		}
+	}
+	return ""
+}
+
+// isFuseReady checks if the FUSE mount point, which is the source of the bind mount point, is accessible
+func isFuseReady(point mountinfo.MountPoint) bool {
+	_, err := os.Stat(point.SourcePath)
+	return err == nil
+}
+
+// sortByMountDependency sorts the mount points so that the volume mount points of pods are recovered
+// before the sub path mount points, which are bind mounted from the volume mount points by kubelet.
+func sortByMountDependency(points []mountinfo.MountPoint) {
+	isSubPath := func(point mountinfo.MountPoint) bool {
+		return strings.Contains(point.MountPath, "volume-subpaths")
+	}
+	sort.SliceStable(points, func(i, j int) bool {
+		if isSubPath(points[i]) != isSubPath(points[j]) {
+			return !isSubPath(points[i])
+		}
+		return points[i].MountPath < points[j].MountPath
+	})
+}
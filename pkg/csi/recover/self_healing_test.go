@@ -0,0 +1,233 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recover
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/utils/mount"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/dataset/volume"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/mountinfo"
+)
+
+func TestSortByMountDependency(t *testing.T) {
+	points := []mountinfo.MountPoint{
+		{MountPath: "/var/lib/kubelet/pods/pod2/volume-subpaths/default-jfsdemo/demo/0"},
+		{MountPath: "/var/lib/kubelet/pods/pod2/volumes/kubernetes.io~csi/default-jfsdemo/mount"},
+		{MountPath: "/var/lib/kubelet/pods/pod1/volumes/kubernetes.io~csi/default-jfsdemo/mount"},
+	}
+	sortByMountDependency(points)
+
+	want := []string{
+		"/var/lib/kubelet/pods/pod1/volumes/kubernetes.io~csi/default-jfsdemo/mount",
+		"/var/lib/kubelet/pods/pod2/volumes/kubernetes.io~csi/default-jfsdemo/mount",
+		"/var/lib/kubelet/pods/pod2/volume-subpaths/default-jfsdemo/demo/0",
+	}
+	for i, point := range points {
+		if point.MountPath != want[i] {
+			t.Errorf("sortByMountDependency()[%d] = %s, want %s", i, point.MountPath, want[i])
+		}
+	}
+}
+
+func TestGetPodUIDFromMountPath(t *testing.T) {
+	tests := map[string]string{
+		"/var/lib/kubelet/pods/1140aa96-18c2-4896-a14f-7e3965a51406/volumes/kubernetes.io~csi/default-jfsdemo/mount": "1140aa96-18c2-4896-a14f-7e3965a51406",
+		"/var/lib/kubelet/pods/6fe8418f-3f78-4adb-9e02-416d8601c1b6/volume-subpaths/default-jfsdemo/demo/0":          "6fe8418f-3f78-4adb-9e02-416d8601c1b6",
+		"/runtime-mnt/juicefs/default/jfsdemo/juicefs-fuse":                                                          "",
+	}
+	for mountPath, want := range tests {
+		if got := getPodUIDFromMountPath(mountPath); got != want {
+			t.Errorf("getPodUIDFromMountPath(%s) = %s, want %s", mountPath, got, want)
+		}
+	}
+}
+
+func TestFuseRecover_restartDisconnectedFuse(t *testing.T) {
+	dataset := &v1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{Name: "jfsdemo", Namespace: "default"},
+		Status: v1alpha1.DatasetStatus{
+			Runtimes: []v1alpha1.Runtime{{Name: "jfsdemo", Namespace: "default", Type: common.JuiceFSRuntime, Category: common.AccelerateCategory}},
+		},
+	}
+	runtime := &v1alpha1.JuiceFSRuntime{ObjectMeta: metav1.ObjectMeta{Name: "jfsdemo", Namespace: "default"}}
+
+	s := apimachineryRuntime.NewScheme()
+	_ = v1alpha1.AddToScheme(s)
+	_ = corev1.AddToScheme(s)
+	runtimeInfo, err := base.GetRuntimeInfo(fake.NewFakeClientWithScheme(s, dataset, runtime), "jfsdemo", "default")
+	if err != nil {
+		t.Fatalf("failed to get runtime info: %v", err)
+	}
+	fuseLabelKey := utils.GetFuseLabelName("default", "jfsdemo", runtimeInfo.GetOwnerDatasetUID())
+
+	fusePod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "jfsdemo-fuse-abcde", Namespace: "default", Labels: map[string]string{"role": "juicefs-fuse"}},
+		Spec:       corev1.PodSpec{NodeName: "test-node", NodeSelector: map[string]string{fuseLabelKey: "true"}},
+	}
+	appPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", UID: "1140aa96-18c2-4896-a14f-7e3965a51406"},
+		Spec:       corev1.PodSpec{NodeName: "test-node"},
+	}
+	fakeClient := fake.NewFakeClientWithScheme(s, dataset, runtime, fusePod, appPod)
+
+	recorder := record.NewFakeRecorder(10)
+	r := &FuseRecover{
+		SafeFormatAndMount: mount.SafeFormatAndMount{Interface: &mount.FakeMounter{}},
+		KubeClient:         fakeClient,
+		ApiReader:          fakeClient,
+		Recorder:           recorder,
+		locks:              utils.NewVolumeLocks(),
+		nodeName:           "test-node",
+		selfHealing:        true,
+		recoverBackoff:     flowcontrol.NewBackOff(defaultRecoverInitialBackoff, defaultRecoverMaxBackoff),
+		fuseRestartBackoff: flowcontrol.NewBackOff(defaultRestartInitialBackoff, defaultRestartMaxBackoff),
+	}
+
+	patches := gomonkey.ApplyFunc(mountinfo.GetDisconnectedMountPoints, func() ([]mountinfo.MountPoint, error) {
+		return []mountinfo.MountPoint{{
+			SourcePath:            "/runtime-mnt/juicefs/default/jfsdemo/juicefs-fuse",
+			MountPath:             "/var/lib/kubelet/pods/1140aa96-18c2-4896-a14f-7e3965a51406/volumes/kubernetes.io~csi/default-jfsdemo/mount",
+			NamespacedDatasetName: "default-jfsdemo",
+		}}, nil
+	})
+	defer patches.Reset()
+	patches.ApplyFunc(volume.GetNamespacedNameByVolumeId, func(client client.Reader, volumeId string) (namespace, name string, err error) {
+		return "default", "jfsdemo", nil
+	})
+	// the fake client doesn't support listing pods by field selector
+	patches.ApplyPrivateMethod(reflect.TypeOf(r), "listPodsOnNode", func(r *FuseRecover, namespace string) ([]corev1.Pod, error) {
+		podList := &corev1.PodList{}
+		if err := fakeClient.List(context.TODO(), podList, client.InNamespace(namespace)); err != nil {
+			return nil, err
+		}
+		return podList.Items, nil
+	})
+
+	r.restartDisconnectedFuse()
+
+	err = fakeClient.Get(context.TODO(), types.NamespacedName{Name: fusePod.Name, Namespace: fusePod.Namespace}, &corev1.Pod{})
+	if utils.IgnoreNotFound(err) != nil || err == nil {
+		t.Fatalf("fuse pod is expected to be deleted, got error %v", err)
+	}
+
+	var events []string
+	for len(recorder.Events) > 0 {
+		events = append(events, <-recorder.Events)
+	}
+	if len(events) != 2 || !strings.Contains(events[0], common.FuseRestarted) || !strings.Contains(events[1], common.FuseDisconnected) {
+		t.Errorf("events = %v, want FuseRestarted on the fuse pod and FuseDisconnected on the application pod", events)
+	}
+
+	// restarting again is expected to be skipped during backoff
+	recreatedPod := fusePod.DeepCopy()
+	recreatedPod.ResourceVersion = ""
+	if err = fakeClient.Create(context.TODO(), recreatedPod); err != nil {
+		t.Fatalf("failed to recreate fuse pod: %v", err)
+	}
+	r.restartDisconnectedFuse()
+	if err = fakeClient.Get(context.TODO(), types.NamespacedName{Name: fusePod.Name, Namespace: fusePod.Namespace}, &corev1.Pod{}); err != nil {
+		t.Errorf("fuse pod is not expected to be restarted during backoff, got error %v", err)
+	}
+}
+
+func TestFuseRecover_doRecoverWithSelfHealing(t *testing.T) {
+	point := mountinfo.MountPoint{
+		SourcePath:            "/runtime-mnt/juicefs/default/jfsdemo/juicefs-fuse",
+		MountPath:             "/var/lib/kubelet/pods/1140aa96-18c2-4896-a14f-7e3965a51406/volumes/kubernetes.io~csi/default-jfsdemo/mount",
+		NamespacedDatasetName: "default-jfsdemo",
+	}
+
+	tests := []struct {
+		name        string
+		fuseReady   bool
+		inBackoff   bool
+		wantMounted bool
+	}{
+		{
+			name:        "fuse_ready",
+			fuseReady:   true,
+			wantMounted: true,
+		},
+		{
+			name:        "fuse_not_ready",
+			fuseReady:   false,
+			wantMounted: false,
+		},
+		{
+			name:        "in_backoff",
+			fuseReady:   true,
+			inBackoff:   true,
+			wantMounted: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeMounter := &mount.FakeMounter{}
+			r := &FuseRecover{
+				SafeFormatAndMount: mount.SafeFormatAndMount{Interface: fakeMounter},
+				Recorder:           record.NewFakeRecorder(10),
+				locks:              utils.NewVolumeLocks(),
+				selfHealing:        true,
+				recoverBackoff:     flowcontrol.NewBackOff(defaultRecoverInitialBackoff, defaultRecoverMaxBackoff),
+				fuseRestartBackoff: flowcontrol.NewBackOff(defaultRestartInitialBackoff, defaultRestartMaxBackoff),
+			}
+			if tt.inBackoff {
+				r.recoverBackoff.Next(point.MountPath, r.recoverBackoff.Clock.Now())
+			}
+
+			mounted := false
+			patches := gomonkey.ApplyMethod(reflect.TypeOf(fakeMounter), "Mount", func(_ *mount.FakeMounter, source string, target string, _ string, _ []string) error {
+				mounted = true
+				return nil
+			})
+			defer patches.Reset()
+			patches.ApplyPrivateMethod(reflect.TypeOf(r), "shouldRecover", func(_ *FuseRecover, mountPath string) (bool, error) {
+				return true, nil
+			})
+			patches.ApplyFunc(isFuseReady, func(point mountinfo.MountPoint) bool {
+				return tt.fuseReady
+			})
+			patches.ApplyPrivateMethod(reflect.TypeOf(r), "eventRecord", func(_ *FuseRecover, point mountinfo.MountPoint, eventType, eventReason string) {})
+			patches.ApplyPrivateMethod(reflect.TypeOf(r), "listPodsOnNode", func(_ *FuseRecover, namespace string) ([]corev1.Pod, error) {
+				return nil, nil
+			})
+
+			r.doRecover(point)
+			if mounted != tt.wantMounted {
+				t.Errorf("doRecover() mounted = %v, want %v", mounted, tt.wantMounted)
+			}
+		})
+	}
+}
@@ -29,6 +29,8 @@ import (
 type registrationFuncs struct {
 	enabled  func() bool
 	register func(mgr manager.Manager, ctx config.RunningContext) error
+	// nodeOnly components work on the node, they are not registered in controller only mode
+	nodeOnly bool
 }
 
 var registraions map[string]registrationFuncs
@@ -37,13 +39,17 @@ func init() {
 	registraions = map[string]registrationFuncs{}
 
 	registraions["plugins"] = registrationFuncs{enabled: plugins.Enabled, register: plugins.Register}
-	registraions["recover"] = registrationFuncs{enabled: recover.Enabled, register: recover.Register}
-	registraions["updatedbconf"] = registrationFuncs{enabled: updatedbconf.Enabled, register: updatedbconf.Register}
+	registraions["recover"] = registrationFuncs{enabled: recover.Enabled, register: recover.Register, nodeOnly: true}
+	registraions["updatedbconf"] = registrationFuncs{enabled: updatedbconf.Enabled, register: updatedbconf.Register, nodeOnly: true}
 }
 
 // SetupWithManager registers all the enabled components defined in registrations to the controller manager.
 func SetupWithManager(mgr manager.Manager, ctx config.RunningContext) error {
 	for rName, r := range registraions {
+		if ctx.ControllerOnly && r.nodeOnly {
+			glog.Infof("%s is skipped in controller only mode", rName)
+			continue
+		}
 		if r.enabled() {
 			glog.Infof("Registering %s to controller manager", rName)
 			if err := r.register(mgr, ctx); err != nil {
@@ -0,0 +1,226 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ctrl
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultAutoscalingMinReplicas            int32   = 1
+	defaultAutoscalingTargetCacheUsage       int32   = 80
+	defaultAutoscalingScaleInCooldownSeconds int32   = 300
+	defaultHighWaterMark                     float64 = 0.95
+)
+
+// Autoscale evaluates the autoscaling policy of the runtime and patches spec.replicas of the runtime
+// if the workers need to be scaled. The runtime is updated in place with the patched one, so the
+// following sync of replicas takes effect on the new replicas directly.
+//
+// The workers are scaled out when the cached data exceeds the target cache usage of the workers and
+// the dataset is not cached enough. The workers are scaled in one by one after the cooldown and the
+// draining of the last removed worker, and only when the cached data still fits in the remaining
+// workers under the target cache usage, so that the data cached on the removed worker can be evicted
+// to or reloaded by the remaining ones.
+func (e *Helper) Autoscale(ctx cruntime.ReconcileRequestContext,
+	runtime base.RuntimeInterface,
+	workers *appsv1.StatefulSet) (err error) {
+	autoscalable, ok := runtime.(base.AutoscalableRuntime)
+	if !ok {
+		return
+	}
+	policy := autoscalable.GetAutoscalingPolicy()
+	if policy == nil {
+		return
+	}
+
+	current := runtime.Replicas()
+	desired, reason := e.desiredReplicas(policy, runtime.GetStatus(), current, workers)
+	if desired == current {
+		e.log.V(1).Info("Nothing to do for autoscaling", "replicas", current, "reason", reason)
+		return
+	}
+
+	e.log.Info("Autoscale the runtime workers", "from", current, "to", desired, "reason", reason)
+	patch := []byte(fmt.Sprintf(`{"spec":{"replicas":%d}}`, desired))
+	err = e.client.Patch(context.TODO(), runtime, client.RawPatch(types.MergePatchType, patch))
+	if err != nil {
+		return err
+	}
+
+	ctx.Recorder.Eventf(runtime, corev1.EventTypeNormal, common.RuntimeAutoscaled,
+		"Runtime autoscaled from %d replicas to %d replicas: %s", current, desired, reason)
+	return
+}
+
+// desiredReplicas calculates the desired replicas of the workers according to the autoscaling policy
+// and the cache states, it also returns the reason of the decision.
+func (e *Helper) desiredReplicas(policy *datav1alpha1.AutoscalingPolicy,
+	status *datav1alpha1.RuntimeStatus,
+	current int32,
+	workers *appsv1.StatefulSet) (desired int32, reason string) {
+	minReplicas := defaultAutoscalingMinReplicas
+	if policy.MinReplicas != nil {
+		minReplicas = *policy.MinReplicas
+	}
+	maxReplicas := policy.MaxReplicas
+	if maxReplicas < minReplicas {
+		maxReplicas = minReplicas
+	}
+	clamp := func(replicas int32) int32 {
+		return min(max(replicas, minReplicas), maxReplicas)
+	}
+
+	desired = clamp(current)
+	if desired != current {
+		reason = fmt.Sprintf("replicas out of range [%d, %d]", minReplicas, maxReplicas)
+		return
+	}
+
+	// wait for the last scaling to finish, the cache states are not accurate during scaling
+	if workers.Spec.Replicas == nil || *workers.Spec.Replicas != current || workers.Status.ReadyReplicas != current {
+		reason = "workers are scaling"
+		return
+	}
+
+	cached, err := utils.FromHumanSize(status.CacheStates[common.Cached])
+	if err != nil {
+		reason = "cached size is unknown"
+		return
+	}
+	perWorkerCapacity := e.getPerWorkerCacheCapacity(status, current)
+	if perWorkerCapacity <= 0 {
+		reason = "cache capacity is unknown"
+		return
+	}
+
+	targetUsage := defaultAutoscalingTargetCacheUsage
+	if policy.TargetCacheUsagePercentage != nil {
+		targetUsage = *policy.TargetCacheUsagePercentage
+	}
+	usablePerWorker := float64(perWorkerCapacity) * e.getHighWaterMark() * float64(targetUsage) / 100
+	needed := int32(math.Ceil(float64(cached) / usablePerWorker))
+
+	switch {
+	case needed > current:
+		if policy.TargetCachedPercentage != nil {
+			cachedPercentage, err := parsePercentage(status.CacheStates[common.CachedPercentage])
+			if err == nil && cachedPercentage >= float64(*policy.TargetCachedPercentage) {
+				reason = fmt.Sprintf("cached percentage %.1f%% reaches the target", cachedPercentage)
+				return
+			}
+		}
+		desired = clamp(needed)
+		reason = fmt.Sprintf("cache usage exceeds %d%% of the capacity under the high watermark", targetUsage)
+	case needed < current:
+		// never scale in again before the cache on the draining workers is migrated to the remaining ones
+		if _, cond := utils.GetRuntimeCondition(status.Conditions, datav1alpha1.RuntimeWorkerScaledIn); isDrainingWorkers(cond) {
+			reason = "workers are draining"
+			return
+		}
+		cooldown := defaultAutoscalingScaleInCooldownSeconds
+		if policy.ScaleInCooldownSeconds != nil {
+			cooldown = *policy.ScaleInCooldownSeconds
+		}
+		if lastScaleTime := getLastScaleTime(status); time.Since(lastScaleTime) < time.Duration(cooldown)*time.Second {
+			reason = "scale-in is cooling down"
+			return
+		}
+		// scale in one by one to give the remaining workers time to take over the cache
+		desired = clamp(current - 1)
+		reason = fmt.Sprintf("cached data fits in %d workers under %d%% of the capacity", needed, targetUsage)
+	default:
+		reason = "cache usage is on target"
+	}
+
+	return
+}
+
+// getPerWorkerCacheCapacity gets the cache capacity of a single worker from the tiered store, and
+// falls back to the average cache capacity reported by the runtime.
+func (e *Helper) getPerWorkerCacheCapacity(status *datav1alpha1.RuntimeStatus, replicas int32) (capacity int64) {
+	if e.runtimeInfo != nil {
+		for _, level := range e.runtimeInfo.GetTieredStoreInfo().Levels {
+			for _, cachePath := range level.CachePaths {
+				if cachePath.Quota != nil {
+					capacity += cachePath.Quota.Value()
+				}
+			}
+		}
+	}
+	if capacity > 0 || replicas <= 0 {
+		return
+	}
+
+	total, err := utils.FromHumanSize(status.CacheStates[common.CacheCapacity])
+	if err != nil {
+		return 0
+	}
+	return total / int64(replicas)
+}
+
+// getHighWaterMark gets the lowest high watermark of the tiered store levels
+func (e *Helper) getHighWaterMark() float64 {
+	highWaterMark := defaultHighWaterMark
+	if e.runtimeInfo == nil {
+		return highWaterMark
+	}
+
+	found := false
+	for _, level := range e.runtimeInfo.GetTieredStoreInfo().Levels {
+		high, err := strconv.ParseFloat(level.High, 64)
+		if err != nil || high <= 0 || high > 1 {
+			continue
+		}
+		if !found || high < highWaterMark {
+			highWaterMark = high
+			found = true
+		}
+	}
+	return highWaterMark
+}
+
+// getLastScaleTime gets the last time when the workers were scaled in or out
+func getLastScaleTime(status *datav1alpha1.RuntimeStatus) (lastScaleTime time.Time) {
+	for _, condType := range []datav1alpha1.RuntimeConditionType{datav1alpha1.RuntimeWorkerScaledIn, datav1alpha1.RuntimeWorkerScaledOut} {
+		_, cond := utils.GetRuntimeCondition(status.Conditions, condType)
+		if cond != nil && cond.LastProbeTime.Time.After(lastScaleTime) {
+			lastScaleTime = cond.LastProbeTime.Time
+		}
+	}
+	return
+}
+
+// parsePercentage parses the percentage like "50.0%"
+func parsePercentage(percentage string) (float64, error) {
+	return strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(percentage, "%")), 64)
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ctrl
+
+import (
+	"context"
+	"time"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ctrl Autoscaling Tests", func() {
+	var (
+		helper       *Helper
+		k8sClient    client.Client
+		fluidRuntime *datav1alpha1.JuiceFSRuntime
+		workerSts    *appsv1.StatefulSet
+		recorder     *record.FakeRecorder
+	)
+
+	BeforeEach(func() {
+		fluidRuntime = &datav1alpha1.JuiceFSRuntime{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "fluid",
+			},
+			Spec: datav1alpha1.JuiceFSRuntimeSpec{
+				Replicas: 2,
+				RuntimeManagement: datav1alpha1.RuntimeManagement{
+					Autoscaling: &datav1alpha1.AutoscalingPolicy{
+						MaxReplicas: 4,
+					},
+				},
+			},
+			Status: datav1alpha1.RuntimeStatus{
+				CacheStates: common.CacheStateList{
+					common.CacheCapacity:    "20.00GiB",
+					common.Cached:           "10.00GiB",
+					common.CachedPercentage: "50.0%",
+				},
+			},
+		}
+
+		workerSts = &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-worker",
+				Namespace: "fluid",
+			},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: ptr.To[int32](2),
+			},
+			Status: appsv1.StatefulSetStatus{
+				ReadyReplicas: 2,
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		k8sClient = fake.NewFakeClientWithScheme(datav1alpha1.UnitTestScheme, []runtime.Object{fluidRuntime, workerSts}...)
+		runtimeInfo, _ := base.BuildRuntimeInfo(fluidRuntime.Name, fluidRuntime.Namespace, common.JuiceFSRuntime)
+		helper = BuildHelper(runtimeInfo, k8sClient, fake.NullLogger())
+		recorder = record.NewFakeRecorder(10)
+
+		ctx := cruntime.ReconcileRequestContext{
+			Log:      fake.NullLogger(),
+			Recorder: recorder,
+		}
+		Expect(helper.Autoscale(ctx, fluidRuntime, workerSts)).To(Succeed())
+	})
+
+	getReplicas := func() int32 {
+		updatedRuntime := &datav1alpha1.JuiceFSRuntime{}
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "test"}, updatedRuntime)).To(Succeed())
+		return updatedRuntime.Spec.Replicas
+	}
+
+	When("the autoscaling policy is not set", func() {
+		BeforeEach(func() {
+			fluidRuntime.Spec.RuntimeManagement.Autoscaling = nil
+			fluidRuntime.Status.CacheStates[common.Cached] = "20.00GiB"
+		})
+
+		It("should not scale the runtime", func() {
+			Expect(getReplicas()).To(Equal(int32(2)))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+	})
+
+	When("the replicas exceed the max replicas", func() {
+		BeforeEach(func() {
+			fluidRuntime.Spec.RuntimeManagement.Autoscaling.MaxReplicas = 1
+		})
+
+		It("should scale the runtime into the range", func() {
+			Expect(getReplicas()).To(Equal(int32(1)))
+			Expect(fluidRuntime.Replicas()).To(Equal(int32(1)))
+			Expect(recorder.Events).To(HaveLen(1))
+		})
+	})
+
+	When("the cache usage is on target", func() {
+		It("should not scale the runtime", func() {
+			Expect(getReplicas()).To(Equal(int32(2)))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+	})
+
+	When("the cache usage exceeds the target", func() {
+		BeforeEach(func() {
+			fluidRuntime.Status.CacheStates[common.Cached] = "18.00GiB"
+		})
+
+		It("should scale out the runtime", func() {
+			Expect(getReplicas()).To(Equal(int32(3)))
+			Expect(recorder.Events).To(HaveLen(1))
+		})
+
+		When("the dataset is cached enough", func() {
+			BeforeEach(func() {
+				fluidRuntime.Spec.RuntimeManagement.Autoscaling.TargetCachedPercentage = ptr.To[int32](80)
+				fluidRuntime.Status.CacheStates[common.CachedPercentage] = "90.0%"
+			})
+
+			It("should not scale out the runtime", func() {
+				Expect(getReplicas()).To(Equal(int32(2)))
+			})
+		})
+
+		When("the workers are still scaling", func() {
+			BeforeEach(func() {
+				workerSts.Status.ReadyReplicas = 1
+			})
+
+			It("should not scale out the runtime", func() {
+				Expect(getReplicas()).To(Equal(int32(2)))
+			})
+		})
+	})
+
+	When("the cached data fits in less workers", func() {
+		BeforeEach(func() {
+			fluidRuntime.Status.CacheStates[common.Cached] = "1.00GiB"
+		})
+
+		It("should scale in the runtime by one", func() {
+			Expect(getReplicas()).To(Equal(int32(1)))
+		})
+
+		When("the workers were scaled recently", func() {
+			BeforeEach(func() {
+				cond := utils.NewRuntimeCondition(datav1alpha1.RuntimeWorkerScaledOut, datav1alpha1.RuntimeWorkersScaledOutReason,
+					"Workers scaled out", corev1.ConditionTrue)
+				cond.LastProbeTime = metav1.NewTime(time.Now().Add(-time.Minute))
+				fluidRuntime.Status.Conditions = []datav1alpha1.RuntimeCondition{cond}
+			})
+
+			It("should not scale in the runtime during cooldown", func() {
+				Expect(getReplicas()).To(Equal(int32(2)))
+			})
+		})
+
+		When("the workers are draining", func() {
+			BeforeEach(func() {
+				cond := utils.NewRuntimeCondition(datav1alpha1.RuntimeWorkerScaledIn, datav1alpha1.RuntimeWorkersDrainingReason,
+					"Draining workers [test-worker-2] before scaling in from 3 replicas to 2 replicas.", corev1.ConditionFalse)
+				cond.LastProbeTime = metav1.NewTime(time.Now().Add(-time.Hour))
+				fluidRuntime.Status.Conditions = []datav1alpha1.RuntimeCondition{cond}
+			})
+
+			It("should not scale in the runtime until the draining is done", func() {
+				Expect(getReplicas()).To(Equal(int32(2)))
+				Expect(recorder.Events).To(BeEmpty())
+			})
+		})
+	})
+})
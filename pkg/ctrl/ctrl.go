@@ -30,6 +30,8 @@ type Helper struct {
 	client client.Client
 
 	log logr.Logger
+
+	drainer WorkerDrainer
 }
 
 func BuildHelper(runtimeInfo base.RuntimeInfoInterface, client client.Client, log logr.Logger) *Helper {
@@ -0,0 +1,421 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ctrl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// the workers are not drained unless the timeout is set, e.g. FLUID_WORKER_DRAIN_TIMEOUT=10m
+const defaultWorkerDrainTimeout time.Duration = 0
+
+// WorkerDrainer is implemented by the engines which are able to migrate the cache off the workers to be removed
+type WorkerDrainer interface {
+	// DrainWorkers migrates the cache on the draining worker pods to the remaining workers,
+	// it returns true when the cache is migrated or there is nothing to migrate.
+	DrainWorkers(pods []corev1.Pod) (drained bool, err error)
+
+	// FinishDrainingWorkers cleans up after the draining of the worker pods is done, timed out or canceled.
+	FinishDrainingWorkers(pods []corev1.Pod) error
+}
+
+// WithWorkerDrainer sets the drainer to migrate the cache off the workers before scaling in
+func (e *Helper) WithWorkerDrainer(drainer WorkerDrainer) *Helper {
+	e.drainer = drainer
+	return e
+}
+
+func getWorkerDrainTimeout() time.Duration {
+	return utils.GetDurationValueFromEnv(common.EnvWorkerDrainTimeout, defaultWorkerDrainTimeout)
+}
+
+// DrainWorkers drains the workers to be removed before scaling in the worker statefulset if the drain timeout is
+// set by the env FLUID_WORKER_DRAIN_TIMEOUT, otherwise the workers are removed directly. The workers with the
+// highest ordinals are marked as draining, so that their nodes are not regarded as the cache nodes of the runtime
+// and no new pods are scheduled to them. Then the cache on them is migrated if the engine supports it. The progress
+// is reported through the RuntimeWorkerScaledIn condition, and it returns true when the workers are drained or the
+// draining times out.
+func (e *Helper) DrainWorkers(ctx cruntime.ReconcileRequestContext,
+	runtime base.RuntimeInterface,
+	currentStatus datav1alpha1.RuntimeStatus,
+	workers *appsv1.StatefulSet) (drained bool, err error) {
+	timeout := getWorkerDrainTimeout()
+	if timeout <= 0 {
+		return true, nil
+	}
+
+	var currentReplicas int32
+	if workers.Spec.Replicas != nil {
+		currentReplicas = *workers.Spec.Replicas
+	}
+	desiredReplicas := runtime.Replicas()
+
+	remainingPods, drainingPods, err := e.getWorkerPodsToDrain(workers, desiredReplicas)
+	if err != nil {
+		return
+	}
+
+	statusToUpdate := runtime.GetStatus()
+	drainStartTime := metav1.Now()
+	_, oldCond := utils.GetRuntimeCondition(statusToUpdate.Conditions, datav1alpha1.RuntimeWorkerScaledIn)
+	if isDrainingWorkers(oldCond) {
+		drainStartTime = oldCond.LastTransitionTime
+	}
+
+	pending, err := e.drainWorkerPods(remainingPods, drainingPods)
+	if err != nil {
+		return
+	}
+
+	if len(pending) == 0 || time.Since(drainStartTime.Time) > timeout {
+		if len(pending) > 0 {
+			e.log.Info("Timed out to drain workers, scale in anyway", "pending", pending, "timeout", timeout)
+			ctx.Recorder.Eventf(runtime, corev1.EventTypeWarning, common.RuntimeScaleInFailed,
+				"Timed out to drain workers %v in %v, scale in anyway", pending, timeout)
+		}
+		if e.drainer != nil {
+			err = e.drainer.FinishDrainingWorkers(drainingPods)
+		}
+		return err == nil, err
+	}
+
+	message := fmt.Sprintf("Draining workers %v before scaling in from %d replicas to %d replicas.", pending, currentReplicas, desiredReplicas)
+	cond := utils.NewRuntimeCondition(datav1alpha1.RuntimeWorkerScaledIn, datav1alpha1.RuntimeWorkersDrainingReason,
+		message, corev1.ConditionFalse)
+	cond.LastTransitionTime = drainStartTime
+	if isDrainingWorkers(oldCond) && oldCond.Message == message {
+		cond.LastProbeTime = oldCond.LastProbeTime
+	}
+	statusToUpdate.Conditions = utils.UpdateRuntimeCondition(statusToUpdate.Conditions, cond)
+
+	if !reflect.DeepEqual(*statusToUpdate, currentStatus) {
+		if !isDrainingWorkers(oldCond) {
+			ctx.Recorder.Eventf(runtime, corev1.EventTypeNormal, common.Succeed, "Draining workers %v before scaling in", pending)
+		}
+		err = e.client.Status().Update(context.TODO(), runtime)
+	}
+	return
+}
+
+// CancelDrainingWorkers stops draining the workers if the runtime is scaled out again during the draining
+func (e *Helper) CancelDrainingWorkers(runtime base.RuntimeInterface,
+	currentStatus datav1alpha1.RuntimeStatus,
+	workers *appsv1.StatefulSet) (err error) {
+	statusToUpdate := runtime.GetStatus()
+	_, oldCond := utils.GetRuntimeCondition(statusToUpdate.Conditions, datav1alpha1.RuntimeWorkerScaledIn)
+	if !isDrainingWorkers(oldCond) {
+		return
+	}
+
+	remainingPods, drainingPods, err := e.getWorkerPodsToDrain(workers, runtime.Replicas())
+	if err != nil {
+		return
+	}
+	var canceledPods []corev1.Pod
+	for _, pod := range append(remainingPods, drainingPods...) {
+		if _, found := pod.Annotations[common.AnnotationWorkerDraining]; found {
+			canceledPods = append(canceledPods, pod)
+		}
+	}
+	if e.drainer != nil && len(canceledPods) > 0 {
+		err = e.drainer.FinishDrainingWorkers(canceledPods)
+		if err != nil {
+			return
+		}
+	}
+	for i := range canceledPods {
+		err = e.patchPodAnnotations(&canceledPods[i], map[string]interface{}{
+			common.AnnotationWorkerDraining:            nil,
+			common.AnnotationWorkerDrainMinReplication: nil,
+		})
+		if err != nil {
+			return
+		}
+	}
+
+	cond := utils.NewRuntimeCondition(datav1alpha1.RuntimeWorkerScaledIn, datav1alpha1.RuntimeWorkersDrainCanceledReason,
+		fmt.Sprintf("Workers drain canceled because the runtime is scaled to %d replicas.", runtime.Replicas()), corev1.ConditionFalse)
+	statusToUpdate.Conditions = utils.UpdateRuntimeCondition(statusToUpdate.Conditions, cond)
+	if !reflect.DeepEqual(*statusToUpdate, currentStatus) {
+		err = e.client.Status().Update(context.TODO(), runtime)
+	}
+	return
+}
+
+// getWorkerPodsToDrain splits the worker pods into the remaining ones and the ones to be removed when scaling in,
+// the pods with the highest ordinals are removed first by the statefulset.
+func (e *Helper) getWorkerPodsToDrain(workers *appsv1.StatefulSet, desiredReplicas int32) (remainingPods, drainingPods []corev1.Pod, err error) {
+	selector, err := metav1.LabelSelectorAsSelector(workers.Spec.Selector)
+	if err != nil {
+		return
+	}
+	pods, err := kubeclient.GetPodsForStatefulSet(e.client, workers, selector)
+	if err != nil {
+		return
+	}
+
+	sort.Slice(pods, func(i, j int) bool {
+		return kubeclient.GetStatefulSetPodOrdinal(&pods[i]) < kubeclient.GetStatefulSetPodOrdinal(&pods[j])
+	})
+	for _, pod := range pods {
+		if kubeclient.GetStatefulSetPodOrdinal(&pod) >= int(desiredReplicas) {
+			drainingPods = append(drainingPods, pod)
+		} else {
+			remainingPods = append(remainingPods, pod)
+		}
+	}
+	return
+}
+
+// drainWorkerPods marks the worker pods as draining and returns the names of the pods which are not drained yet
+func (e *Helper) drainWorkerPods(remainingPods, drainingPods []corev1.Pod) (pending []string, err error) {
+	remainingNodes := map[string]bool{}
+	for _, pod := range remainingPods {
+		remainingNodes[pod.Spec.NodeName] = true
+	}
+
+	for i := range drainingPods {
+		pod := &drainingPods[i]
+		if _, found := pod.Annotations[common.AnnotationWorkerDraining]; !found {
+			err = e.patchPodAnnotations(pod, map[string]interface{}{
+				common.AnnotationWorkerDraining: "true",
+			})
+			if err != nil {
+				return
+			}
+			pending = append(pending, pod.Name)
+			continue
+		}
+
+		// wait for the schedule info of the runtime removed from the node
+		if pod.Spec.NodeName != "" && !remainingNodes[pod.Spec.NodeName] {
+			var node *corev1.Node
+			node, err = kubeclient.GetNode(e.client, pod.Spec.NodeName)
+			if apierrors.IsNotFound(err) {
+				err = nil
+				continue
+			}
+			if err != nil {
+				return
+			}
+			if _, found := node.Labels[e.runtimeInfo.GetRuntimeLabelName()]; found {
+				pending = append(pending, pod.Name)
+			}
+		}
+	}
+
+	if len(pending) > 0 || e.drainer == nil || len(drainingPods) == 0 {
+		return
+	}
+
+	drained, err := e.drainer.DrainWorkers(drainingPods)
+	if err != nil || drained {
+		return
+	}
+	for _, pod := range drainingPods {
+		pending = append(pending, pod.Name)
+	}
+	return
+}
+
+func (e *Helper) patchPodAnnotations(pod *corev1.Pod, annotations map[string]interface{}) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": annotations,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return e.client.Patch(context.TODO(), pod, client.RawPatch(types.MergePatchType, patch))
+}
+
+func isDrainingWorkers(cond *datav1alpha1.RuntimeCondition) bool {
+	return cond != nil && cond.Status == corev1.ConditionFalse && cond.Reason == datav1alpha1.RuntimeWorkersDrainingReason
+}
+
+// WorkerCacheReplicator replicates the cached files by raising their minimum replication, it's implemented by
+// the file utils of the engines supporting it, e.g. Alluxio and GooseFS.
+type WorkerCacheReplicator interface {
+	// ListCachedFiles lists the files under the path which are cached partially or fully
+	ListCachedFiles(path string) ([]string, error)
+
+	// GetFileLocations returns the workers holding the blocks of the file
+	GetFileLocations(path string) ([]string, error)
+
+	// GetMinReplication returns the minimum replication of the file
+	GetMinReplication(path string) (int32, error)
+
+	// SetMinReplication sets the minimum replication of the files under the path
+	SetMinReplication(path string, replicas int32) error
+}
+
+// ReplicateWorkerCache replicates the files cached on the draining workers alone to the remaining workers. At the
+// first call, the minimum replication of these files is raised above the number of the draining workers, and their
+// previous minimum replication is recorded on the pod with the lowest ordinal to be restored. It returns true once
+// each of the files has a replica on the remaining workers.
+func (e *Helper) ReplicateWorkerCache(pods []corev1.Pod, replicator WorkerCacheReplicator) (replicated bool, err error) {
+	if len(pods) == 0 {
+		return true, nil
+	}
+
+	previous, started, err := getWorkerCacheReplication(pods)
+	if err != nil || started {
+		if err == nil {
+			replicated, err = e.isWorkerCacheReplicated(pods, replicator, previous)
+		}
+		return
+	}
+
+	files, err := replicator.ListCachedFiles("/")
+	if err != nil {
+		return
+	}
+	replicas := int32(len(pods)) + 1
+	previous = map[string]int32{}
+	for _, file := range files {
+		var locations []string
+		locations, err = replicator.GetFileLocations(file)
+		if err != nil {
+			return
+		}
+		if len(locations) == 0 || hasReplicaOffPods(locations, pods) {
+			continue
+		}
+
+		var minReplication int32
+		minReplication, err = replicator.GetMinReplication(file)
+		if err != nil {
+			return
+		}
+		if minReplication < replicas {
+			previous[file] = minReplication
+		}
+	}
+
+	// record the previous minimum replication before raising it, so that it's always restored
+	value, err := json.Marshal(previous)
+	if err != nil {
+		return
+	}
+	err = e.patchPodAnnotations(&pods[0], map[string]interface{}{
+		common.AnnotationWorkerDrainMinReplication: string(value),
+	})
+	if err != nil {
+		return
+	}
+
+	e.log.Info("Replicating the cache off the draining workers", "files", len(previous), "minReplication", replicas)
+	for file := range previous {
+		err = replicator.SetMinReplication(file, replicas)
+		if err != nil {
+			return
+		}
+	}
+	return len(previous) == 0, nil
+}
+
+// RestoreWorkerCacheReplication restores the minimum replication of the files replicated off the draining workers
+func (e *Helper) RestoreWorkerCacheReplication(pods []corev1.Pod, replicator WorkerCacheReplicator) (err error) {
+	previous, started, err := getWorkerCacheReplication(pods)
+	if err != nil || !started {
+		return
+	}
+
+	for file, minReplication := range previous {
+		err = replicator.SetMinReplication(file, minReplication)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (e *Helper) isWorkerCacheReplicated(pods []corev1.Pod, replicator WorkerCacheReplicator, files map[string]int32) (bool, error) {
+	for file := range files {
+		locations, err := replicator.GetFileLocations(file)
+		if err != nil {
+			return false, err
+		}
+		// the file evicted from the cache needs no replication
+		if len(locations) > 0 && !hasReplicaOffPods(locations, pods) {
+			e.log.V(1).Info("Waiting for the cache to be replicated off the draining workers", "file", file)
+			return false, nil
+		}
+	}
+	e.log.Info("Cache replicated off the draining workers", "files", len(files))
+	return true, nil
+}
+
+// getWorkerCacheReplication returns the previous minimum replication of the files replicated off the draining workers
+func getWorkerCacheReplication(pods []corev1.Pod) (previous map[string]int32, started bool, err error) {
+	for _, pod := range pods {
+		value, found := pod.Annotations[common.AnnotationWorkerDrainMinReplication]
+		if !found {
+			continue
+		}
+		err = json.Unmarshal([]byte(value), &previous)
+		return previous, true, err
+	}
+	return
+}
+
+func hasReplicaOffPods(locations []string, pods []corev1.Pod) bool {
+	for _, location := range locations {
+		if !isWorkerOfPods(location, pods) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsWorkerCacheReplicating checks if the cache on the draining workers has been started to replicate
+func IsWorkerCacheReplicating(pods []corev1.Pod) bool {
+	for _, pod := range pods {
+		if _, found := pod.Annotations[common.AnnotationWorkerDrainMinReplication]; found {
+			return true
+		}
+	}
+	return false
+}
+
+func isWorkerOfPods(worker string, pods []corev1.Pod) bool {
+	for _, pod := range pods {
+		if worker == pod.Status.PodIP || worker == pod.Status.HostIP || worker == pod.Spec.NodeName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,354 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ctrl
+
+import (
+	"context"
+	"os"
+	"time"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type fakeWorkerDrainer struct {
+	drained  bool
+	finished []string
+}
+
+func (d *fakeWorkerDrainer) DrainWorkers(pods []corev1.Pod) (bool, error) {
+	return d.drained, nil
+}
+
+func (d *fakeWorkerDrainer) FinishDrainingWorkers(pods []corev1.Pod) error {
+	for _, pod := range pods {
+		d.finished = append(d.finished, pod.Name)
+	}
+	return nil
+}
+
+type fakeWorkerCacheReplicator struct {
+	locations      map[string][]string
+	minReplication map[string]int32
+}
+
+func (r *fakeWorkerCacheReplicator) ListCachedFiles(path string) (files []string, err error) {
+	for file := range r.locations {
+		files = append(files, file)
+	}
+	return
+}
+
+func (r *fakeWorkerCacheReplicator) GetFileLocations(path string) ([]string, error) {
+	return r.locations[path], nil
+}
+
+func (r *fakeWorkerCacheReplicator) GetMinReplication(path string) (int32, error) {
+	return r.minReplication[path], nil
+}
+
+func (r *fakeWorkerCacheReplicator) SetMinReplication(path string, replicas int32) error {
+	r.minReplication[path] = replicas
+	return nil
+}
+
+var _ = Describe("Ctrl Drain Tests", func() {
+	var (
+		helper       *Helper
+		k8sClient    client.Client
+		runtimeInfo  base.RuntimeInfoInterface
+		fluidRuntime *datav1alpha1.JuiceFSRuntime
+		workerSts    *appsv1.StatefulSet
+		pods         []*corev1.Pod
+		node         *corev1.Node
+		drainer      *fakeWorkerDrainer
+	)
+
+	newWorkerPod := func(name, nodeName string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "fluid",
+				Labels:    map[string]string{"app": "juicefs", "role": "juicefs-worker"},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "apps/v1",
+					Kind:       "StatefulSet",
+					Name:       "test-worker",
+					UID:        "test-worker-uid",
+					Controller: ptr.To(true),
+				}},
+			},
+			Spec: corev1.PodSpec{NodeName: nodeName},
+		}
+	}
+
+	BeforeEach(func() {
+		fluidRuntime = &datav1alpha1.JuiceFSRuntime{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "fluid",
+			},
+			Spec: datav1alpha1.JuiceFSRuntimeSpec{
+				Replicas: 1,
+			},
+		}
+
+		workerSts = &appsv1.StatefulSet{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "StatefulSet",
+				APIVersion: "apps/v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-worker",
+				Namespace: "fluid",
+				UID:       "test-worker-uid",
+			},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: ptr.To[int32](2),
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "juicefs", "role": "juicefs-worker"},
+				},
+			},
+		}
+
+		pods = []*corev1.Pod{newWorkerPod("test-worker-0", "node-0"), newWorkerPod("test-worker-1", "node-1")}
+		node = &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "node-1",
+				Labels: map[string]string{"fluid.io/s-juicefs-fluid-test": "true"},
+			},
+		}
+		drainer = nil
+		Expect(os.Setenv(common.EnvWorkerDrainTimeout, "10m")).To(Succeed())
+		DeferCleanup(os.Unsetenv, common.EnvWorkerDrainTimeout)
+	})
+
+	JustBeforeEach(func() {
+		dataset := &datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "fluid",
+			},
+		}
+		resources := []runtime.Object{fluidRuntime, dataset, workerSts, node}
+		for _, pod := range pods {
+			resources = append(resources, pod)
+		}
+		k8sClient = fake.NewFakeClientWithScheme(datav1alpha1.UnitTestScheme, resources...)
+		runtimeInfo, _ = base.BuildRuntimeInfo(fluidRuntime.Name, fluidRuntime.Namespace, common.JuiceFSRuntime)
+		helper = BuildHelper(runtimeInfo, k8sClient, fake.NullLogger())
+		if drainer != nil {
+			helper = helper.WithWorkerDrainer(drainer)
+		}
+
+		ctx := cruntime.ReconcileRequestContext{
+			Log:      fake.NullLogger(),
+			Recorder: record.NewFakeRecorder(10),
+		}
+		Expect(helper.SyncReplicas(ctx, fluidRuntime, *fluidRuntime.Status.DeepCopy(), workerSts)).To(Succeed())
+	})
+
+	getStsReplicas := func() int32 {
+		sts := &appsv1.StatefulSet{}
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "test-worker"}, sts)).To(Succeed())
+		return *sts.Spec.Replicas
+	}
+
+	getScaledInCondition := func() *datav1alpha1.RuntimeCondition {
+		updatedRuntime := &datav1alpha1.JuiceFSRuntime{}
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "test"}, updatedRuntime)).To(Succeed())
+		_, cond := utils.GetRuntimeCondition(updatedRuntime.Status.Conditions, datav1alpha1.RuntimeWorkerScaledIn)
+		return cond
+	}
+
+	getPodAnnotations := func(name string) map[string]string {
+		pod := &corev1.Pod{}
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: name}, pod)).To(Succeed())
+		return pod.Annotations
+	}
+
+	setDraining := func(transitionTime time.Time) {
+		cond := utils.NewRuntimeCondition(datav1alpha1.RuntimeWorkerScaledIn, datav1alpha1.RuntimeWorkersDrainingReason,
+			"Draining workers", corev1.ConditionFalse)
+		cond.LastTransitionTime = metav1.NewTime(transitionTime)
+		fluidRuntime.Status.Conditions = []datav1alpha1.RuntimeCondition{cond}
+		pods[1].Annotations = map[string]string{common.AnnotationWorkerDraining: "true"}
+	}
+
+	When("the runtime is scaled in", func() {
+		It("should mark the worker to be removed as draining instead of scaling in", func() {
+			Expect(getStsReplicas()).To(Equal(int32(2)))
+			Expect(getPodAnnotations("test-worker-1")).To(HaveKeyWithValue(common.AnnotationWorkerDraining, "true"))
+			Expect(getPodAnnotations("test-worker-0")).NotTo(HaveKey(common.AnnotationWorkerDraining))
+
+			cond := getScaledInCondition()
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Status).To(Equal(corev1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(datav1alpha1.RuntimeWorkersDrainingReason))
+			Expect(cond.Message).To(ContainSubstring("test-worker-1"))
+		})
+	})
+
+	When("the node of the draining worker still has the schedule info", func() {
+		BeforeEach(func() {
+			setDraining(time.Now())
+		})
+
+		It("should keep draining", func() {
+			Expect(getStsReplicas()).To(Equal(int32(2)))
+			Expect(getScaledInCondition().Reason).To(Equal(datav1alpha1.RuntimeWorkersDrainingReason))
+		})
+	})
+
+	When("the schedule info is removed from the node of the draining worker", func() {
+		BeforeEach(func() {
+			setDraining(time.Now())
+			node.Labels = nil
+		})
+
+		It("should scale in the workers", func() {
+			Expect(getStsReplicas()).To(Equal(int32(1)))
+			cond := getScaledInCondition()
+			Expect(cond.Status).To(Equal(corev1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(datav1alpha1.RuntimeWorkersScaledInReason))
+		})
+
+		When("the engine is migrating the cache", func() {
+			BeforeEach(func() {
+				drainer = &fakeWorkerDrainer{drained: false}
+			})
+
+			It("should keep draining", func() {
+				Expect(getStsReplicas()).To(Equal(int32(2)))
+				Expect(drainer.finished).To(BeEmpty())
+			})
+		})
+
+		When("the engine has migrated the cache", func() {
+			BeforeEach(func() {
+				drainer = &fakeWorkerDrainer{drained: true}
+			})
+
+			It("should finish draining and scale in the workers", func() {
+				Expect(getStsReplicas()).To(Equal(int32(1)))
+				Expect(drainer.finished).To(Equal([]string{"test-worker-1"}))
+			})
+		})
+	})
+
+	When("the draining times out", func() {
+		BeforeEach(func() {
+			setDraining(time.Now().Add(-time.Hour))
+		})
+
+		It("should scale in the workers anyway", func() {
+			Expect(getStsReplicas()).To(Equal(int32(1)))
+		})
+	})
+
+	When("the draining is not enabled", func() {
+		BeforeEach(func() {
+			Expect(os.Unsetenv(common.EnvWorkerDrainTimeout)).To(Succeed())
+		})
+
+		It("should scale in the workers directly", func() {
+			Expect(getStsReplicas()).To(Equal(int32(1)))
+			Expect(getPodAnnotations("test-worker-1")).NotTo(HaveKey(common.AnnotationWorkerDraining))
+		})
+	})
+
+	When("the runtime is scaled out again during the draining", func() {
+		BeforeEach(func() {
+			setDraining(time.Now())
+			fluidRuntime.Spec.Replicas = 2
+		})
+
+		It("should cancel the draining", func() {
+			Expect(getStsReplicas()).To(Equal(int32(2)))
+			Expect(getPodAnnotations("test-worker-1")).NotTo(HaveKey(common.AnnotationWorkerDraining))
+			Expect(getScaledInCondition().Reason).To(Equal(datav1alpha1.RuntimeWorkersDrainCanceledReason))
+		})
+	})
+
+	Describe("Test Helper.ReplicateWorkerCache()", func() {
+		var (
+			replicator   *fakeWorkerCacheReplicator
+			drainingPods func() []corev1.Pod
+		)
+
+		BeforeEach(func() {
+			pods[1].Status.PodIP = "192.168.0.2"
+			replicator = &fakeWorkerCacheReplicator{
+				locations: map[string][]string{
+					"/a": {"192.168.0.2"},
+					"/b": {"192.168.0.1", "192.168.0.2"},
+					"/c": {"192.168.0.2"},
+				},
+				minReplication: map[string]int32{"/a": 1, "/b": 0, "/c": 3},
+			}
+			drainingPods = func() []corev1.Pod {
+				pod := &corev1.Pod{}
+				Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "test-worker-1"}, pod)).To(Succeed())
+				return []corev1.Pod{*pod}
+			}
+		})
+
+		It("should replicate the files cached on the draining workers alone and restore their minimum replication", func() {
+			replicated, err := helper.ReplicateWorkerCache(drainingPods(), replicator)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replicated).To(BeFalse())
+			Expect(replicator.minReplication).To(Equal(map[string]int32{"/a": 2, "/b": 0, "/c": 3}))
+			Expect(IsWorkerCacheReplicating(drainingPods())).To(BeTrue())
+
+			replicated, err = helper.ReplicateWorkerCache(drainingPods(), replicator)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replicated).To(BeFalse())
+
+			replicator.locations["/a"] = []string{"192.168.0.1", "192.168.0.2"}
+			replicated, err = helper.ReplicateWorkerCache(drainingPods(), replicator)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replicated).To(BeTrue())
+
+			Expect(helper.RestoreWorkerCacheReplication(drainingPods(), replicator)).To(Succeed())
+			Expect(replicator.minReplication).To(Equal(map[string]int32{"/a": 1, "/b": 0, "/c": 3}))
+		})
+
+		It("should be replicated if nothing is cached on the draining workers alone", func() {
+			delete(replicator.locations, "/a")
+			delete(replicator.locations, "/c")
+			replicated, err := helper.ReplicateWorkerCache(drainingPods(), replicator)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replicated).To(BeTrue())
+			Expect(replicator.minReplication).To(Equal(map[string]int32{"/a": 1, "/b": 0, "/c": 3}))
+		})
+	})
+})
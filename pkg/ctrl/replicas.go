@@ -37,6 +37,12 @@ func (e *Helper) SyncReplicas(ctx cruntime.ReconcileRequestContext,
 
 	var cond datav1alpha1.RuntimeCondition
 
+	// 0. scale the runtime automatically if the autoscaling policy is set
+	err = e.Autoscale(ctx, runtime, workers)
+	if err != nil {
+		return
+	}
+
 	// nil pointer protection
 	var currentWorkerStsReplicas int32
 	if workers.Spec.Replicas != nil {
@@ -45,6 +51,20 @@ func (e *Helper) SyncReplicas(ctx cruntime.ReconcileRequestContext,
 		currentWorkerStsReplicas = 0
 	}
 
+	if runtime.Replicas() < currentWorkerStsReplicas {
+		// drain the workers to be removed before scaling in
+		var drained bool
+		drained, err = e.DrainWorkers(ctx, runtime, currentStatus, workers)
+		if err != nil || !drained {
+			return
+		}
+	} else {
+		err = e.CancelDrainingWorkers(runtime, currentStatus, workers)
+		if err != nil {
+			return
+		}
+	}
+
 	if runtime.Replicas() != currentWorkerStsReplicas {
 		// 1. update scale condition
 		statusToUpdate := runtime.GetStatus()
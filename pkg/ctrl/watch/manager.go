@@ -208,5 +208,18 @@ func SetupWatcherForWebhook(mgr ctrl.Manager, certBuilder *webhook.CertificateBu
 		return err
 	}
 
+	validatingWebhookConfigurationEventHandler := &validatingWebhookConfigurationEventHandler{}
+	err = webhookController.Watch(source.Kind(mgr.GetCache(), &admissionregistrationv1.ValidatingWebhookConfiguration{}),
+		&handler.EnqueueRequestForObject{},
+		predicate.Funcs{
+			CreateFunc: validatingWebhookConfigurationEventHandler.onCreateFunc(webhookName),
+			UpdateFunc: validatingWebhookConfigurationEventHandler.onUpdateFunc(webhookName),
+			DeleteFunc: validatingWebhookConfigurationEventHandler.onDeleteFunc(webhookName),
+		})
+	if err != nil {
+		log.Error(err, "Failed to watch validatingWebhookConfiguration")
+		return err
+	}
+
 	return
 }
@@ -58,6 +58,12 @@ func (e *Helper) SetupWorkers(runtime base.RuntimeInterface,
 	}
 
 	if actualReplicas != desireReplicas {
+		// the cache capacity of the new workers is reserved in the DatasetQuotas before scaling out
+		err = base.ReserveDatasetQuota(e.client, e.runtimeInfo, actualReplicas, desireReplicas)
+		if err != nil {
+			return err
+		}
+
 		// workerToUpdate, err := e.buildWorkersAffinity(workers)
 
 		workerToUpdate, err := e.BuildWorkersAffinity(workers)
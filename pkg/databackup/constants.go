@@ -20,6 +20,7 @@ const (
 	Finalizer            = "fluid-databackup-controller-finalizer"
 	AlluxioBackupPathPod = "/alluxio_backups"
 	GooseFSBackupPathPod = "/goosefs_backups"
+	JuiceFSBackupPathPod = "/juicefs_backups"
 	DatabackupChart      = "fluid-databackup"
 
 	BackupLocationPath     = "BackupLocationPath"
@@ -42,6 +42,9 @@ type DataBackup struct {
 	PVCName        string `yaml:"pvcName,omitempty"`
 	Path           string `yaml:"path,omitempty"`
 	RuntimeType    string `yaml:"runtimeType,omitempty"`
+	// secret which stores the meta url of JuiceFS community edition
+	MetaUrlSecret    string `yaml:"metaurlSecret,omitempty"`
+	MetaUrlSecretKey string `yaml:"metaurlSecretKey,omitempty"`
 	// image pull secrets
 	ImagePullSecrets []corev1.LocalObjectReference `yaml:"imagePullSecrets,omitempty"`
 	Affinity         *corev1.Affinity              `yaml:"affinity,omitempty"`
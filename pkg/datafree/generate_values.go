@@ -0,0 +1,106 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datafree
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/docker"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/transformer"
+)
+
+// GenDataFreeValue builds a DataFreeValue from the given DataFree, the engine-specific fields like the image
+// and the options are expected to be filled by the engine.
+func GenDataFreeValue(c client.Client, dataset *datav1alpha1.Dataset, dataFree *datav1alpha1.DataFree, image string) (*DataFreeValue, error) {
+	// free the cache of the whole dataset if no path is specified
+	targetPaths := []string{}
+	for _, path := range dataFree.Spec.Paths {
+		path = strings.TrimSpace(path)
+		if len(path) > 0 {
+			targetPaths = append(targetPaths, path)
+		}
+	}
+	if len(targetPaths) == 0 {
+		targetPaths = append(targetPaths, "/")
+	}
+
+	options := map[string]string{}
+	for k, v := range dataFree.Spec.Options {
+		options[k] = v
+	}
+
+	dataFreeInfo := DataFreeInfo{
+		Policy:           string(dataFree.Spec.Policy),
+		Schedule:         dataFree.Spec.Schedule,
+		BackoffLimit:     3,
+		TargetDataset:    dataFree.Spec.Dataset.Name,
+		TargetPaths:      targetPaths,
+		Image:            image,
+		Options:          options,
+		Labels:           dataFree.Spec.PodMetadata.Labels,
+		Annotations:      dataflow.InjectAffinityAnnotation(dataFree.Annotations, dataFree.Spec.PodMetadata.Annotations),
+		ImagePullSecrets: docker.GetImagePullSecretsFromEnv(common.EnvImagePullSecretsKey),
+		Tolerations:      dataFree.Spec.Tolerations,
+		NodeSelector:     dataFree.Spec.NodeSelector,
+		SchedulerName:    dataFree.Spec.SchedulerName,
+		Resources:        dataFree.Spec.Resources,
+	}
+
+	// inject the node affinity by previous operation pod.
+	affinity, err := dataflow.InjectAffinityByRunAfterOp(c, dataFree.Spec.RunAfter, dataFree.Namespace, dataFree.Spec.Affinity)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to inject affinity by runAfterOp")
+	}
+	dataFreeInfo.Affinity = affinity
+
+	return &DataFreeValue{
+		Name:           dataFree.Name,
+		OwnerDatasetId: utils.GetDatasetId(dataset.Namespace, dataset.Name, string(dataset.UID)),
+		Owner:          transformer.GenerateOwnerReferenceFromObject(dataFree),
+		DataFreeInfo:   dataFreeInfo,
+	}, nil
+}
+
+// WriteDataFreeValueFile marshals the DataFreeValue to a temporary yaml file used by fluid datafree helm chart.
+func WriteDataFreeValueFile(value *DataFreeValue, dataFree *datav1alpha1.DataFree, runtimeType string) (valueFileName string, err error) {
+	data, err := yaml.Marshal(value)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to marshal dataFreeValue of DataFree %s/%s", dataFree.Namespace, dataFree.Name)
+	}
+
+	valueFile, err := os.CreateTemp(os.TempDir(), fmt.Sprintf("%s-%s-%s-free-values.yaml", dataFree.Namespace, dataFree.Name, runtimeType))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create temp file to store values for DataFree %s/%s", dataFree.Namespace, dataFree.Name)
+	}
+
+	err = os.WriteFile(valueFile.Name(), data, 0o400)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to write temp file %s", valueFile.Name())
+	}
+
+	return valueFile.Name(), nil
+}
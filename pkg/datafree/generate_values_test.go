@@ -0,0 +1,140 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datafree
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestGenDataFreeValue(t *testing.T) {
+	dataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "demo-dataset",
+			Namespace: "default",
+			UID:       "dataset-uid",
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		dataFree      *datav1alpha1.DataFree
+		wantPaths     []string
+		wantOptions   map[string]string
+		wantPolicy    string
+		wantSchedule  string
+		wantDatasetId string
+	}{
+		{
+			name: "free the whole dataset",
+			dataFree: &datav1alpha1.DataFree{
+				ObjectMeta: metav1.ObjectMeta{Name: "demo-free", Namespace: "default"},
+				Spec: datav1alpha1.DataFreeSpec{
+					Dataset: datav1alpha1.TargetDataset{Name: dataset.Name, Namespace: dataset.Namespace},
+					Policy:  datav1alpha1.Once,
+				},
+			},
+			wantPaths:     []string{"/"},
+			wantOptions:   map[string]string{},
+			wantPolicy:    "Once",
+			wantDatasetId: "default-demo-dataset",
+		},
+		{
+			name: "free the given paths by cron",
+			dataFree: &datav1alpha1.DataFree{
+				ObjectMeta: metav1.ObjectMeta{Name: "demo-free", Namespace: "default"},
+				Spec: datav1alpha1.DataFreeSpec{
+					Dataset:  datav1alpha1.TargetDataset{Name: dataset.Name, Namespace: dataset.Namespace},
+					Paths:    []string{" /a ", "", "/b/c"},
+					Options:  map[string]string{"force": "true"},
+					Policy:   datav1alpha1.Cron,
+					Schedule: "0 * * * *",
+				},
+			},
+			wantPaths:     []string{"/a", "/b/c"},
+			wantOptions:   map[string]string{"force": "true"},
+			wantPolicy:    "Cron",
+			wantSchedule:  "0 * * * *",
+			wantDatasetId: "default-demo-dataset",
+		},
+	}
+
+	for _, tc := range testCases {
+		c := fake.NewFakeClientWithScheme(datav1alpha1.UnitTestScheme, dataset, tc.dataFree)
+		value, err := GenDataFreeValue(c, dataset, tc.dataFree, "test-image:latest")
+		if err != nil {
+			t.Fatalf("testcase %s: unexpected error %v", tc.name, err)
+		}
+		info := value.DataFreeInfo
+		if !reflect.DeepEqual(info.TargetPaths, tc.wantPaths) {
+			t.Errorf("testcase %s: expect target paths %v, got %v", tc.name, tc.wantPaths, info.TargetPaths)
+		}
+		if !reflect.DeepEqual(info.Options, tc.wantOptions) {
+			t.Errorf("testcase %s: expect options %v, got %v", tc.name, tc.wantOptions, info.Options)
+		}
+		if info.Policy != tc.wantPolicy || info.Schedule != tc.wantSchedule {
+			t.Errorf("testcase %s: expect policy %s and schedule %q, got %s and %q", tc.name, tc.wantPolicy, tc.wantSchedule, info.Policy, info.Schedule)
+		}
+		if info.Image != "test-image:latest" || info.TargetDataset != dataset.Name {
+			t.Errorf("testcase %s: unexpected image %s or target dataset %s", tc.name, info.Image, info.TargetDataset)
+		}
+		if value.OwnerDatasetId != tc.wantDatasetId {
+			t.Errorf("testcase %s: expect owner dataset id %s, got %s", tc.name, tc.wantDatasetId, value.OwnerDatasetId)
+		}
+		if value.Owner == nil || value.Owner.Name != tc.dataFree.Name {
+			t.Errorf("testcase %s: expect owner %s, got %v", tc.name, tc.dataFree.Name, value.Owner)
+		}
+	}
+}
+
+func TestWriteDataFreeValueFile(t *testing.T) {
+	dataFree := &datav1alpha1.DataFree{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo-free", Namespace: "default"},
+	}
+	value := &DataFreeValue{
+		Name: dataFree.Name,
+		DataFreeInfo: DataFreeInfo{
+			TargetDataset: "demo-dataset",
+			TargetPaths:   []string{"/"},
+		},
+	}
+
+	valueFileName, err := WriteDataFreeValueFile(value, dataFree, "alluxio")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	defer os.Remove(valueFileName)
+
+	data, err := os.ReadFile(valueFileName)
+	if err != nil {
+		t.Fatalf("failed to read value file: %v", err)
+	}
+	var got DataFreeValue
+	if err = yaml.Unmarshal(data, &got); err != nil {
+		t.Fatalf("failed to unmarshal value file: %v", err)
+	}
+	if !reflect.DeepEqual(&got, value) {
+		t.Errorf("expect value %v, got %v", value, got)
+	}
+}
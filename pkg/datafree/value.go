@@ -0,0 +1,78 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datafree
+
+import (
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// DataFreeValue defines the value yaml file used in DataFree helm chart
+type DataFreeValue struct {
+	Name           string                 `json:"name"`
+	OwnerDatasetId string                 `json:"ownerDatasetId"`
+	Owner          *common.OwnerReference `json:"owner,omitempty"`
+	DataFreeInfo   DataFreeInfo           `json:"datafree"`
+}
+
+// DataFreeInfo defines values used in DataFree helm chart
+type DataFreeInfo struct {
+	// Policy including Once, Cron
+	Policy string `json:"policy"`
+
+	// Schedule The schedule in Cron format, only set when policy is cron, see https://en.wikipedia.org/wiki/Cron.
+	Schedule string `json:"schedule,omitempty"`
+
+	// BackoffLimit specifies the upper limit times when the DataFree job fails
+	BackoffLimit int32 `json:"backoffLimit,omitempty"`
+
+	// TargetDataset specifies the dataset that the DataFree targets
+	TargetDataset string `json:"targetDataset,omitempty"`
+
+	// TargetPaths specifies which paths should the DataFree free, the whole dataset is freed if empty
+	TargetPaths []string `json:"targetPaths,omitempty"`
+
+	// Image specifies the image that the DataFree job uses
+	Image string `json:"image,omitempty"`
+
+	// Options specifies the extra datafree properties for runtime
+	Options map[string]string `json:"options,omitempty"`
+
+	// Labels defines labels in DataFree's pod metadata
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations defines annotations in DataFree's pod metadata
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// image pull secrets
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	// pod affinity
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	// pod tolerations
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// node selector
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// scheduler name
+	SchedulerName string `json:"schedulerName,omitempty"`
+
+	// Resources that will be requested by DataFree job.
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
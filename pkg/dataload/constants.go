@@ -24,4 +24,8 @@ const (
 	DataloadDefaultImage = "registry.cn-hangzhou.aliyuncs.com/fluid/fluid-dataloader"
 	DataloadSuffixLength = 5
 	EnvDataloaderImg     = "DATALOADER_IMG"
+
+	// DataloadProgressKey is the key of the progress ConfigMap where DataLoad job reports
+	// the progress of target paths in json format
+	DataloadProgressKey = "progress"
 )
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataload
+
+import (
+	"fmt"
+	"time"
+
+	utilcache "k8s.io/apimachinery/pkg/util/cache"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+const (
+	duCacheSize = 256
+
+	defaultDuCacheTTL = time.Minute
+)
+
+// duCache holds the du results of the target paths, because du is costly for large directories and the progress
+// of DataLoad is refreshed on every reconcile.
+var duCache = utilcache.NewLRUExpireCache(duCacheSize)
+
+type duResult struct {
+	total  int64
+	cached int64
+}
+
+// DuFunc returns the total bytes and the cached bytes under the path.
+type DuFunc func(path string) (total int64, cached int64, err error)
+
+// GetDuWithCache returns the du result of the path in the cache runtime if it's not expired, otherwise it runs du
+// and caches the result for FLUID_DATALOAD_DU_CACHE_TTL. Failures are not cached.
+func GetDuWithCache(namespace, runtimeName, path string, du DuFunc) (total int64, cached int64, err error) {
+	key := fmt.Sprintf("%s/%s:%s", namespace, runtimeName, path)
+	if value, found := duCache.Get(key); found {
+		if result, ok := value.(duResult); ok {
+			return result.total, result.cached, nil
+		}
+	}
+
+	total, cached, err = du(path)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	duCache.Add(key, duResult{total: total, cached: cached}, utils.GetDurationValueFromEnv(common.EnvDataLoadDuCacheTTL, defaultDuCacheTTL))
+	return total, cached, nil
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataload
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+func TestGetDuWithCache(t *testing.T) {
+	called := 0
+	failed := false
+	du := func(path string) (int64, int64, error) {
+		called++
+		if failed {
+			return 0, 0, errors.New("du failed")
+		}
+		return 4096, 1024, nil
+	}
+
+	failed = true
+	if _, _, err := GetDuWithCache("fluid", "hbase", "/a", du); err == nil {
+		t.Errorf("GetDuWithCache() expect error when du fails")
+	}
+
+	failed = false
+	for i := 0; i < 2; i++ {
+		total, cached, err := GetDuWithCache("fluid", "hbase", "/a", du)
+		if err != nil || total != 4096 || cached != 1024 {
+			t.Errorf("GetDuWithCache() = %d, %d, %v, want 4096, 1024, nil", total, cached, err)
+		}
+	}
+	if called != 2 {
+		t.Errorf("expect du to be called twice since the failure is not cached, got %d", called)
+	}
+
+	if _, _, err := GetDuWithCache("fluid", "spark", "/a", du); err != nil || called != 3 {
+		t.Errorf("expect du to be called for another runtime, got %d calls, err %v", called, err)
+	}
+
+	t.Setenv(common.EnvDataLoadDuCacheTTL, "0s")
+	if _, _, err := GetDuWithCache("fluid", "hive", "/a", du); err != nil {
+		t.Fatalf("GetDuWithCache() got unexpected error: %v", err)
+	}
+	if _, _, err := GetDuWithCache("fluid", "hive", "/a", du); err != nil || called != 5 {
+		t.Errorf("expect the expired du result to be refreshed, got %d calls, err %v", called, err)
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataload
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+// ParseProgress parses the progress of target paths reported by DataLoad job from the progress ConfigMap.
+// The progress is stored as a json array under the key `progress`, e.g.
+// [{"path":"/a","loadedBytes":1024,"totalBytes":4096,"loadedFiles":1,"totalFiles":4,"eta":"3m"}]
+// It returns nil if the ConfigMap does not exist or the job has not reported any progress yet.
+func ParseProgress(configMap *corev1.ConfigMap) ([]datav1alpha1.TargetPathProgress, error) {
+	if configMap == nil {
+		return nil, nil
+	}
+
+	data, found := configMap.Data[DataloadProgressKey]
+	if !found || len(data) == 0 {
+		return nil, nil
+	}
+
+	var progress []datav1alpha1.TargetPathProgress
+	if err := json.Unmarshal([]byte(data), &progress); err != nil {
+		return nil, fmt.Errorf("failed to parse the progress in ConfigMap %s/%s: %v", configMap.Namespace, configMap.Name, err)
+	}
+	return progress, nil
+}
+
+// GetTargetPaths returns the target paths loaded by the current run of the DataLoad, the root path is loaded if no
+// target path is specified.
+func GetTargetPaths(dataLoad *datav1alpha1.DataLoad) []string {
+	targets := GetTargetsToLoad(dataLoad)
+	if len(targets) == 0 {
+		return []string{"/"}
+	}
+
+	paths := make([]string, 0, len(targets))
+	for _, target := range targets {
+		paths = append(paths, target.Path)
+	}
+	return paths
+}
+
+// FindProgress returns the progress of the given path, or nil if the path is not found.
+func FindProgress(progress []datav1alpha1.TargetPathProgress, path string) *datav1alpha1.TargetPathProgress {
+	for i := range progress {
+		if progress[i].Path == path {
+			return &progress[i]
+		}
+	}
+	return nil
+}
+
+// EstimateETA estimates the remaining time of the paths whose ETA is not reported, assuming the data
+// has been loaded at a constant rate since the DataLoad job started.
+func EstimateETA(progress []datav1alpha1.TargetPathProgress, elapsed time.Duration) {
+	for i := range progress {
+		p := &progress[i]
+		if len(p.ETA) > 0 {
+			continue
+		}
+
+		loaded, total := p.LoadedBytes, p.TotalBytes
+		if total == 0 {
+			loaded, total = p.LoadedFiles, p.TotalFiles
+		}
+		if loaded <= 0 || total <= 0 {
+			continue
+		}
+
+		remaining := time.Duration(0)
+		if total > loaded {
+			remaining = time.Duration(float64(elapsed) * float64(total-loaded) / float64(loaded))
+		}
+		p.ETA = remaining.Round(time.Second).String()
+	}
+}
+
+// CompleteProgress marks all the data under the paths as loaded once the DataLoad job succeeds.
+func CompleteProgress(progress []datav1alpha1.TargetPathProgress) {
+	for i := range progress {
+		p := &progress[i]
+		if p.TotalBytes > p.LoadedBytes {
+			p.LoadedBytes = p.TotalBytes
+		}
+		if p.TotalFiles > p.LoadedFiles {
+			p.LoadedFiles = p.TotalFiles
+		}
+		p.ETA = time.Duration(0).String()
+	}
+}
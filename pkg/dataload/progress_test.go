@@ -0,0 +1,119 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataload
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+func TestParseProgress(t *testing.T) {
+	tests := []struct {
+		name      string
+		configMap *corev1.ConfigMap
+		want      []datav1alpha1.TargetPathProgress
+		wantErr   bool
+	}{
+		{
+			name:      "configmap not found",
+			configMap: nil,
+			want:      nil,
+		},
+		{
+			name:      "no progress reported",
+			configMap: &corev1.ConfigMap{Data: map[string]string{}},
+			want:      nil,
+		},
+		{
+			name: "progress reported",
+			configMap: &corev1.ConfigMap{Data: map[string]string{
+				DataloadProgressKey: `[{"path":"/a","loadedBytes":1024,"totalBytes":4096,"loadedFiles":1,"totalFiles":4,"eta":"3m"},{"path":"/b"}]`,
+			}},
+			want: []datav1alpha1.TargetPathProgress{
+				{Path: "/a", LoadedBytes: 1024, TotalBytes: 4096, LoadedFiles: 1, TotalFiles: 4, ETA: "3m"},
+				{Path: "/b"},
+			},
+		},
+		{
+			name: "invalid progress",
+			configMap: &corev1.ConfigMap{Data: map[string]string{
+				DataloadProgressKey: `{"path":"/a"`,
+			}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseProgress(tt.configMap)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseProgress() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseProgress() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetTargetPaths(t *testing.T) {
+	dataLoad := &datav1alpha1.DataLoad{}
+	if got := GetTargetPaths(dataLoad); !reflect.DeepEqual(got, []string{"/"}) {
+		t.Errorf("GetTargetPaths() = %v, want [/]", got)
+	}
+
+	dataLoad.Spec.Target = []datav1alpha1.TargetPath{{Path: "/a"}, {Path: "/b"}}
+	if got := GetTargetPaths(dataLoad); !reflect.DeepEqual(got, []string{"/a", "/b"}) {
+		t.Errorf("GetTargetPaths() = %v, want [/a /b]", got)
+	}
+}
+
+func TestEstimateETA(t *testing.T) {
+	progress := []datav1alpha1.TargetPathProgress{
+		{Path: "/bytes", LoadedBytes: 1024, TotalBytes: 4096},
+		{Path: "/files", LoadedFiles: 1, TotalFiles: 2},
+		{Path: "/reported", LoadedBytes: 1024, TotalBytes: 4096, ETA: "1h"},
+		{Path: "/loaded", LoadedBytes: 4096, TotalBytes: 4096},
+		{Path: "/unknown", TotalBytes: 4096},
+	}
+	EstimateETA(progress, time.Minute)
+
+	want := []string{"3m0s", "1m0s", "1h", "0s", ""}
+	for i, p := range progress {
+		if p.ETA != want[i] {
+			t.Errorf("EstimateETA() of path %s = %q, want %q", p.Path, p.ETA, want[i])
+		}
+	}
+}
+
+func TestCompleteProgress(t *testing.T) {
+	progress := []datav1alpha1.TargetPathProgress{
+		{Path: "/a", LoadedBytes: 1024, TotalBytes: 4096, TotalFiles: 4, ETA: "3m"},
+	}
+	CompleteProgress(progress)
+
+	want := []datav1alpha1.TargetPathProgress{
+		{Path: "/a", LoadedBytes: 4096, TotalBytes: 4096, LoadedFiles: 4, TotalFiles: 4, ETA: "0s"},
+	}
+	if !reflect.DeepEqual(progress, want) {
+		t.Errorf("CompleteProgress() = %v, want %v", progress, want)
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataload
+
+import (
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/ufschange"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// GetWatchedPaths returns the target paths whose changes in the under file system re-trigger the DataLoad.
+// It returns nil if the policy of the DataLoad is not OnEvent.
+func GetWatchedPaths(dataLoad *datav1alpha1.DataLoad) []string {
+	if dataLoad.Spec.Policy != datav1alpha1.OnEvent {
+		return nil
+	}
+
+	if len(dataLoad.Spec.Target) == 0 {
+		return []string{"/"}
+	}
+
+	paths := make([]string, 0, len(dataLoad.Spec.Target))
+	for _, target := range dataLoad.Spec.Target {
+		paths = append(paths, target.Path)
+	}
+	return paths
+}
+
+// GetTargetsToLoad returns the targets loaded by the current run of the DataLoad. All the targets are loaded in
+// the first run, and only the changed ones are reloaded when the OnEvent DataLoad is re-triggered.
+func GetTargetsToLoad(dataLoad *datav1alpha1.DataLoad) []datav1alpha1.TargetPath {
+	changedPaths := ufschange.GetPathsInfo(dataLoad.Status.Infos, ufschange.ChangedPathsInfoKey)
+	if dataLoad.Spec.Policy != datav1alpha1.OnEvent || len(changedPaths) == 0 {
+		return dataLoad.Spec.Target
+	}
+
+	targets := make([]datav1alpha1.TargetPath, 0, len(changedPaths))
+	for _, target := range dataLoad.Spec.Target {
+		if utils.ContainsString(changedPaths, target.Path) {
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataload
+
+import (
+	"reflect"
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/ufschange"
+)
+
+func TestGetWatchedPaths(t *testing.T) {
+	testcases := []struct {
+		name     string
+		spec     datav1alpha1.DataLoadSpec
+		expected []string
+	}{
+		{
+			name:     "once policy",
+			spec:     datav1alpha1.DataLoadSpec{Policy: datav1alpha1.Once, Target: []datav1alpha1.TargetPath{{Path: "/a"}}},
+			expected: nil,
+		},
+		{
+			name:     "onevent policy without target",
+			spec:     datav1alpha1.DataLoadSpec{Policy: datav1alpha1.OnEvent},
+			expected: []string{"/"},
+		},
+		{
+			name:     "onevent policy with targets",
+			spec:     datav1alpha1.DataLoadSpec{Policy: datav1alpha1.OnEvent, Target: []datav1alpha1.TargetPath{{Path: "/a"}, {Path: "/b"}}},
+			expected: []string{"/a", "/b"},
+		},
+	}
+
+	for _, testcase := range testcases {
+		dataLoad := &datav1alpha1.DataLoad{Spec: testcase.spec}
+		if got := GetWatchedPaths(dataLoad); !reflect.DeepEqual(got, testcase.expected) {
+			t.Errorf("testcase %s: expect %v, got %v", testcase.name, testcase.expected, got)
+		}
+	}
+}
+
+func TestGetTargetsToLoad(t *testing.T) {
+	targets := []datav1alpha1.TargetPath{{Path: "/a", Replicas: 1}, {Path: "/b", Replicas: 2}}
+	testcases := []struct {
+		name     string
+		policy   datav1alpha1.Policy
+		infos    map[string]string
+		expected []datav1alpha1.TargetPath
+	}{
+		{
+			name:     "first run of onevent dataload",
+			policy:   datav1alpha1.OnEvent,
+			expected: targets,
+		},
+		{
+			name:     "re-triggered onevent dataload",
+			policy:   datav1alpha1.OnEvent,
+			infos:    map[string]string{ufschange.ChangedPathsInfoKey: `["/b"]`},
+			expected: []datav1alpha1.TargetPath{{Path: "/b", Replicas: 2}},
+		},
+		{
+			name:     "once dataload",
+			policy:   datav1alpha1.Once,
+			infos:    map[string]string{ufschange.ChangedPathsInfoKey: `["/b"]`},
+			expected: targets,
+		},
+	}
+
+	for _, testcase := range testcases {
+		dataLoad := &datav1alpha1.DataLoad{
+			Spec:   datav1alpha1.DataLoadSpec{Policy: testcase.policy, Target: targets},
+			Status: datav1alpha1.OperationStatus{Infos: testcase.infos},
+		}
+		if got := GetTargetsToLoad(dataLoad); !reflect.DeepEqual(got, testcase.expected) {
+			t.Errorf("testcase %s: expect %v, got %v", testcase.name, testcase.expected, got)
+		}
+	}
+}
@@ -28,4 +28,39 @@ const (
 
 	DefaultSSHReadyTimeoutSeconds = 180
 	DefaultSSHPort                = 22
+
+	// ParallelMode selects how the parallel tasks coordinate with each other, see ParallelModeSSH and ParallelModeSharded
+	ParallelMode              = "mode"
+	CoordinatorImage          = "coordinatorImage"
+	ShardsReadyTimeoutSeconds = "shardsReadyTimeoutSeconds"
+
+	// ParallelModeSSH runs a launcher which distributes the migration to the workers statefulset by ssh
+	ParallelModeSSH = "ssh"
+	// ParallelModeSharded runs an indexed job without ssh, the first pod lists the source and shards the keys
+	// into a ConfigMap, and each pod migrates the shard of its completion index.
+	ParallelModeSharded = "sharded"
+
+	// DefaultGenericMigrateImage is the image of the generic migrator, which syncs the data by rclone
+	DefaultGenericMigrateImage   = "rclone/rclone:1.68"
+	DefaultGenericMigrateTimeout = "30m"
+
+	DefaultShardsReadyTimeoutSeconds = 600
+
+	// DataMigrateContainerName is the name of the container which migrates the data and reports the result
+	// in its termination message
+	DataMigrateContainerName = "datamigrate"
+
+	// StateSinceKey is the key of the unix time in the state ConfigMap, the files changed since then are copied
+	// in Incremental mode
+	StateSinceKey = "since"
+)
+
+// The keys of the migration result in the status infos of DataMigrate
+const (
+	FilesCopiedInfoKey     = "filesCopied"
+	FilesSkippedInfoKey    = "filesSkipped"
+	FilesFailedInfoKey     = "filesFailed"
+	FilesCheckedInfoKey    = "filesChecked"
+	FilesMismatchedInfoKey = "filesMismatched"
+	MismatchedPathsInfoKey = "mismatchedPaths"
 )
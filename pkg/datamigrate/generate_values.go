@@ -0,0 +1,247 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamigrate
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/dataset/volume"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/docker"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/transformer"
+)
+
+// the datasets and the PVCs to migrate are mounted into the generic migrator under the directory
+const genericMigrateMountRoot = "/fluid"
+
+// rcloneBackends maps the schemes of the external storages to the rclone backends. The object storages compatible
+// with S3 share the s3 backend, and their providers and endpoints are set by the options, e.g. s3-provider: Alibaba.
+var rcloneBackends = map[string]string{
+	"s3":  "s3",
+	"oss": "s3",
+	"cos": "s3",
+	"gs":  "gcs",
+}
+
+// genericMigratorEngines are the engines without their own DataMigrate implementation, which migrate the data by
+// the generic migrator
+var genericMigratorEngines = map[string]bool{
+	common.AlluxioEngineImpl:    true,
+	common.GooseFSEngineImpl:    true,
+	common.JindoCacheEngineImpl: true,
+}
+
+// UseGenericMigrator returns true if the engine migrates the data by the generic migrator, which runs a single task
+// only, so neither the parallel tasks nor the parallel modes are supported.
+func UseGenericMigrator(engineImpl string) bool {
+	return genericMigratorEngines[engineImpl]
+}
+
+// GenDataMigrateValueFile generates the value file of the generic migrator, which is shared by the runtimes without
+// their own DataMigrate implementation, e.g. Alluxio, GooseFS and JindoCache. The datasets are accessed through their
+// fuse PVCs, so the data written to a dataset goes through the runtime to its UFS.
+func GenDataMigrateValueFile(client client.Client, dataMigrate *datav1alpha1.DataMigrate) (valueFileName string, err error) {
+	dataMigrateValue, err := GenDataMigrateValue(client, dataMigrate)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := yaml.Marshal(dataMigrateValue)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to marshal dataMigrateValue of DataMigrate %s/%s", dataMigrate.GetNamespace(), dataMigrate.GetName())
+	}
+
+	valueFile, err := os.CreateTemp(os.TempDir(), fmt.Sprintf("%s-%s-migrate-values.yaml", dataMigrate.Namespace, dataMigrate.Name))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create temp file to store values for DataMigrate %s/%s", dataMigrate.Namespace, dataMigrate.Name)
+	}
+
+	err = os.WriteFile(valueFile.Name(), data, 0o400)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to write temp file %s", valueFile.Name())
+	}
+
+	return valueFile.Name(), nil
+}
+
+// GenDataMigrateValue builds the DataMigrateValue of the generic migrator. The generic migrator syncs the data by
+// rclone with rsync-like semantics, and only runs a single task.
+func GenDataMigrateValue(client client.Client, dataMigrate *datav1alpha1.DataMigrate) (*DataMigrateValue, error) {
+	if dataMigrate.Spec.Parallelism > 1 {
+		return nil, fmt.Errorf("DataMigrate %s/%s sets %d parallel tasks, which is not supported by the generic migrator",
+			dataMigrate.Namespace, dataMigrate.Name, dataMigrate.Spec.Parallelism)
+	}
+
+	targetDataset, err := utils.GetTargetDatasetOfMigrate(client, dataMigrate)
+	if err != nil {
+		return nil, err
+	}
+
+	imageName, imageTag := dataMigrate.Spec.Image, dataMigrate.Spec.ImageTag
+	defaultImageInfo := strings.Split(DefaultGenericMigrateImage, ":")
+	if len(imageName) == 0 {
+		imageName = defaultImageInfo[0]
+	}
+	if len(imageTag) == 0 {
+		imageTag = defaultImageInfo[1]
+	}
+
+	dataMigrateInfo := DataMigrateInfo{
+		BackoffLimit:     3,
+		TargetDataset:    targetDataset.Name,
+		EncryptOptions:   []datav1alpha1.EncryptOption{},
+		Image:            fmt.Sprintf("%s:%s", imageName, imageTag),
+		Options:          map[string]string{},
+		Labels:           dataMigrate.Spec.PodMetadata.Labels,
+		Annotations:      dataflow.InjectAffinityAnnotation(dataMigrate.Annotations, dataMigrate.Spec.PodMetadata.Annotations),
+		ImagePullSecrets: docker.GetImagePullSecretsFromEnv(common.EnvImagePullSecretsKey),
+		Policy:           string(dataMigrate.Spec.Policy),
+		Schedule:         dataMigrate.Spec.Schedule,
+		Mode:             string(dataMigrate.Spec.Mode),
+		Resources:        dataMigrate.Spec.Resources,
+		NodeSelector:     dataMigrate.Spec.NodeSelector,
+		Tolerations:      dataMigrate.Spec.Tolerations,
+		SchedulerName:    dataMigrate.Spec.SchedulerName,
+		Parallelism:      1,
+	}
+
+	// inject the node affinity by previous operation pod.
+	dataMigrateInfo.Affinity, err = dataflow.InjectAffinityByRunAfterOp(client, dataMigrate.Spec.RunAfter, dataMigrate.Namespace, dataMigrate.Spec.Affinity)
+	if err != nil {
+		return nil, err
+	}
+
+	// the options except timeout are passed to rclone as flags
+	timeout := dataMigrate.Spec.Options["timeout"]
+	if timeout == "" {
+		timeout = DefaultGenericMigrateTimeout
+	}
+	keys := make([]string, 0, len(dataMigrate.Spec.Options))
+	for k := range dataMigrate.Spec.Options {
+		if k != "timeout" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	options := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if v := dataMigrate.Spec.Options[k]; v != "" {
+			options = append(options, fmt.Sprintf("--%s=%s", k, v))
+		} else {
+			options = append(options, fmt.Sprintf("--%s", k))
+		}
+	}
+	dataMigrateInfo.Options["option"] = strings.Join(options, " ")
+	dataMigrateInfo.Options["timeout"] = timeout
+
+	dataMigrateInfo.MigrateFrom, err = genGenericDataUrl(client, dataMigrate.Spec.From, "from", dataMigrate.Namespace, &dataMigrateInfo)
+	if err != nil {
+		return nil, err
+	}
+	dataMigrateInfo.MigrateTo, err = genGenericDataUrl(client, dataMigrate.Spec.To, "to", dataMigrate.Namespace, &dataMigrateInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DataMigrateValue{
+		Name:            dataMigrate.Name,
+		OwnerDatasetId:  utils.GetDatasetId(targetDataset.Namespace, targetDataset.Name, string(targetDataset.UID)),
+		Owner:           transformer.GenerateOwnerReferenceFromObject(dataMigrate),
+		DataMigrateInfo: dataMigrateInfo,
+	}, nil
+}
+
+// genGenericDataUrl returns the location of the data to migrate for rclone. A dataset is mounted through its fuse PVC
+// and a pvc:// storage through the PVC, whose locations are the absolute paths in the container, while the other
+// external storages are located by the rclone connection strings, e.g. :s3:bucket/path.
+func genGenericDataUrl(client client.Client, data datav1alpha1.DataToMigrate, side string, namespace string, info *DataMigrateInfo) (dataUrl string, err error) {
+	mountPath := path.Join(genericMigrateMountRoot, side)
+
+	if data.DataSet != nil {
+		if len(data.DataSet.Namespace) > 0 && data.DataSet.Namespace != namespace {
+			return "", fmt.Errorf("dataset %s/%s to migrate is not in the namespace of DataMigrate %s", data.DataSet.Namespace, data.DataSet.Name, namespace)
+		}
+		pvc, err := volume.GetFusePVCOfDataset(client, data.DataSet.Name, namespace)
+		if err != nil {
+			return "", err
+		}
+		if side == "to" && len(pvc.Spec.AccessModes) == 1 && pvc.Spec.AccessModes[0] == corev1.ReadOnlyMany {
+			return "", fmt.Errorf("dataset %s/%s is read only, data can't be migrated to it", namespace, data.DataSet.Name)
+		}
+		mountPVC(info, side+"-dataset", pvc.Name, "", mountPath)
+		return path.Join(mountPath, data.DataSet.Path), nil
+	}
+
+	if data.ExternalStorage == nil {
+		return "", fmt.Errorf("either dataset or externalStorage should be set to migrate %s", side)
+	}
+
+	for _, encryptOption := range data.ExternalStorage.EncryptOptions {
+		encryptOption.Name = utils.ConvertDashToUnderscore(encryptOption.Name)
+		if err = utils.CheckValidateEnvName(encryptOption.Name); err != nil {
+			return "", err
+		}
+		info.EncryptOptions = append(info.EncryptOptions, encryptOption)
+	}
+
+	uri := data.ExternalStorage.URI
+	if strings.HasPrefix(uri, common.VolumeScheme.String()) {
+		// e.g. pvc://my-pvc/path/to/dir
+		pvcName, subPath, _ := strings.Cut(strings.TrimPrefix(uri, common.VolumeScheme.String()), "/")
+		mountPVC(info, side+"-pvc", pvcName, subPath, mountPath)
+		return mountPath, nil
+	}
+
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse the uri %s to migrate %s", uri, side)
+	}
+	backend, found := rcloneBackends[u.Scheme]
+	if !found {
+		return "", fmt.Errorf("the scheme of %s to migrate %s is not supported by the generic migrator", uri, side)
+	}
+	return fmt.Sprintf(":%s:%s%s", backend, u.Host, u.Path), nil
+}
+
+func mountPVC(info *DataMigrateInfo, volumeName string, claimName string, subPath string, mountPath string) {
+	info.NativeVolumes = append(info.NativeVolumes, corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: claimName,
+			},
+		},
+	})
+	info.NativeVolumeMounts = append(info.NativeVolumeMounts, corev1.VolumeMount{
+		Name:      volumeName,
+		MountPath: mountPath,
+		SubPath:   subPath,
+	})
+}
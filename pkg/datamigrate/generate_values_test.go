@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamigrate
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestGenDataMigrateValue(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(testScheme)
+	_ = datav1alpha1.AddToScheme(testScheme)
+
+	newDataset := func(name string) *datav1alpha1.Dataset {
+		return &datav1alpha1.Dataset{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+	}
+	newFusePVC := func(name string, accessMode corev1.PersistentVolumeAccessMode) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels:    map[string]string{common.LabelAnnotationStorageCapacityPrefix + "default-" + name: "true"},
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{AccessModes: []corev1.PersistentVolumeAccessMode{accessMode}},
+		}
+	}
+	objs := []runtime.Object{
+		newDataset("alluxio"), newFusePVC("alluxio", corev1.ReadWriteMany),
+		newDataset("readonly"), newFusePVC("readonly", corev1.ReadOnlyMany),
+		newDataset("nopvc"),
+	}
+	client := fake.NewFakeClientWithScheme(testScheme, objs...)
+
+	s3 := datav1alpha1.DataToMigrate{
+		ExternalStorage: &datav1alpha1.ExternalStorage{
+			URI: "s3://bucket/path/",
+			EncryptOptions: []datav1alpha1.EncryptOption{{
+				Name: "RCLONE-S3-ACCESS-KEY-ID",
+				ValueFrom: datav1alpha1.EncryptOptionSource{
+					SecretKeyRef: datav1alpha1.SecretKeySelector{Name: "s3-secret", Key: "ak"},
+				},
+			}},
+		},
+	}
+	toDataset := func(name string) datav1alpha1.DataToMigrate {
+		return datav1alpha1.DataToMigrate{DataSet: &datav1alpha1.DatasetToMigrate{Name: name, Path: "/dir/"}}
+	}
+	newDataMigrate := func(from, to datav1alpha1.DataToMigrate) *datav1alpha1.DataMigrate {
+		return &datav1alpha1.DataMigrate{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+			Spec: datav1alpha1.DataMigrateSpec{
+				From:    from,
+				To:      to,
+				Mode:    datav1alpha1.IncrementalMigrateMode,
+				Options: map[string]string{"s3-provider": "AWS", "checksum": "", "timeout": "1h"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		dataMigrate *datav1alpha1.DataMigrate
+		wantErr     bool
+		wantFrom    string
+		wantTo      string
+		wantClaims  []string
+	}{
+		{
+			name:        "s3_to_dataset",
+			dataMigrate: newDataMigrate(s3, toDataset("alluxio")),
+			wantFrom:    ":s3:bucket/path/",
+			wantTo:      "/fluid/to/dir",
+			wantClaims:  []string{"alluxio"},
+		},
+		{
+			name:        "dataset_to_pvc",
+			dataMigrate: newDataMigrate(toDataset("alluxio"), datav1alpha1.DataToMigrate{ExternalStorage: &datav1alpha1.ExternalStorage{URI: "pvc://nfs/sub/dir"}}),
+			wantFrom:    "/fluid/from/dir",
+			wantTo:      "/fluid/to",
+			wantClaims:  []string{"alluxio", "nfs"},
+		},
+		{
+			name:        "dataset_without_fuse_pvc",
+			dataMigrate: newDataMigrate(s3, toDataset("nopvc")),
+			wantErr:     true,
+		},
+		{
+			name:        "read_only_dataset",
+			dataMigrate: newDataMigrate(s3, toDataset("readonly")),
+			wantErr:     true,
+		},
+		{
+			name: "parallel_tasks",
+			dataMigrate: func() *datav1alpha1.DataMigrate {
+				dataMigrate := newDataMigrate(s3, toDataset("alluxio"))
+				dataMigrate.Spec.Parallelism = 2
+				return dataMigrate
+			}(),
+			wantErr: true,
+		},
+		{
+			name:        "unsupported_scheme",
+			dataMigrate: newDataMigrate(datav1alpha1.DataToMigrate{ExternalStorage: &datav1alpha1.ExternalStorage{URI: "hdfs://namenode/path"}}, toDataset("alluxio")),
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := GenDataMigrateValue(client, tt.dataMigrate)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GenDataMigrateValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			info := value.DataMigrateInfo
+			if info.MigrateFrom != tt.wantFrom || info.MigrateTo != tt.wantTo {
+				t.Errorf("GenDataMigrateValue() migrates from %s to %s, want from %s to %s", info.MigrateFrom, info.MigrateTo, tt.wantFrom, tt.wantTo)
+			}
+			if info.Image != DefaultGenericMigrateImage || info.Mode != string(datav1alpha1.IncrementalMigrateMode) || info.Parallelism != 1 {
+				t.Errorf("GenDataMigrateValue() image = %s, mode = %s, parallelism = %d", info.Image, info.Mode, info.Parallelism)
+			}
+			if info.Options["option"] != "--checksum --s3-provider=AWS" || info.Options["timeout"] != "1h" {
+				t.Errorf("GenDataMigrateValue() options = %v", info.Options)
+			}
+			if tt.dataMigrate.Spec.From.ExternalStorage != nil &&
+				(len(info.EncryptOptions) != 1 || info.EncryptOptions[0].Name != "RCLONE_S3_ACCESS_KEY_ID") {
+				t.Errorf("GenDataMigrateValue() encrypt options = %v", info.EncryptOptions)
+			}
+
+			var claims []string
+			for _, volume := range info.NativeVolumes {
+				claims = append(claims, volume.PersistentVolumeClaim.ClaimName)
+			}
+			if len(claims) != len(tt.wantClaims) || len(info.NativeVolumeMounts) != len(tt.wantClaims) {
+				t.Fatalf("GenDataMigrateValue() volumes = %v, want the claims %v", info.NativeVolumes, tt.wantClaims)
+			}
+			for i := range claims {
+				if claims[i] != tt.wantClaims[i] {
+					t.Errorf("GenDataMigrateValue() claims = %v, want %v", claims, tt.wantClaims)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamigrate
+
+import (
+	"strconv"
+	"strings"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/ufschange"
+)
+
+// MaxMismatchedPaths is the max number of the mismatched paths kept in the status infos of DataMigrate
+const MaxMismatchedPaths = 100
+
+// Report is the result of the migration reported by the DataMigrate pods
+type Report struct {
+	Copied  int64
+	Skipped int64
+	Failed  int64
+
+	// Checked and Mismatched are the numbers of the files compared in Verify mode
+	Checked    int64
+	Mismatched int64
+	// MismatchedPaths are the paths missing in the destination or different in size or checksum
+	MismatchedPaths []string
+}
+
+// ParseReport parses the report from the termination message of the DataMigrate container. The message has one
+// key=value per line, e.g.
+//
+//	copied=10
+//	skipped=2
+//	failed=0
+//
+// and in Verify mode, the mismatched paths are reported line by line, e.g.
+//
+//	checked=12
+//	mismatched=1
+//	mismatch=path/to/file
+//
+// The lines not in key=value format are ignored, so that the report is tolerant of the truncated message.
+func ParseReport(message string) (report Report) {
+	for _, line := range strings.Split(message, "\n") {
+		key, value, found := strings.Cut(strings.TrimSpace(line), "=")
+		if !found {
+			continue
+		}
+
+		if key == "mismatch" {
+			report.MismatchedPaths = append(report.MismatchedPaths, value)
+			continue
+		}
+
+		count, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+		switch key {
+		case "copied":
+			report.Copied = count
+		case "skipped":
+			report.Skipped = count
+		case "failed":
+			report.Failed = count
+		case "checked":
+			report.Checked = count
+		case "mismatched":
+			report.Mismatched = count
+		}
+	}
+	return
+}
+
+// Merge adds up the report of another DataMigrate pod, e.g. the one migrating another shard.
+func (r *Report) Merge(other Report) {
+	r.Copied += other.Copied
+	r.Skipped += other.Skipped
+	r.Failed += other.Failed
+	r.Checked += other.Checked
+	r.Mismatched += other.Mismatched
+	r.MismatchedPaths = append(r.MismatchedPaths, other.MismatchedPaths...)
+}
+
+// SetInfos stores the report in the status infos of DataMigrate according to the migrate mode, at most
+// MaxMismatchedPaths mismatched paths are kept.
+func (r Report) SetInfos(mode datav1alpha1.MigrateMode, infos map[string]string) {
+	if mode == datav1alpha1.VerifyMigrateMode {
+		infos[FilesCheckedInfoKey] = strconv.FormatInt(r.Checked, 10)
+		infos[FilesMismatchedInfoKey] = strconv.FormatInt(r.Mismatched, 10)
+		paths := r.MismatchedPaths
+		if len(paths) > MaxMismatchedPaths {
+			paths = paths[:MaxMismatchedPaths]
+		}
+		ufschange.SetPathsInfo(infos, MismatchedPathsInfoKey, paths)
+		return
+	}
+
+	infos[FilesCopiedInfoKey] = strconv.FormatInt(r.Copied, 10)
+	infos[FilesSkippedInfoKey] = strconv.FormatInt(r.Skipped, 10)
+	infos[FilesFailedInfoKey] = strconv.FormatInt(r.Failed, 10)
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamigrate
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/ufschange"
+)
+
+func TestParseReport(t *testing.T) {
+	testcases := []struct {
+		name     string
+		message  string
+		expected Report
+	}{
+		{
+			name:     "copy",
+			message:  "copied=10\nskipped=2\nfailed=1\n",
+			expected: Report{Copied: 10, Skipped: 2, Failed: 1},
+		},
+		{
+			name:     "verify",
+			message:  "checked=3\nmismatched=2\nmismatch=a/b.csv\nmismatch=c=d.csv\n",
+			expected: Report{Checked: 3, Mismatched: 2, MismatchedPaths: []string{"a/b.csv", "c=d.csv"}},
+		},
+		{
+			name:     "malformed",
+			message:  "juicefs sync failed\ncopied=abc\nskipped=2\nmismatch",
+			expected: Report{Skipped: 2},
+		},
+		{
+			name: "empty",
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			if got := ParseReport(testcase.message); !reflect.DeepEqual(got, testcase.expected) {
+				t.Errorf("ParseReport() = %v, want %v", got, testcase.expected)
+			}
+		})
+	}
+}
+
+func TestReportSetInfos(t *testing.T) {
+	report := Report{Copied: 1, Skipped: 2}
+	report.Merge(Report{Copied: 3, Failed: 1, Checked: 4, Mismatched: 1, MismatchedPaths: []string{"a"}})
+
+	infos := map[string]string{}
+	report.SetInfos(datav1alpha1.IncrementalMigrateMode, infos)
+	expected := map[string]string{FilesCopiedInfoKey: "4", FilesSkippedInfoKey: "2", FilesFailedInfoKey: "1"}
+	if !reflect.DeepEqual(infos, expected) {
+		t.Errorf("SetInfos() = %v, want %v", infos, expected)
+	}
+
+	for i := 0; i < MaxMismatchedPaths; i++ {
+		report.MismatchedPaths = append(report.MismatchedPaths, fmt.Sprintf("path-%d", i))
+	}
+	infos = map[string]string{}
+	report.SetInfos(datav1alpha1.VerifyMigrateMode, infos)
+	if infos[FilesCheckedInfoKey] != "4" || infos[FilesMismatchedInfoKey] != "1" {
+		t.Errorf("SetInfos() = %v, want the counts of the verification", infos)
+	}
+	paths := ufschange.GetPathsInfo(infos, MismatchedPathsInfoKey)
+	if len(paths) != MaxMismatchedPaths || paths[0] != "a" {
+		t.Errorf("SetInfos() mismatched paths = %v, want the first %d paths", paths, MaxMismatchedPaths)
+	}
+}
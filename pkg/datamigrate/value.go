@@ -37,6 +37,9 @@ type DataMigrateInfo struct {
 	// Schedule The schedule in Cron format, only set when policy is cron, see https://en.wikipedia.org/wiki/Cron.
 	Schedule string `json:"schedule,omitempty"`
 
+	// Mode for migrate, including Copy, Verify, Incremental
+	Mode string `json:"mode,omitempty"`
+
 	// BackoffLimit specifies the upper limit times when the DataMigrate job fails
 	BackoffLimit int32 `json:"backoffLimit,omitempty"`
 
@@ -101,4 +104,20 @@ type ParallelOptions struct {
 	SSHReadyTimeoutSeconds int `json:"readyTimeoutSeconds,omitempty"`
 
 	SSHSecretName string `json:"sshSecretName,omitempty"`
+
+	// Mode is ssh or sharded, defaults to ssh
+	Mode string `json:"mode,omitempty"`
+
+	// CoordinatorImage is the image with kubectl to publish and fetch the shards in sharded mode
+	CoordinatorImage string `json:"coordinatorImage,omitempty"`
+
+	ShardsReadyTimeoutSeconds int `json:"shardsReadyTimeoutSeconds,omitempty"`
+}
+
+// GetParallelMode returns the parallel mode in the parallel options, defaults to ParallelModeSSH
+func GetParallelMode(parallelOptions map[string]string) string {
+	if mode := parallelOptions[ParallelMode]; len(mode) > 0 {
+		return mode
+	}
+	return ParallelModeSSH
 }
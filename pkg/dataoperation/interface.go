@@ -79,3 +79,10 @@ type StatusHandler interface {
 	// GetOperationStatus get operation status according to helm chart status
 	GetOperationStatus(ctx runtime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus) (result *datav1alpha1.OperationStatus, err error)
 }
+
+// UFSChangeWatcher is optionally implemented by the data operations re-triggered by the changes of the under file system.
+type UFSChangeWatcher interface {
+	// GetWatchedPaths returns the paths whose changes re-trigger the data operation, it returns empty if the data
+	// operation is not triggered by events.
+	GetWatchedPaths() []string
+}
@@ -23,4 +23,5 @@ const (
 	DataBackupType  OperationType = "DataBackup"
 	DataMigrateType OperationType = "DataMigrate"
 	DataProcessType OperationType = "DataProcess"
+	DataFreeType    OperationType = "DataFree"
 )
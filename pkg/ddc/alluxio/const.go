@@ -60,3 +60,15 @@ const (
 	MountConfigStorage   = "ALLUXIO_MOUNT_CONFIG_STORAGE"
 	ConfigmapStorageName = "configmap"
 )
+
+const (
+	masterContainerName = "alluxio-master"
+	workerContainerName = "alluxio-worker"
+	fuseContainerName   = "alluxio-fuse"
+
+	// the keys of the java options in the config configmap rendered by the helm chart
+	javaOptsKey       = "ALLUXIO_JAVA_OPTS"
+	masterJavaOptsKey = "ALLUXIO_MASTER_JAVA_OPTS"
+	workerJavaOptsKey = "ALLUXIO_WORKER_JAVA_OPTS"
+	fuseJavaOptsKey   = "ALLUXIO_FUSE_JAVA_OPTS"
+)
@@ -0,0 +1,90 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdatafree "github.com/fluid-cloudnative/fluid/pkg/datafree"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestGenerateDataFreeValueFile(t *testing.T) {
+	dataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-dataset", Namespace: "fluid"},
+	}
+	client := fake.NewFakeClientWithScheme(testScheme, dataset)
+	engine := AlluxioEngine{name: "test-dataset", namespace: "fluid", Client: client, Log: fake.NullLogger()}
+	ctx := cruntime.ReconcileRequestContext{Client: client}
+
+	testCases := []struct {
+		name      string
+		paths     []string
+		wantPaths []string
+	}{
+		{
+			name:      "free the whole dataset",
+			wantPaths: []string{"/"},
+		},
+		{
+			name:      "free the given paths",
+			paths:     []string{"/a", "/b"},
+			wantPaths: []string{"/a", "/b"},
+		},
+	}
+
+	for _, tc := range testCases {
+		dataFree := &datav1alpha1.DataFree{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-datafree", Namespace: "fluid"},
+			Spec: datav1alpha1.DataFreeSpec{
+				Dataset: datav1alpha1.TargetDataset{Name: "test-dataset", Namespace: "fluid"},
+				Paths:   tc.paths,
+			},
+		}
+
+		valueFileName, err := engine.generateDataFreeValueFile(ctx, dataFree)
+		if err != nil {
+			t.Fatalf("testcase %s: unexpected error %v", tc.name, err)
+		}
+		data, err := os.ReadFile(valueFileName)
+		if err != nil {
+			t.Fatalf("testcase %s: failed to read value file: %v", tc.name, err)
+		}
+		var value cdatafree.DataFreeValue
+		if err = yaml.Unmarshal(data, &value); err != nil {
+			t.Fatalf("testcase %s: failed to unmarshal value file: %v", tc.name, err)
+		}
+		if !reflect.DeepEqual(value.DataFreeInfo.TargetPaths, tc.wantPaths) {
+			t.Errorf("testcase %s: expect target paths %v, got %v", tc.name, tc.wantPaths, value.DataFreeInfo.TargetPaths)
+		}
+		if value.DataFreeInfo.Image != common.DefaultAlluxioRuntimeImage {
+			t.Errorf("testcase %s: expect image %s, got %s", tc.name, common.DefaultAlluxioRuntimeImage, value.DataFreeInfo.Image)
+		}
+	}
+
+	if _, err := engine.generateDataFreeValueFile(ctx, &datav1alpha1.DataLoad{}); err == nil {
+		t.Errorf("expect error when the object is not a DataFree")
+	}
+}
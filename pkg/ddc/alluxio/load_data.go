@@ -152,7 +152,7 @@ func (e *AlluxioEngine) genDataLoadValue(image string, targetDataset *datav1alph
 	}
 
 	targetPaths := []cdataload.TargetPath{}
-	for _, target := range dataload.Spec.Target {
+	for _, target := range cdataload.GetTargetsToLoad(dataload) {
 		fluidNative := utils.IsTargetPathUnderFluidNativeMounts(target.Path, *targetDataset)
 		targetPaths = append(targetPaths, cdataload.TargetPath{
 			Path:        target.Path,
@@ -187,3 +187,40 @@ func (e *AlluxioEngine) CheckRuntimeReady() (ready bool) {
 	}
 	return true
 }
+
+// getDataLoadProgress regards the cached bytes of a target path as the loaded bytes. Both du and count are costly for
+// large directories, so the du result is cached for a while and the total files under the path are only counted once.
+func (e *AlluxioEngine) getDataLoadProgress(object client.Object, previous []datav1alpha1.TargetPathProgress) (progress []datav1alpha1.TargetPathProgress, err error) {
+	dataLoad, ok := object.(*datav1alpha1.DataLoad)
+	if !ok {
+		return nil, fmt.Errorf("object %v is not a DataLoad", object)
+	}
+
+	podName, containerName := e.getMasterPodInfo()
+	fileUtils := operations.NewAlluxioFileUtils(podName, containerName, e.namespace, e.Log)
+	for _, path := range cdataload.GetTargetPaths(dataLoad) {
+		pathProgress := datav1alpha1.TargetPathProgress{Path: path}
+		if last := cdataload.FindProgress(previous, path); last != nil {
+			pathProgress.TotalFiles = last.TotalFiles
+		}
+
+		pathProgress.TotalBytes, pathProgress.LoadedBytes, err = cdataload.GetDuWithCache(e.namespace, e.name, path, func(path string) (int64, int64, error) {
+			total, cached, _, err := fileUtils.Du(path)
+			return total, cached, err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if pathProgress.TotalFiles == 0 {
+			pathProgress.TotalFiles, _, _, err = fileUtils.Count(path)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		progress = append(progress, pathProgress)
+	}
+
+	return progress, nil
+}
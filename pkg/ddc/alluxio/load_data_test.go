@@ -515,3 +515,51 @@ func TestCheckRuntimeReady(t *testing.T) {
 	}
 	patch2.Reset()
 }
+
+func TestGetDataLoadProgress(t *testing.T) {
+	engine := AlluxioEngine{
+		namespace: "fluid",
+		name:      "hbase",
+		Log:       fake.NullLogger(),
+	}
+	dataLoad := &datav1alpha1.DataLoad{
+		Spec: datav1alpha1.DataLoadSpec{
+			Target: []datav1alpha1.TargetPath{{Path: "/a"}, {Path: "/b"}},
+		},
+	}
+
+	countCalled, duCalled := 0, 0
+	patches := ApplyMethodFunc(operations.AlluxioFileUtils{}, "Du", func(alluxioPath string) (int64, int64, string, error) {
+		duCalled++
+		return 4096, 1024, "25%", nil
+	})
+	defer patches.Reset()
+	patches.ApplyMethodFunc(operations.AlluxioFileUtils{}, "Count", func(alluxioPath string) (int64, int64, int64, error) {
+		countCalled++
+		return 4, 1, 4096, nil
+	})
+
+	previous := []datav1alpha1.TargetPathProgress{{Path: "/a", TotalFiles: 8}}
+	progress, err := engine.getDataLoadProgress(dataLoad, previous)
+	if err != nil {
+		t.Fatalf("fail to get dataload progress: %v", err)
+	}
+
+	want := []datav1alpha1.TargetPathProgress{
+		{Path: "/a", LoadedBytes: 1024, TotalBytes: 4096, TotalFiles: 8},
+		{Path: "/b", LoadedBytes: 1024, TotalBytes: 4096, TotalFiles: 4},
+	}
+	if !reflect.DeepEqual(progress, want) {
+		t.Errorf("expect progress %v, got %v", want, progress)
+	}
+	if countCalled != 1 {
+		t.Errorf("expect files to be counted once for the path without previous progress, got %d", countCalled)
+	}
+
+	if _, err = engine.getDataLoadProgress(dataLoad, progress); err != nil {
+		t.Fatalf("fail to get dataload progress: %v", err)
+	}
+	if duCalled != 2 {
+		t.Errorf("expect du of each path to be cached, got %d calls", duCalled)
+	}
+}
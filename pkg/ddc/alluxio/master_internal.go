@@ -30,16 +30,7 @@ import (
 
 // setup the cache master
 func (e *AlluxioEngine) setupMasterInternal() (err error) {
-	var (
-		chartName = utils.GetChartsDirectory() + "/" + common.AlluxioChart
-	)
-
-	runtime, err := e.getRuntime()
-	if err != nil {
-		return
-	}
-
-	valueFileName, err := e.generateAlluxioValueFile(runtime)
+	valueFileName, chartName, err := e.GenerateRuntimeValueFile()
 	if err != nil {
 		return
 	}
@@ -57,6 +48,19 @@ func (e *AlluxioEngine) setupMasterInternal() (err error) {
 	return helm.InstallRelease(e.name, e.namespace, valueFileName, chartName)
 }
 
+// GenerateRuntimeValueFile transforms the runtime into the value file of the runtime chart
+func (e *AlluxioEngine) GenerateRuntimeValueFile() (valueFileName string, chartName string, err error) {
+	chartName = utils.GetChartsDirectory() + "/" + common.AlluxioChart
+
+	runtime, err := e.getRuntime()
+	if err != nil {
+		return
+	}
+
+	valueFileName, err = e.generateAlluxioValueFile(runtime)
+	return
+}
+
 // generate alluxio struct
 func (e *AlluxioEngine) generateAlluxioValueFile(runtime *datav1alpha1.AlluxioRuntime) (valueFileName string, err error) {
 
@@ -113,6 +117,10 @@ func (e *AlluxioEngine) getHelmValuesConfigMapName() string {
 	return e.name + "-" + e.engineImpl + "-values"
 }
 
+func (e *AlluxioEngine) getConfigmapName() string {
+	return e.name + "-config"
+}
+
 func (e *AlluxioEngine) getMountConfigmapName() string {
 	return e.name + "-mount-config"
 }
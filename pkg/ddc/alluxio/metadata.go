@@ -24,6 +24,7 @@ import (
 	"strconv"
 	"time"
 
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/alluxio/operations"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
@@ -88,14 +89,16 @@ func (e *AlluxioEngine) shouldSyncMetadata() (should bool, err error) {
 		return should, nil
 	}
 
-	//todo: periodical metadata sync
-	if dataset.Status.UfsTotal != "" && dataset.Status.UfsTotal != metadataSyncNotDoneMsg {
+	if base.IsScheduledMetadataSync(dataset, metadataSyncNotDoneMsg) {
 		e.Log.V(1).Info("dataset ufs is ready",
 			"dataset name", dataset.Name,
 			"dataset namespace", dataset.Namespace,
 			"ufstotal", dataset.Status.UfsTotal)
-		should = false
-		return should, nil
+		// keep waiting for the result of the scheduled metadata sync in progress
+		if e.MetadataSyncDoneCh != nil {
+			return true, nil
+		}
+		return base.ShouldScheduleMetadataSync(runtime.Spec.RuntimeManagement.MetadataSyncPolicy, dataset)
 	}
 	should = true
 	return should, nil
@@ -107,7 +110,8 @@ func (e *AlluxioEngine) shouldRestoreMetadata() (should bool, err error) {
 	if err != nil {
 		return
 	}
-	if dataset.Spec.DataRestoreLocation != nil {
+	// the metadata is only restored when setting up the runtime
+	if dataset.Spec.DataRestoreLocation != nil && !base.IsScheduledMetadataSync(dataset, metadataSyncNotDoneMsg) {
 		e.Log.V(1).Info("restore metadata of dataset from backup",
 			"dataset name", dataset.Name,
 			"dataset namespace", dataset.Namespace,
@@ -213,6 +217,7 @@ func (e *AlluxioEngine) syncMetadataInternal() (err error) {
 					datasetToUpdate := dataset.DeepCopy()
 					datasetToUpdate.Status.UfsTotal = result.UfsTotal
 					datasetToUpdate.Status.FileNum = result.FileNum
+					base.SetMetadataSyncedCondition(datasetToUpdate, result)
 
 					if !reflect.DeepEqual(datasetToUpdate, dataset) {
 						err = e.Client.Status().Update(context.TODO(), datasetToUpdate)
@@ -230,6 +235,7 @@ func (e *AlluxioEngine) syncMetadataInternal() (err error) {
 				}
 			} else {
 				e.Log.Error(result.Err, "Metadata sync failed")
+				base.RecordMetadataSyncFailure(e.Client, result, e.namespace, e.name, e.Log)
 				return result.Err
 			}
 		case <-time.After(checkMetadataSyncDoneTimeoutMillisec * time.Millisecond):
@@ -242,6 +248,10 @@ func (e *AlluxioEngine) syncMetadataInternal() (err error) {
 			if err != nil {
 				return
 			}
+			// keep the ufs total and file num of the dataset during the scheduled metadata sync
+			if base.IsScheduledMetadataSync(dataset, metadataSyncNotDoneMsg) {
+				return
+			}
 			datasetToUpdate := dataset.DeepCopy()
 			datasetToUpdate.Status.UfsTotal = metadataSyncNotDoneMsg
 			datasetToUpdate.Status.FileNum = metadataSyncNotDoneMsg
@@ -297,7 +307,7 @@ func (e *AlluxioEngine) syncMetadataInternal() (err error) {
 				}
 			}
 			// load metadata
-			err = fileUtils.LoadMetadataWithoutTimeout("/")
+			err = e.loadMetadataOfDataset(fileUtils, dataset)
 			if err != nil {
 				e.Log.Error(err, "LoadMetadata failed when syncing metadata", "name", e.name, "namespace", e.namespace)
 				result.Err = err
@@ -336,3 +346,29 @@ func (e *AlluxioEngine) syncMetadataInternal() (err error) {
 	}
 	return
 }
+
+// loadMetadataOfDataset loads the metadata of the whole dataset when setting up the runtime, and loads the metadata
+// under the paths of the metadata sync policy in the given mode on schedule.
+func (e *AlluxioEngine) loadMetadataOfDataset(fileUtils operations.AlluxioFileUtils, dataset *datav1alpha1.Dataset) (err error) {
+	if !base.IsScheduledMetadataSync(dataset, metadataSyncNotDoneMsg) {
+		return fileUtils.LoadMetadataWithoutTimeout("/")
+	}
+
+	runtime, err := e.getRuntime()
+	if err != nil {
+		return err
+	}
+	policy := runtime.Spec.RuntimeManagement.MetadataSyncPolicy
+	for _, path := range policy.ScheduledSyncPaths() {
+		e.Log.Info("Scheduled metadata sync", "path", path, "incremental", policy.IncrementalSyncEnabled())
+		if policy.IncrementalSyncEnabled() {
+			err = fileUtils.LoadMetadataWithoutTimeout(path)
+		} else {
+			err = fileUtils.ReloadMetadataWithoutTimeout(path)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
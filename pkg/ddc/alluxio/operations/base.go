@@ -39,6 +39,9 @@ type AlluxioFileUtils struct {
 	log       logr.Logger
 }
 
+// minReplicationPattern matches the minimum replication in the file info printed by `fs stat`, e.g. replicationMin=0
+var minReplicationPattern = regexp.MustCompile(`replicationMin=(-?\d+)`)
+
 func NewAlluxioFileUtils(podName string, containerName string, namespace string, log logr.Logger) AlluxioFileUtils {
 
 	return AlluxioFileUtils{
@@ -118,6 +121,103 @@ func (a AlluxioFileUtils) ReportMetrics() (metrics string, err error) {
 	return stdout, err
 }
 
+// SetMinReplication sets the minimum number of the block replicas of the files under the path recursively
+// by running `alluxio fs setReplication -R --min <replicas> <path>` command
+func (a AlluxioFileUtils) SetMinReplication(path string, replicas int32) (err error) {
+	var (
+		command = []string{"alluxio", "fs", "setReplication", "-R", "--min", strconv.Itoa(int(replicas)), path}
+		stdout  string
+		stderr  string
+	)
+	stdout, stderr, err = a.exec(command, false)
+	if err != nil {
+		a.log.Error(err, "AlluxioFileUtils.SetMinReplication() failed", "stdout", stdout, "stderr", stderr)
+		return
+	}
+	return
+}
+
+// ListCachedFiles lists the files under the path recursively which are cached partially or fully
+// by running `alluxio fs ls -R <path>` command
+func (a AlluxioFileUtils) ListCachedFiles(path string) (files []string, err error) {
+	var (
+		command = []string{"alluxio", "fs", "ls", "-R", path}
+		stdout  string
+		stderr  string
+	)
+	stdout, stderr, err = a.exec(command, false)
+	if err != nil {
+		a.log.Error(err, "AlluxioFileUtils.ListCachedFiles() failed", "stdout", stdout, "stderr", stderr)
+		return
+	}
+
+	// e.g. -rw-r--r--  root  root  6  PERSISTED 06-17-2024 10:00:00:000  50% /a/data.csv
+	for _, line := range strings.Split(stdout, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "d") {
+			continue
+		}
+		for i, field := range fields {
+			if strings.HasSuffix(field, "%") {
+				if field != "0%" && i+1 < len(fields) {
+					files = append(files, strings.Join(fields[i+1:], " "))
+				}
+				break
+			}
+		}
+	}
+	return
+}
+
+// GetFileLocations returns the workers holding the blocks of the file by running `alluxio fs location <path>` command
+func (a AlluxioFileUtils) GetFileLocations(path string) (locations []string, err error) {
+	var (
+		command = []string{"alluxio", "fs", "location", path}
+		stdout  string
+		stderr  string
+	)
+	stdout, stderr, err = a.exec(command, false)
+	if err != nil {
+		a.log.Error(err, "AlluxioFileUtils.GetFileLocations() failed", "stdout", stdout, "stderr", stderr)
+		return
+	}
+
+	// e.g. /a/data.csv with file id 33554431 is on nodes:
+	//      192.168.0.1
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	for _, line := range lines[1:] {
+		if location := strings.TrimSpace(line); len(location) > 0 {
+			locations = append(locations, location)
+		}
+	}
+	return
+}
+
+// GetMinReplication returns the minimum number of the block replicas of the file by running `alluxio fs stat <path>` command
+func (a AlluxioFileUtils) GetMinReplication(path string) (replicas int32, err error) {
+	var (
+		command = []string{"alluxio", "fs", "stat", path}
+		stdout  string
+		stderr  string
+	)
+	stdout, stderr, err = a.exec(command, false)
+	if err != nil {
+		a.log.Error(err, "AlluxioFileUtils.GetMinReplication() failed", "stdout", stdout, "stderr", stderr)
+		return
+	}
+
+	matches := minReplicationPattern.FindStringSubmatch(stdout)
+	if len(matches) != 2 {
+		err = fmt.Errorf("failed to find the minimum replication of %s in %s", path, stdout)
+		return
+	}
+	value, err := strconv.ParseInt(matches[1], 10, 32)
+	if err != nil {
+		return
+	}
+	return int32(value), nil
+}
+
 // ReportCapacity get alluxio capacity info by running `alluxio fsadmin report capacity` command
 func (a AlluxioFileUtils) ReportCapacity() (report string, err error) {
 	var (
@@ -135,10 +235,19 @@ func (a AlluxioFileUtils) ReportCapacity() (report string, err error) {
 
 // Load the metadata without timeout
 func (a AlluxioFileUtils) LoadMetadataWithoutTimeout(alluxioPath string) (err error) {
+	return a.loadMetadataWithoutTimeout([]string{"alluxio", "fs", "loadMetadata", "-R", alluxioPath})
+}
+
+// ReloadMetadataWithoutTimeout reloads the metadata under the path without timeout, the metadata
+// of the files already loaded is updated forcibly
+func (a AlluxioFileUtils) ReloadMetadataWithoutTimeout(alluxioPath string) (err error) {
+	return a.loadMetadataWithoutTimeout([]string{"alluxio", "fs", "loadMetadata", "-R", "-F", alluxioPath})
+}
+
+func (a AlluxioFileUtils) loadMetadataWithoutTimeout(command []string) (err error) {
 	var (
-		command = []string{"alluxio", "fs", "loadMetadata", "-R", alluxioPath}
-		stdout  string
-		stderr  string
+		stdout string
+		stderr string
 	)
 
 	start := time.Now()
@@ -467,6 +576,35 @@ func (a AlluxioFileUtils) LoadMetaData(alluxioPath string, sync bool) (err error
 	return
 }
 
+// ListRecursively lists the files under the path recursively with the metadata synced from the under file system.
+// The percentages of the files cached in Alluxio are dropped so that the listing only changes with the under file system.
+func (a AlluxioFileUtils) ListRecursively(alluxioPath string) (listing string, err error) {
+	var (
+		command = []string{"alluxio", "fs", "-Dalluxio.user.file.metadata.sync.interval=0", "ls", "-R", alluxioPath}
+		stdout  string
+		stderr  string
+	)
+
+	stdout, stderr, err = a.exec(command, false)
+	if err != nil {
+		a.log.Error(err, "AlluxioFileUtils.ListRecursively() failed", "stdout", stdout, "stderr", stderr)
+		return
+	}
+
+	lines := strings.Split(stdout, "\n")
+	for i, line := range lines {
+		fields := strings.Fields(line)
+		kept := make([]string, 0, len(fields))
+		for _, field := range fields {
+			if !strings.HasSuffix(field, "%") {
+				kept = append(kept, field)
+			}
+		}
+		lines[i] = strings.Join(kept, " ")
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
 func (a AlluxioFileUtils) Mkdir(alluxioPath string) (err error) {
 	var (
 		command = []string{"alluxio", "fs", "mkdir", alluxioPath}
@@ -665,3 +665,61 @@ func TestAlluxioFileUtils_ExecMountScripts(t *testing.T) {
 	}
 	patch2.Reset()
 }
+
+func TestAlluxioFileUtils_ListRecursively(t *testing.T) {
+	mockExec := func(ctx context.Context, p1, p2, p3 string, p4 []string) (stdout string, stderr string, e error) {
+		if strings.Contains(p4[5], EXEC_ERR) {
+			return "does not exist", "", errors.New("exec-error")
+		}
+		return "-rw-r--r--  root  root  6  PERSISTED 06-17-2024 10:00:00:000  50% /a/data.csv", "", nil
+	}
+
+	patches := gomonkey.ApplyFunc(kubeclient.ExecCommandInContainerWithFullOutput, mockExec)
+	defer patches.Reset()
+
+	if _, err := (AlluxioFileUtils{log: fake.NullLogger()}).ListRecursively(EXEC_ERR); err == nil {
+		t.Errorf("expect error when exec fails")
+	}
+
+	listing, err := AlluxioFileUtils{log: fake.NullLogger()}.ListRecursively("/a")
+	if err != nil {
+		t.Fatalf("fail to list recursively: %v", err)
+	}
+	if expected := "-rw-r--r-- root root 6 PERSISTED 06-17-2024 10:00:00:000 /a/data.csv"; listing != expected {
+		t.Errorf("expect listing %q, got %q", expected, listing)
+	}
+}
+
+func TestAlluxioFileUtils_WorkerCacheReplication(t *testing.T) {
+	mockExec := func(a AlluxioFileUtils, command []string, verbose bool) (stdout string, stderr string, err error) {
+		switch command[2] {
+		case "ls":
+			return `drwxr-xr-x  root  root  2  PERSISTED 06-17-2024 10:00:00:000  DIR /a
+-rw-r--r--  root  root  6  PERSISTED 06-17-2024 10:00:00:000  50% /a/data.csv
+-rw-r--r--  root  root  6  PERSISTED 06-17-2024 10:00:00:000  0% /a/uncached.csv`, "", nil
+		case "location":
+			return "/a/data.csv with file id 33554431 is on nodes: \n192.168.0.1\n192.168.0.2\n", "", nil
+		case "stat":
+			return "/a/data.csv is a file path.\nFileInfo{fileId=33554431, replicationMax=-1, replicationMin=1}", "", nil
+		}
+		return "", "", errors.New("unexpected command")
+	}
+	patches := gomonkey.ApplyPrivateMethod(AlluxioFileUtils{}, "exec", mockExec)
+	defer patches.Reset()
+
+	a := AlluxioFileUtils{log: fake.NullLogger()}
+	files, err := a.ListCachedFiles("/")
+	if err != nil || !reflect.DeepEqual(files, []string{"/a/data.csv"}) {
+		t.Errorf("expect cached files [/a/data.csv], got %v, err %v", files, err)
+	}
+
+	locations, err := a.GetFileLocations("/a/data.csv")
+	if err != nil || !reflect.DeepEqual(locations, []string{"192.168.0.1", "192.168.0.2"}) {
+		t.Errorf("expect locations [192.168.0.1 192.168.0.2], got %v, err %v", locations, err)
+	}
+
+	replicas, err := a.GetMinReplication("/a/data.csv")
+	if err != nil || replicas != 1 {
+		t.Errorf("expect minimum replication 1, got %d, err %v", replicas, err)
+	}
+}
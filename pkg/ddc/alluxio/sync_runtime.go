@@ -16,9 +16,538 @@ limitations under the License.
 
 package alluxio
 
-import cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/yaml"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ctrl"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+	runtimeOpts "github.com/fluid-cloudnative/fluid/pkg/utils/runtimes/options"
+)
+
+// componentValue is the part of the values of a component which can be synced to its pod template
+type componentValue struct {
+	// image is empty if the image of the component is not defined by users
+	image        string
+	env          map[string]string
+	nodeSelector map[string]string
+	resources    common.Resources
+}
 
 // SyncRuntime syncs the runtime spec
 func (e *AlluxioEngine) SyncRuntime(ctx cruntime.ReconcileRequestContext) (changed bool, err error) {
+	if runtimeOpts.ShouldSkipSyncingRuntime() {
+		e.Log.V(1).Info("Skipping runtime sync due to CONTROLLER_SKIP_SYNCING_RUNTIME being enabled")
+		return
+	}
+
+	runtime, err := e.getRuntime()
+	if err != nil {
+		return
+	}
+
+	// Syncing the runtime spec in the same way as JuiceFSRuntime:
+	// 1. get old value from configmap, and transform the latest value from the runtime spec with the ports of the old value
+	// 2. sync the properties and the jvm options to the config configmap rendered by the helm chart
+	// 3. sync master, worker and fuse spec given old value, latest value, and the components to restart for the config changes
+	// 4. Commit value changes to complete the process
+	var changedFields []string
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		changedFields = nil
+		oldValue, innerErr := e.getValueFromConfigmap()
+		if innerErr != nil {
+			return innerErr
+		}
+
+		latestValue, innerErr := e.transformLatestValue(runtime, oldValue)
+		if innerErr != nil {
+			return innerErr
+		}
+
+		configFields, innerErr := e.syncConfig(oldValue, latestValue)
+		if innerErr != nil {
+			return innerErr
+		}
+		changedFields = append(changedFields, configFields...)
+		globalConfigChanged := utils.ContainsString(configFields, "properties")
+
+		masterFields, innerErr := e.syncMasterSpec(ctx, runtime, oldValue, latestValue,
+			globalConfigChanged || utils.ContainsString(configFields, "master.properties"))
+		if innerErr != nil {
+			return innerErr
+		}
+		changedFields = append(changedFields, masterFields...)
+
+		workerFields, innerErr := e.syncWorkerSpec(ctx, runtime, oldValue, latestValue,
+			globalConfigChanged || utils.ContainsString(configFields, "worker.properties"))
+		if innerErr != nil {
+			return innerErr
+		}
+		changedFields = append(changedFields, workerFields...)
+
+		fuseFields, innerErr := e.syncFuseSpec(ctx, runtime, oldValue, latestValue,
+			globalConfigChanged || utils.ContainsString(configFields, "fuse.properties"))
+		if innerErr != nil {
+			return innerErr
+		}
+		changedFields = append(changedFields, fuseFields...)
+
+		if len(changedFields) > 0 {
+			e.Log.Info("Committing changed value to configmap", "name", ctx.Name, "namespace", ctx.Namespace, "changedFields", changedFields)
+			return e.saveValueToConfigmap(e.commitSyncedValue(oldValue, latestValue))
+		}
+		return nil
+	})
+
+	if err != nil {
+		e.Log.Error(err, "Failed to sync runtime")
+		return false, err
+	}
+
+	changed = len(changedFields) > 0
+	if changed {
+		err = e.setSpecSyncedCondition(changedFields)
+	}
+	return
+}
+
+// transformLatestValue transforms the runtime into the latest value, the ports allocated when setting up the runtime are kept
+func (e *AlluxioEngine) transformLatestValue(runtime *datav1alpha1.AlluxioRuntime, oldValue *Alluxio) (value *Alluxio, err error) {
+	value, err = e.transformWithoutPorts(runtime)
+	if err != nil {
+		return
+	}
+
+	value.Master.Ports = oldValue.Master.Ports
+	value.Worker.Ports = oldValue.Worker.Ports
+	value.JobMaster.Ports = oldValue.JobMaster.Ports
+	value.JobWorker.Ports = oldValue.JobWorker.Ports
+	value.APIGateway.Ports = oldValue.APIGateway.Ports
+	e.setPortProperties(runtime, value)
+	return
+}
+
+// commitSyncedValue returns the old value with the synced fields replaced by the latest ones
+func (e *AlluxioEngine) commitSyncedValue(oldValue, latestValue *Alluxio) *Alluxio {
+	value := oldValue
+	value.Image, value.ImageTag = latestValue.Image, latestValue.ImageTag
+	value.Properties, value.JvmOptions = latestValue.Properties, latestValue.JvmOptions
+
+	value.Master.Properties, value.Master.JvmOptions = latestValue.Master.Properties, latestValue.Master.JvmOptions
+	value.Master.Env, value.Master.NodeSelector, value.Master.Resources = latestValue.Master.Env, latestValue.Master.NodeSelector, latestValue.Master.Resources
+
+	value.Worker.Properties, value.Worker.JvmOptions = latestValue.Worker.Properties, latestValue.Worker.JvmOptions
+	value.Worker.Env, value.Worker.NodeSelector, value.Worker.Resources = latestValue.Worker.Env, latestValue.Worker.NodeSelector, latestValue.Worker.Resources
+
+	value.Fuse.Image, value.Fuse.ImageTag = latestValue.Fuse.Image, latestValue.Fuse.ImageTag
+	value.Fuse.Properties, value.Fuse.JvmOptions = latestValue.Fuse.Properties, latestValue.Fuse.JvmOptions
+	value.Fuse.Env, value.Fuse.NodeSelector, value.Fuse.Resources = latestValue.Fuse.Env, latestValue.Fuse.NodeSelector, latestValue.Fuse.Resources
+	return value
+}
+
+// syncConfig syncs the properties and the jvm options to the java options in the config configmap
+func (e *AlluxioEngine) syncConfig(oldValue, latestValue *Alluxio) (changedFields []string, err error) {
+	javaOpts := []struct {
+		field               string
+		key                 string
+		oldOpts, latestOpts []string
+	}{
+		{
+			field:      "properties",
+			key:        javaOptsKey,
+			oldOpts:    utils.TransformPropertiesToJavaOpts(oldValue.Properties, oldValue.JvmOptions),
+			latestOpts: utils.TransformPropertiesToJavaOpts(latestValue.Properties, latestValue.JvmOptions),
+		},
+		{
+			field:      "master.properties",
+			key:        masterJavaOptsKey,
+			oldOpts:    utils.TransformPropertiesToJavaOpts(oldValue.Master.Properties, oldValue.Master.JvmOptions),
+			latestOpts: utils.TransformPropertiesToJavaOpts(latestValue.Master.Properties, latestValue.Master.JvmOptions),
+		},
+		{
+			field:      "worker.properties",
+			key:        workerJavaOptsKey,
+			oldOpts:    utils.TransformPropertiesToJavaOpts(oldValue.Worker.Properties, oldValue.Worker.JvmOptions),
+			latestOpts: utils.TransformPropertiesToJavaOpts(latestValue.Worker.Properties, latestValue.Worker.JvmOptions),
+		},
+		{
+			field:      "fuse.properties",
+			key:        fuseJavaOptsKey,
+			oldOpts:    utils.TransformPropertiesToJavaOpts(oldValue.Fuse.Properties, oldValue.Fuse.JvmOptions),
+			latestOpts: utils.TransformPropertiesToJavaOpts(latestValue.Fuse.Properties, latestValue.Fuse.JvmOptions),
+		},
+	}
+
+	config, err := kubeclient.GetConfigmapByName(e.Client, e.getConfigmapName(), e.namespace)
+	if err != nil {
+		return
+	}
+	if config == nil {
+		e.Log.Info("syncConfig: the config configmap is not found, skip syncing properties", "configmap", e.getConfigmapName())
+		return
+	}
+
+	configToUpdate := config.DeepCopy()
+	for _, opts := range javaOpts {
+		if reflect.DeepEqual(opts.oldOpts, opts.latestOpts) {
+			continue
+		}
+		e.Log.Info("syncConfig: java options changed", "field", opts.field, "old", opts.oldOpts, "new", opts.latestOpts)
+		configToUpdate.Data[opts.key] = utils.UpdateJavaOpts(configToUpdate.Data[opts.key], opts.oldOpts, opts.latestOpts)
+		changedFields = append(changedFields, opts.field)
+	}
+
+	if !reflect.DeepEqual(config, configToUpdate) {
+		err = kubeclient.UpdateConfigMap(e.Client, configToUpdate)
+	}
+	return
+}
+
+func (e *AlluxioEngine) syncMasterSpec(ctx cruntime.ReconcileRequestContext, runtime *datav1alpha1.AlluxioRuntime, oldValue, latestValue *Alluxio, restart bool) (changedFields []string, err error) {
+	master, err := kubeclient.GetStatefulSet(e.Client, e.getMasterName(), e.namespace)
+	if err != nil {
+		return
+	}
+
+	if master.Spec.UpdateStrategy.Type != appsv1.OnDeleteStatefulSetStrategyType {
+		e.Log.V(1).Info("Master Sts's update strategy is not safe to sync master spec", "updateStrategy", master.Spec.UpdateStrategy.Type)
+		err = kubeclient.UpdateStatefulSetUpdateStrategy(e.Client, master.Name, master.Namespace, appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType})
+		if err != nil {
+			return
+		}
+		e.Log.Info("syncMasterSpec: successfully updated master sts update strategy to OnDelete", "master sts", types.NamespacedName{Namespace: master.Namespace, Name: master.Name})
+		// statefulset update event would trigger a new reconciliation, so it's safe to return here
+		return
+	}
+
+	masterToUpdate := master.DeepCopy()
+	changedFields, err = e.checkAndSetComponentChanges("master", masterContainerName,
+		componentValue{
+			image:        e.getSyncedImage(runtime.Spec.AlluxioVersion.Image, runtime.Spec.AlluxioVersion.ImageTag, oldValue.Image, oldValue.ImageTag),
+			env:          oldValue.Master.Env,
+			nodeSelector: oldValue.Master.NodeSelector,
+			resources:    oldValue.Master.Resources,
+		},
+		componentValue{
+			image:        e.getSyncedImage(runtime.Spec.AlluxioVersion.Image, runtime.Spec.AlluxioVersion.ImageTag, latestValue.Image, latestValue.ImageTag),
+			env:          latestValue.Master.Env,
+			nodeSelector: latestValue.Master.NodeSelector,
+			resources:    latestValue.Master.Resources,
+		},
+		&masterToUpdate.Spec.Template)
+	if err != nil {
+		return
+	}
+	if restart {
+		restartPodTemplate(&masterToUpdate.Spec.Template)
+	}
+
+	if reflect.DeepEqual(master, masterToUpdate) {
+		e.Log.V(1).Info("syncMasterSpec: no differences detected about master", "master sts", types.NamespacedName{Namespace: master.Namespace, Name: master.Name})
+		return
+	}
+
+	e.Log.Info("syncMasterSpec: some fields are changed in master, try to update master sts", "master sts", types.NamespacedName{Namespace: master.Namespace, Name: master.Name}, "changedFields", changedFields)
+	err = e.Client.Update(context.TODO(), masterToUpdate)
+	if err != nil {
+		e.Log.Error(err, "failed to update the master sts spec")
+	}
+	return
+}
+
+func (e *AlluxioEngine) syncWorkerSpec(ctx cruntime.ReconcileRequestContext, runtime *datav1alpha1.AlluxioRuntime, oldValue, latestValue *Alluxio, restart bool) (changedFields []string, err error) {
+	workers, err := ctrl.GetWorkersAsStatefulset(e.Client,
+		types.NamespacedName{Namespace: e.namespace, Name: e.getWorkerName()})
+	if err != nil {
+		return
+	}
+
+	if workers.Spec.UpdateStrategy.Type != appsv1.OnDeleteStatefulSetStrategyType {
+		e.Log.V(1).Info("Worker Sts's update strategy is not safe to sync worker spec", "updateStrategy", workers.Spec.UpdateStrategy.Type)
+		err = kubeclient.UpdateStatefulSetUpdateStrategy(e.Client, workers.Name, workers.Namespace, appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType})
+		if err != nil {
+			return
+		}
+		e.Log.Info("syncWorkerSpec: successfully updated worker sts update strategy to OnDelete", "worker sts", types.NamespacedName{Namespace: workers.Namespace, Name: workers.Name})
+		// statefulset update event would trigger a new reconciliation, so it's safe to return here
+		return
+	}
+
+	workersToUpdate := workers.DeepCopy()
+	changedFields, err = e.checkAndSetComponentChanges("worker", workerContainerName,
+		componentValue{
+			image:        e.getSyncedImage(runtime.Spec.AlluxioVersion.Image, runtime.Spec.AlluxioVersion.ImageTag, oldValue.Image, oldValue.ImageTag),
+			env:          oldValue.Worker.Env,
+			nodeSelector: oldValue.Worker.NodeSelector,
+			resources:    oldValue.Worker.Resources,
+		},
+		componentValue{
+			image:        e.getSyncedImage(runtime.Spec.AlluxioVersion.Image, runtime.Spec.AlluxioVersion.ImageTag, latestValue.Image, latestValue.ImageTag),
+			env:          latestValue.Worker.Env,
+			nodeSelector: latestValue.Worker.NodeSelector,
+			resources:    latestValue.Worker.Resources,
+		},
+		&workersToUpdate.Spec.Template)
+	if err != nil {
+		return
+	}
+	if restart {
+		restartPodTemplate(&workersToUpdate.Spec.Template)
+	}
+
+	if reflect.DeepEqual(workers, workersToUpdate) {
+		e.Log.V(1).Info("syncWorkerSpec: no differences detected about worker", "worker sts", types.NamespacedName{Namespace: workers.Namespace, Name: workers.Name})
+		return
+	}
+
+	e.Log.Info("syncWorkerSpec: some fields are changed in worker, try to update worker sts", "worker sts", types.NamespacedName{Namespace: workers.Namespace, Name: workers.Name}, "changedFields", changedFields)
+	err = e.Client.Update(context.TODO(), workersToUpdate)
+	if err != nil {
+		e.Log.Error(err, "failed to update the worker sts spec")
+	}
+	return
+}
+
+func (e *AlluxioEngine) syncFuseSpec(ctx cruntime.ReconcileRequestContext, runtime *datav1alpha1.AlluxioRuntime, oldValue, latestValue *Alluxio, restart bool) (changedFields []string, err error) {
+	fuses, err := kubeclient.GetDaemonset(e.Client, e.getFuseName(), e.namespace)
+	if err != nil {
+		return
+	}
+
+	if fuses.Spec.UpdateStrategy.Type != appsv1.OnDeleteDaemonSetStrategyType {
+		e.Log.V(1).Info("Fuse Daemonset's update strategy is not safe to sync fuse spec", "updateStrategy", fuses.Spec.UpdateStrategy.Type)
+		err = kubeclient.UpdateDaemonSetUpdateStrategy(e.Client, fuses.Name, fuses.Namespace, appsv1.DaemonSetUpdateStrategy{Type: appsv1.OnDeleteDaemonSetStrategyType})
+		if err != nil {
+			return
+		}
+		e.Log.Info("syncFuseSpec: successfully updated fuse daemonset's update strategy to OnDelete", "fuse ds", types.NamespacedName{Namespace: fuses.Namespace, Name: fuses.Name})
+		// daemonset update event would trigger a new reconciliation, so it's safe to return here
+		return
+	}
+
+	fusesToUpdate := fuses.DeepCopy()
+	changedFields, err = e.checkAndSetComponentChanges("fuse", fuseContainerName,
+		componentValue{
+			image:        e.getSyncedImage(runtime.Spec.Fuse.Image, runtime.Spec.Fuse.ImageTag, oldValue.Fuse.Image, oldValue.Fuse.ImageTag),
+			env:          oldValue.Fuse.Env,
+			nodeSelector: oldValue.Fuse.NodeSelector,
+			resources:    oldValue.Fuse.Resources,
+		},
+		componentValue{
+			image:        e.getSyncedImage(runtime.Spec.Fuse.Image, runtime.Spec.Fuse.ImageTag, latestValue.Fuse.Image, latestValue.Fuse.ImageTag),
+			env:          latestValue.Fuse.Env,
+			nodeSelector: latestValue.Fuse.NodeSelector,
+			resources:    latestValue.Fuse.Resources,
+		},
+		&fusesToUpdate.Spec.Template)
+	if err != nil {
+		return
+	}
+	if restart {
+		restartPodTemplate(&fusesToUpdate.Spec.Template)
+	}
+
+	if reflect.DeepEqual(fuses, fusesToUpdate) {
+		e.Log.V(1).Info("syncFuseSpec: no differences detected about fuse", "fuse ds", types.NamespacedName{Namespace: fuses.Namespace, Name: fuses.Name})
+		return
+	}
+
+	// the fuse pods are not restarted until the application pods using them are gone, the fuse generation tells
+	// the csi plugin to clean up the outdated fuse pods when the OnFuseChanged clean policy is used.
+	if err = e.increaseFuseGeneration(fusesToUpdate); err != nil {
+		e.Log.Error(err, "syncFuseSpec: failed to update the fuse generation on fuse daemonset", "fuse ds", types.NamespacedName{Namespace: fuses.Namespace, Name: fuses.Name})
+		return
+	}
+
+	e.Log.Info("syncFuseSpec: some fields are changed in fuse, try to update fuse daemonset", "fuse ds", types.NamespacedName{Namespace: fuses.Namespace, Name: fuses.Name}, "changedFields", changedFields)
+	err = e.Client.Update(context.TODO(), fusesToUpdate)
+	if err != nil {
+		e.Log.Error(err, "failed to update the fuse ds spec")
+	}
+	return
+}
+
+// checkAndSetComponentChanges sets the changes between the old value and the latest value of the component to its
+// pod template, and returns the changed fields.
+func (e *AlluxioEngine) checkAndSetComponentChanges(component, containerName string, oldValue, latestValue componentValue, template *corev1.PodTemplateSpec) (changedFields []string, err error) {
+	// nodeSelector
+	if !reflect.DeepEqual(oldValue.nodeSelector, latestValue.nodeSelector) && (len(oldValue.nodeSelector) > 0 || len(latestValue.nodeSelector) > 0) {
+		e.Log.Info("node selector changed", "component", component, "old", oldValue.nodeSelector, "new", latestValue.nodeSelector)
+		template.Spec.NodeSelector =
+			utils.UnionMapsWithOverride(utils.GetMapsDifference(template.Spec.NodeSelector, oldValue.nodeSelector), latestValue.nodeSelector)
+		changedFields = append(changedFields, component+".nodeSelector")
+	}
+
+	// image
+	// For image, we assume once image/imageTag is set, it shall not be removed by user.
+	// It's hard for Fluid to detect the removal and find a way to rollout image back to the default image.
+	if len(latestValue.image) > 0 && oldValue.image != latestValue.image {
+		e.Log.Info("image changed", "component", component, "old", oldValue.image, "new", latestValue.image)
+		for i := range template.Spec.Containers {
+			if template.Spec.Containers[i].Image == oldValue.image {
+				template.Spec.Containers[i].Image = latestValue.image
+			}
+		}
+		changedFields = append(changedFields, component+".image")
+	}
+
+	containerIdx := utils.GetContainerIndex(template.Spec.Containers, containerName)
+	if containerIdx < 0 {
+		return
+	}
+	container := &template.Spec.Containers[containerIdx]
+
+	// resources
+	oldResources, err := utils.TransformInternalResourcesToCoreV1Resources(oldValue.resources)
+	if err != nil {
+		return
+	}
+	latestResources, err := utils.TransformInternalResourcesToCoreV1Resources(latestValue.resources)
+	if err != nil {
+		return
+	}
+	if !utils.ResourceRequirementsEqual(oldResources, latestResources) {
+		e.Log.Info("resources changed", "component", component, "old", oldResources, "new", latestResources)
+		container.Resources = latestResources
+		changedFields = append(changedFields, component+".resources")
+	}
+
+	// env
+	if !reflect.DeepEqual(oldValue.env, latestValue.env) && (len(oldValue.env) > 0 || len(latestValue.env) > 0) {
+		e.Log.Info("env variables changed", "component", component, "old", oldValue.env, "new", latestValue.env)
+		container.Env = append(utils.GetEnvsDifference(container.Env, transformEnvs(oldValue.env)), transformEnvs(latestValue.env)...)
+		changedFields = append(changedFields, component+".env")
+	}
 	return
 }
+
+// getSyncedImage returns the image of the value if the image is defined in the runtime spec
+func (e *AlluxioEngine) getSyncedImage(runtimeImage, runtimeImageTag, image, imageTag string) string {
+	if len(runtimeImage) == 0 && len(runtimeImageTag) == 0 {
+		return ""
+	}
+	if len(imageTag) == 0 {
+		return image
+	}
+	return image + ":" + imageTag
+}
+
+func (e *AlluxioEngine) increaseFuseGeneration(fusesToUpdate *appsv1.DaemonSet) error {
+	newGeneration := "1"
+	currentGeneration, exist := fusesToUpdate.Spec.Template.Labels[common.LabelRuntimeFuseGeneration]
+	if exist {
+		currentGenerationInt, err := strconv.Atoi(currentGeneration)
+		if err != nil {
+			e.Log.Error(err, "Failed to parse current fuse generation from the ds label")
+			return nil
+		}
+		newGeneration = strconv.FormatInt(int64(currentGenerationInt+1), 10)
+	}
+
+	if fusesToUpdate.Spec.Template.Labels == nil {
+		fusesToUpdate.Spec.Template.Labels = map[string]string{}
+	}
+	fusesToUpdate.Spec.Template.Labels[common.LabelRuntimeFuseGeneration] = newGeneration
+	pvc, err := kubeclient.GetPersistentVolumeClaim(e.Client, e.name, e.namespace)
+	if err != nil {
+		return err
+	}
+
+	labelsToModify := common.LabelsToModify{}
+	if _, exist := pvc.Labels[common.LabelRuntimeFuseGeneration]; exist {
+		labelsToModify.Update(common.LabelRuntimeFuseGeneration, newGeneration)
+	} else {
+		labelsToModify.Add(common.LabelRuntimeFuseGeneration, newGeneration)
+	}
+
+	if _, err = utils.PatchLabels(e.Client, pvc, labelsToModify); err != nil {
+		e.Log.Error(err, fmt.Sprintf("fuse changed but failed to update fuse generation on pvc %s/%s", e.namespace, e.name))
+	}
+	return nil
+}
+
+// setSpecSyncedCondition records the changed fields synced to the components in the runtime condition
+func (e *AlluxioEngine) setSpecSyncedCondition(changedFields []string) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		runtime, err := e.getRuntime()
+		if err != nil {
+			return err
+		}
+		runtimeToUpdate := runtime.DeepCopy()
+		cond := utils.NewRuntimeCondition(datav1alpha1.RuntimeSpecSynced, datav1alpha1.RuntimeSpecSyncedReason,
+			fmt.Sprintf("The changes of %s are synced to the runtime", strings.Join(changedFields, ", ")), corev1.ConditionTrue)
+		runtimeToUpdate.Status.Conditions = utils.UpdateRuntimeCondition(runtimeToUpdate.Status.Conditions, cond)
+		return e.Client.Status().Update(context.TODO(), runtimeToUpdate)
+	})
+}
+
+func (e *AlluxioEngine) getValueFromConfigmap() (*Alluxio, error) {
+	helmValueConfigMap, err := kubeclient.GetConfigmapByName(e.Client, e.getHelmValuesConfigMapName(), e.namespace)
+	if err != nil {
+		return nil, err
+	}
+	if helmValueConfigMap == nil {
+		return nil, fmt.Errorf("helm value %s not found", e.getHelmValuesConfigMapName())
+	}
+	helmValue, exist := helmValueConfigMap.Data["data"]
+	if !exist {
+		return nil, fmt.Errorf("data in helm value %s do not exist", e.getHelmValuesConfigMapName())
+	}
+	var currentValue Alluxio
+	if err := yaml.Unmarshal([]byte(helmValue), &currentValue); err != nil {
+		return nil, err
+	}
+	return &currentValue, nil
+}
+
+func (e *AlluxioEngine) saveValueToConfigmap(value *Alluxio) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		helmValueConfigMap, err := kubeclient.GetConfigmapByName(e.Client, e.getHelmValuesConfigMapName(), e.namespace)
+		if err != nil {
+			return err
+		}
+		if helmValueConfigMap == nil {
+			return fmt.Errorf("helm value %s not found", e.getHelmValuesConfigMapName())
+		}
+		data, err := yaml.Marshal(value)
+		if err != nil {
+			return err
+		}
+		helmValueConfigMap.Data["data"] = string(data)
+		return kubeclient.UpdateConfigMap(e.Client, helmValueConfigMap)
+	})
+}
+
+// transformEnvs transforms the env map into the env variables sorted by names as rendered by the helm chart
+func transformEnvs(env map[string]string) (envs []corev1.EnvVar) {
+	for name, value := range env {
+		envs = append(envs, corev1.EnvVar{Name: name, Value: value})
+	}
+	sort.Slice(envs, func(i, j int) bool {
+		return envs[i].Name < envs[j].Name
+	})
+	return
+}
+
+// restartPodTemplate changes the pod template to restart the pods for the config changes
+func restartPodTemplate(template *corev1.PodTemplateSpec) {
+	if template.ObjectMeta.Annotations == nil {
+		template.ObjectMeta.Annotations = map[string]string{}
+	}
+	template.ObjectMeta.Annotations["kubectl.kubernetes.io/restartedAt"] = time.Now().Format(time.RFC3339)
+}
@@ -17,71 +17,226 @@ limitations under the License.
 package alluxio
 
 import (
+	"context"
+	"strings"
 	"testing"
 
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/yaml"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
 )
 
+func mockSyncRuntimeObjects(alluxioRuntime *datav1alpha1.AlluxioRuntime, value *Alluxio) ([]runtime.Object, error) {
+	data, err := yaml.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	podTemplate := func(containerName string) corev1.PodTemplateSpec {
+		return corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{
+					Name:  containerName,
+					Image: value.Image + ":" + value.ImageTag,
+				}},
+			},
+		}
+	}
+
+	fuseTemplate := podTemplate(fuseContainerName)
+	fuseTemplate.Spec.Containers[0].Image = value.Fuse.Image + ":" + value.Fuse.ImageTag
+	fuseTemplate.Spec.Containers[0].Env = transformEnvs(value.Fuse.Env)
+
+	return []runtime.Object{
+		alluxioRuntime,
+		&datav1alpha1.Dataset{ObjectMeta: metav1.ObjectMeta{Name: alluxioRuntime.Name, Namespace: alluxioRuntime.Namespace}},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: alluxioRuntime.Name + "-alluxio-values", Namespace: alluxioRuntime.Namespace},
+			Data:       map[string]string{"data": string(data)},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: alluxioRuntime.Name + "-config", Namespace: alluxioRuntime.Namespace},
+			Data: map[string]string{
+				workerJavaOptsKey: "-Dalluxio.worker.rpc.port=29999 -Xmx2G ",
+			},
+		},
+		&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: alluxioRuntime.Name, Namespace: alluxioRuntime.Namespace}},
+		&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: alluxioRuntime.Name + "-master", Namespace: alluxioRuntime.Namespace},
+			Spec: appsv1.StatefulSetSpec{
+				UpdateStrategy: appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType},
+				Template:       podTemplate(masterContainerName),
+			},
+		},
+		&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: alluxioRuntime.Name + "-worker", Namespace: alluxioRuntime.Namespace},
+			Spec: appsv1.StatefulSetSpec{
+				UpdateStrategy: appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType},
+				Template:       podTemplate(workerContainerName),
+			},
+		},
+		&appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: alluxioRuntime.Name + "-fuse", Namespace: alluxioRuntime.Namespace},
+			Spec: appsv1.DaemonSetSpec{
+				UpdateStrategy: appsv1.DaemonSetUpdateStrategy{Type: appsv1.OnDeleteDaemonSetStrategyType},
+				Template:       fuseTemplate,
+			},
+		},
+	}, nil
+}
+
 func TestAlluxioEngine_SyncRuntime(t *testing.T) {
-	type fields struct {
-		// runtime                *datav1alpha1.AlluxioRuntime
-		// name                   string
-		// namespace              string
-		// runtimeType            string
-		// Log                    logr.Logger
-		// Client                 client.Client
-		// defaultGracefulShutdownLimits int32
-		// retryShutdown          int32
-		// initImage              string
-		// MetadataSyncDoneCh     chan MetadataSyncResult
-		// runtimeInfo            base.RuntimeInfoInterface
-		// UnitTest               bool
-		// lastCacheHitStates     *cacheHitStates
-		// Helper                 *ctrl.Helper
-		// Recorder               record.EventRecorder
+	oldRuntime := &datav1alpha1.AlluxioRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "fluid"},
+		Spec: datav1alpha1.AlluxioRuntimeSpec{
+			Worker: datav1alpha1.AlluxioCompTemplateSpec{
+				Properties: map[string]string{"alluxio.worker.rpc.port": "29999"},
+				JvmOptions: []string{"-Xmx2G"},
+			},
+			Fuse: datav1alpha1.AlluxioFuseSpec{
+				Env: map[string]string{"FUSE_ENV": "old"},
+			},
+		},
 	}
-	type args struct {
-		ctx cruntime.ReconcileRequestContext
+
+	newRuntime := oldRuntime.DeepCopy()
+	newRuntime.Spec.Worker.JvmOptions = []string{"-Xmx4G"}
+	newRuntime.Spec.Worker.Resources = corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
 	}
+	newRuntime.Spec.Fuse.Env = map[string]string{"FUSE_ENV": "new"}
+
 	tests := []struct {
-		name        string
-		fields      fields
-		args        args
-		wantChanged bool
-		wantErr     bool
+		name              string
+		runtime           *datav1alpha1.AlluxioRuntime
+		wantChanged       bool
+		wantChangedFields []string
 	}{
 		{
-			name:        "default",
+			name:        "no_changes",
+			runtime:     oldRuntime,
 			wantChanged: false,
-			wantErr:     false,
+		},
+		{
+			name:              "worker_and_fuse_changed",
+			runtime:           newRuntime,
+			wantChanged:       true,
+			wantChangedFields: []string{"worker.properties", "worker.resources", "fuse.env"},
 		},
 	}
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			e := &AlluxioEngine{
-				// runtime:                tt.fields.runtime,
-				// name:                   tt.fields.name,
-				// namespace:              tt.fields.namespace,
-				// runtimeType:            tt.fields.runtimeType,
-				// Log:                    tt.fields.Log,
-				// Client:                 tt.fields.Client,
-				// defaultGracefulShutdownLimits: tt.fields.defaultGracefulShutdownLimits,
-				// retryShutdown:          tt.fields.retryShutdown,
-				// initImage:              tt.fields.initImage,
-				// MetadataSyncDoneCh:     tt.fields.MetadataSyncDoneCh,
-				// runtimeInfo:            tt.fields.runtimeInfo,
-				// UnitTest:               tt.fields.UnitTest,
-				// lastCacheHitStates:     tt.fields.lastCacheHitStates,
-				// Helper:                 tt.fields.Helper,
-				// Recorder:               tt.fields.Recorder,
-			}
-			gotChanged, err := e.SyncRuntime(tt.args.ctx)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("AlluxioEngine.SyncRuntime() error = %v, wantErr %v", err, tt.wantErr)
+			runtimeInfo, err := base.BuildRuntimeInfo("hbase", "fluid", common.AlluxioRuntime)
+			if err != nil {
+				t.Fatalf("fail to create the runtimeInfo with error %v", err)
+			}
+			engine := &AlluxioEngine{
+				name:        "hbase",
+				namespace:   "fluid",
+				engineImpl:  common.AlluxioEngineImpl,
+				runtimeInfo: runtimeInfo,
+				Log:         ctrl.Log.WithName(tt.name),
+			}
+
+			// the old value is the one transformed from the runtime before changes
+			engine.Client = fake.NewFakeClientWithScheme(testScheme, oldRuntime.DeepCopy(),
+				&datav1alpha1.Dataset{ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "fluid"}})
+			oldValue, err := engine.transformLatestValue(oldRuntime.DeepCopy(), &Alluxio{
+				Master: Master{Ports: Ports{Rpc: 19998, Web: 19999}},
+				Worker: Worker{Ports: Ports{Rpc: 29999, Web: 30000}},
+			})
+			if err != nil {
+				t.Fatalf("failed to transform the old runtime: %v", err)
+			}
+
+			objs, err := mockSyncRuntimeObjects(tt.runtime.DeepCopy(), oldValue)
+			if err != nil {
+				t.Fatalf("failed to mock objects: %v", err)
+			}
+			engine.Client = fake.NewFakeClientWithScheme(testScheme, objs...)
+
+			changed, err := engine.SyncRuntime(cruntime.ReconcileRequestContext{
+				NamespacedName: types.NamespacedName{Name: "hbase", Namespace: "fluid"},
+			})
+			if err != nil {
+				t.Fatalf("AlluxioEngine.SyncRuntime() error = %v", err)
+			}
+			if changed != tt.wantChanged {
+				t.Fatalf("AlluxioEngine.SyncRuntime() = %v, want %v", changed, tt.wantChanged)
+			}
+			if !tt.wantChanged {
 				return
 			}
-			if gotChanged != tt.wantChanged {
-				t.Errorf("AlluxioEngine.SyncRuntime() = %v, want %v", gotChanged, tt.wantChanged)
+
+			worker := &appsv1.StatefulSet{}
+			if err = engine.Client.Get(context.TODO(), types.NamespacedName{Name: "hbase-worker", Namespace: "fluid"}, worker); err != nil {
+				t.Fatalf("failed to get worker: %v", err)
+			}
+			if cpu := worker.Spec.Template.Spec.Containers[0].Resources.Requests[corev1.ResourceCPU]; cpu.Cmp(resource.MustParse("2")) != 0 {
+				t.Errorf("worker cpu requests = %v, want 2", cpu.String())
+			}
+			if _, found := worker.Spec.Template.Annotations["kubectl.kubernetes.io/restartedAt"]; !found {
+				t.Errorf("worker is expected to be restarted for the config changes")
+			}
+
+			config := &corev1.ConfigMap{}
+			if err = engine.Client.Get(context.TODO(), types.NamespacedName{Name: "hbase-config", Namespace: "fluid"}, config); err != nil {
+				t.Fatalf("failed to get config: %v", err)
+			}
+			if want := "-Dalluxio.worker.rpc.port=29999 -Xmx4G "; config.Data[workerJavaOptsKey] != want {
+				t.Errorf("worker java opts = %q, want %q", config.Data[workerJavaOptsKey], want)
+			}
+
+			fuse := &appsv1.DaemonSet{}
+			if err = engine.Client.Get(context.TODO(), types.NamespacedName{Name: "hbase-fuse", Namespace: "fluid"}, fuse); err != nil {
+				t.Fatalf("failed to get fuse: %v", err)
+			}
+			var fuseEnvs []string
+			for _, env := range fuse.Spec.Template.Spec.Containers[0].Env {
+				if env.Name == "FUSE_ENV" {
+					fuseEnvs = append(fuseEnvs, env.Value)
+				}
+			}
+			if len(fuseEnvs) != 1 || fuseEnvs[0] != "new" {
+				t.Errorf("fuse env FUSE_ENV = %v, want [new]", fuseEnvs)
+			}
+			if generation := fuse.Spec.Template.Labels[common.LabelRuntimeFuseGeneration]; generation != "1" {
+				t.Errorf("fuse generation = %q, want 1", generation)
+			}
+
+			runtime, err := engine.getRuntime()
+			if err != nil {
+				t.Fatalf("failed to get runtime: %v", err)
+			}
+			_, cond := utils.GetRuntimeCondition(runtime.Status.Conditions, datav1alpha1.RuntimeSpecSynced)
+			if cond == nil {
+				t.Fatalf("condition %s is not found", datav1alpha1.RuntimeSpecSynced)
+			}
+			for _, field := range tt.wantChangedFields {
+				if !strings.Contains(cond.Message, field) {
+					t.Errorf("condition message %q doesn't contain %s", cond.Message, field)
+				}
+			}
+
+			// syncing again is expected to change nothing
+			changed, err = engine.SyncRuntime(cruntime.ReconcileRequestContext{
+				NamespacedName: types.NamespacedName{Name: "hbase", Namespace: "fluid"},
+			})
+			if err != nil || changed {
+				t.Errorf("AlluxioEngine.SyncRuntime() again = %v, %v, want no changes", changed, err)
 			}
 		})
 	}
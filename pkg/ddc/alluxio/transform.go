@@ -34,6 +34,32 @@ import (
 )
 
 func (e *AlluxioEngine) transform(runtime *datav1alpha1.AlluxioRuntime) (value *Alluxio, err error) {
+	value, err = e.transformWithoutPorts(runtime)
+	if err != nil {
+		return
+	}
+
+	// 12.allocate port for fluid engine
+	if datav1alpha1.IsHostNetwork(runtime.Spec.Master.NetworkMode) ||
+		datav1alpha1.IsHostNetwork(runtime.Spec.Worker.NetworkMode) {
+		e.Log.Info("allocatePorts for hostnetwork mode")
+		err = e.allocatePorts(value, runtime)
+		if err != nil {
+			return
+		}
+	} else {
+		e.Log.Info("skip allocatePorts for container network mode")
+		e.generateStaticPorts(value)
+	}
+
+	// 13.set engine properties
+	e.setPortProperties(runtime, value)
+	return
+}
+
+// transformWithoutPorts transforms the runtime into the values without allocating the ports, so that it can be
+// used to compare the latest values with the ones of the running runtime.
+func (e *AlluxioEngine) transformWithoutPorts(runtime *datav1alpha1.AlluxioRuntime) (value *Alluxio, err error) {
 	if runtime == nil {
 		err = fmt.Errorf("the alluxioRuntime is null")
 		return
@@ -99,26 +125,13 @@ func (e *AlluxioEngine) transform(runtime *datav1alpha1.AlluxioRuntime) (value *
 	// 9. set optimization parameters if all the mounts are HTTP
 	e.optimizeDefaultPropertiesAndFuseForHTTP(runtime, dataset, value)
 
-	// 10.allocate port for fluid engine
-	if datav1alpha1.IsHostNetwork(runtime.Spec.Master.NetworkMode) ||
-		datav1alpha1.IsHostNetwork(runtime.Spec.Worker.NetworkMode) {
-		e.Log.Info("allocatePorts for hostnetwork mode")
-		err = e.allocatePorts(value, runtime)
-		if err != nil {
-			return
-		}
-	} else {
-		e.Log.Info("skip allocatePorts for container network mode")
-		e.generateStaticPorts(value)
-	}
-
-	// 11.set engine properties
-	e.setPortProperties(runtime, value)
-
-	// 12.set API Gateway
+	// 10.set API Gateway
 	err = e.transformAPIGateway(runtime, value)
+	if err != nil {
+		return
+	}
 
-	// 13.set the placementMode
+	// 11.set the placementMode
 	e.transformPlacementMode(dataset, value)
 	return
 }
@@ -312,6 +325,7 @@ func (e *AlluxioEngine) transformMasters(runtime *datav1alpha1.AlluxioRuntime,
 	if len(nodeSelector) != 0 {
 		value.Master.NodeSelector = nodeSelector
 	}
+	value.Master.Scheduling = utils.TransformSchedulingSpec(runtime.Spec.Master.SchedulingSpec)
 
 	// // check the run as
 	// if runtime.Spec.RunAs != nil {
@@ -388,6 +402,7 @@ func (e *AlluxioEngine) transformWorkers(runtime *datav1alpha1.AlluxioRuntime, v
 	} else {
 		value.Worker.NodeSelector = map[string]string{}
 	}
+	value.Worker.Scheduling = utils.TransformSchedulingSpec(runtime.Spec.Worker.SchedulingSpec)
 
 	if len(runtime.Spec.Worker.Properties) > 0 {
 		value.Worker.Properties = runtime.Spec.Worker.Properties
@@ -101,6 +101,7 @@ func (e *AlluxioEngine) transformFuse(runtime *datav1alpha1.AlluxioRuntime, data
 		value.Fuse.NodeSelector = map[string]string{}
 	}
 	value.Fuse.NodeSelector[utils.GetFuseLabelName(runtime.Namespace, runtime.Name, e.runtimeInfo.GetOwnerDatasetUID())] = "true"
+	value.Fuse.Scheduling = utils.TransformSchedulingSpec(runtime.Spec.Fuse.SchedulingSpec)
 
 	// parse fuse container network mode
 	value.Fuse.HostNetwork = datav1alpha1.IsHostNetwork(runtime.Spec.Fuse.NetworkMode)
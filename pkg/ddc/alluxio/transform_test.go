@@ -282,6 +282,27 @@ func TestTransformWorkers(t *testing.T) {
 				},
 			},
 		},
+		"test scheduling case1": {
+			runtime: &datav1alpha1.AlluxioRuntime{
+				Spec: datav1alpha1.AlluxioRuntimeSpec{
+					Worker: datav1alpha1.AlluxioCompTemplateSpec{
+						SchedulingSpec: datav1alpha1.SchedulingSpec{
+							Tolerations:       []corev1.Toleration{{Key: "gpu", Operator: corev1.TolerationOpExists}},
+							PriorityClassName: "high-priority",
+						},
+					},
+				},
+			},
+			wantValue: &Alluxio{
+				Worker: Worker{
+					Scheduling: &common.Scheduling{
+						Tolerations:       []corev1.Toleration{{Key: "gpu", Operator: corev1.TolerationOpExists}},
+						PriorityClassName: "high-priority",
+					},
+					HostNetwork: true,
+				},
+			},
+		},
 	}
 
 	engine := &AlluxioEngine{Log: fake.NullLogger()}
@@ -314,6 +335,13 @@ func TestTransformWorkers(t *testing.T) {
 					)
 				}
 			}
+			if !reflect.DeepEqual(v.wantValue.Worker.Scheduling, gotValue.Worker.Scheduling) {
+				t.Errorf("check %s failure, got:%v,want:%v",
+					k,
+					gotValue.Worker.Scheduling,
+					v.wantValue.Worker.Scheduling,
+				)
+			}
 		}
 	}
 }
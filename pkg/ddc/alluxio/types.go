@@ -140,6 +140,7 @@ type Worker struct {
 	JvmOptions       []string                      `json:"jvmOptions,omitempty"`
 	Env              map[string]string             `json:"env,omitempty"`
 	NodeSelector     map[string]string             `json:"nodeSelector,omitempty"`
+	Scheduling       *common.Scheduling            `json:"scheduling,omitempty"`
 	Properties       map[string]string             `json:"properties,omitempty"`
 	HostNetwork      bool                          `json:"hostNetwork,omitempty"`
 	Resources        common.Resources              `json:"resources,omitempty"`
@@ -156,6 +157,7 @@ type Master struct {
 	Env          map[string]string    `json:"env,omitempty"`
 	Affinity     Affinity             `json:"affinity"`
 	NodeSelector map[string]string    `json:"nodeSelector,omitempty"`
+	Scheduling   *common.Scheduling   `json:"scheduling,omitempty"`
 	Properties   map[string]string    `json:"properties,omitempty"`
 	Replicas     int32                `json:"replicaCount,omitempty"`
 	HostNetwork  bool                 `json:"hostNetwork,omitempty"`
@@ -183,6 +185,7 @@ type Restore struct {
 type Fuse struct {
 	Image              string                        `json:"image,omitempty"`
 	NodeSelector       map[string]string             `json:"nodeSelector,omitempty"`
+	Scheduling         *common.Scheduling            `json:"scheduling,omitempty"`
 	ImageTag           string                        `json:"imageTag,omitempty"`
 	ImagePullPolicy    string                        `json:"imagePullPolicy,omitempty"`
 	ImagePullSecrets   []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
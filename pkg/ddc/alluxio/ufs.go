@@ -57,6 +57,13 @@ func (e *AlluxioEngine) TotalFileNums() (value int64, err error) {
 	return e.totalFileNumsInternal()
 }
 
+// ListUFS lists the files under the path of the dataset recursively to detect the changes of the under file system
+func (e *AlluxioEngine) ListUFS(path string) (listing string, err error) {
+	podName, containerName := e.getMasterPodInfo()
+	fileUtils := operations.NewAlluxioFileUtils(podName, containerName, e.namespace, e.Log)
+	return fileUtils.ListRecursively(path)
+}
+
 // ShouldCheckUFS checks if it requires checking UFS
 func (e *AlluxioEngine) ShouldCheckUFS() (should bool, err error) {
 	// For Alluxio Engine, always attempt to prepare UFS
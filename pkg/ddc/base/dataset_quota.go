@@ -0,0 +1,264 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+// datasetQuotaRuntimeTypes are the runtimes whose workers are scaled out from zero by the runtime controller,
+// so that all the cache capacity they request passes through ReserveDatasetQuota.
+var datasetQuotaRuntimeTypes = map[string]bool{
+	common.AlluxioRuntime:       true,
+	common.GooseFSRuntime:       true,
+	common.JindoRuntime:         true,
+	common.JindoFSxEngineImpl:   true,
+	common.JindoCacheEngineImpl: true,
+	common.JuiceFSRuntime:       true,
+}
+
+// GetCacheCapacityRequests gets the cache capacity requested by the runtime on each medium type,
+// which is the quota of the tiered store levels multiplied by the replicas of the workers.
+func GetCacheCapacityRequests(runtimeInfo RuntimeInfoInterface, replicas int32) map[common.MediumType]resource.Quantity {
+	return getCacheCapacityRequests(runtimeInfo.GetTieredStoreInfo(), replicas)
+}
+
+func getCacheCapacityRequests(tieredStoreInfo TieredStoreInfo, replicas int32) (requests map[common.MediumType]resource.Quantity) {
+	requests = map[common.MediumType]resource.Quantity{}
+	if replicas <= 0 {
+		return
+	}
+	for _, level := range tieredStoreInfo.Levels {
+		for _, cachePath := range level.CachePaths {
+			if cachePath.Quota == nil {
+				continue
+			}
+			addCacheCapacityRequests(requests, map[common.MediumType]resource.Quantity{
+				level.MediumType: *resource.NewQuantity(cachePath.Quota.Value()*int64(replicas), resource.BinarySI),
+			})
+		}
+	}
+	return
+}
+
+// ReserveDatasetQuota reserves the cache capacity requested by scaling the workers of the runtime out
+// from currentReplicas to desiredReplicas in the DatasetQuotas of its namespace, and returns an error
+// without reserving anything in the exceeded DatasetQuota if any limit is exceeded.
+//
+// The requests are added to the usage recorded in the status of the DatasetQuotas, and the status is
+// updated with optimistic concurrency, so the runtimes scaling out concurrently can't oversubscribe
+// the quota. Scaling in releases nothing here, the usage is recalculated by UpdateDatasetQuotaStatus.
+func ReserveDatasetQuota(c client.Client, runtimeInfo RuntimeInfoInterface, currentReplicas, desiredReplicas int32) (err error) {
+	if runtimeInfo == nil || desiredReplicas <= currentReplicas || !datasetQuotaRuntimeTypes[runtimeInfo.GetRuntimeType()] {
+		return nil
+	}
+
+	requests := GetCacheCapacityRequests(runtimeInfo, desiredReplicas-currentReplicas)
+	if len(requests) == 0 {
+		return nil
+	}
+
+	name, namespace := runtimeInfo.GetName(), runtimeInfo.GetNamespace()
+	quotaList := &datav1alpha1.DatasetQuotaList{}
+	if err = c.List(context.TODO(), quotaList, client.InNamespace(namespace)); err != nil {
+		return err
+	}
+
+	for _, quota := range quotaList.Items {
+		err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+			quotaToUpdate := &datav1alpha1.DatasetQuota{}
+			if err := c.Get(context.TODO(), client.ObjectKeyFromObject(&quota), quotaToUpdate); err != nil {
+				return utils.IgnoreNotFound(err)
+			}
+			if quotaToUpdate.Status.CacheCapacity == nil {
+				return fmt.Errorf("the usage of DatasetQuota %s/%s is not calculated yet", namespace, quota.Name)
+			}
+
+			used := map[common.MediumType]resource.Quantity{}
+			addCacheCapacityRequests(used, quotaToUpdate.Status.MediumCacheCapacity)
+			addCacheCapacityRequests(used, requests)
+			if err := checkDatasetQuota(*quotaToUpdate, used); err != nil {
+				return fmt.Errorf("cache capacity requested by scaling out dataset %s/%s exceeds the DatasetQuota %s: %v",
+					namespace, name, quota.Name, err)
+			}
+
+			datasets := quotaToUpdate.Status.Datasets
+			if !utils.ContainsString(datasets, name) {
+				datasets = append(append([]string{}, datasets...), name)
+				sort.Strings(datasets)
+			}
+			setDatasetQuotaStatus(quotaToUpdate, used, datasets)
+			return c.Status().Update(context.TODO(), quotaToUpdate)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateDatasetQuotaStatus recalculates the cache capacity requested by the workers of the runtimes
+// in the namespace of the DatasetQuota and records it in the status.
+func UpdateDatasetQuotaStatus(c client.Client, key client.ObjectKey) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		quota := &datav1alpha1.DatasetQuota{}
+		if err := c.Get(context.TODO(), key, quota); err != nil {
+			return err
+		}
+
+		used, datasets, err := GetDatasetQuotaUsage(c, quota.Namespace)
+		if err != nil {
+			return err
+		}
+
+		quotaToUpdate := quota.DeepCopy()
+		setDatasetQuotaStatus(quotaToUpdate, used, datasets)
+		if equality.Semantic.DeepEqual(quota.Status, quotaToUpdate.Status) {
+			return nil
+		}
+		return c.Status().Update(context.TODO(), quotaToUpdate)
+	})
+}
+
+// GetDatasetQuotaUsage sums the cache capacity requested by the worker statefulsets of the runtimes bound
+// to the datasets in the namespace, it also returns the sorted names of the datasets requesting cache capacity.
+func GetDatasetQuotaUsage(c client.Client, namespace string) (used map[common.MediumType]resource.Quantity, datasets []string, err error) {
+	datasetList := &datav1alpha1.DatasetList{}
+	if err = c.List(context.TODO(), datasetList, client.InNamespace(namespace)); err != nil {
+		return
+	}
+
+	used = map[common.MediumType]resource.Quantity{}
+	for _, dataset := range datasetList.Items {
+		// the dataset no longer requests cache capacity once its runtime is deleted
+		if len(dataset.Status.Runtimes) == 0 || !datasetQuotaRuntimeTypes[dataset.Status.Runtimes[0].Type] {
+			continue
+		}
+
+		var requests map[common.MediumType]resource.Quantity
+		requests, err = getRuntimeCacheCapacityRequests(c, dataset.Status.Runtimes[0].Type, dataset.Name, namespace)
+		if err != nil {
+			return
+		}
+		if len(requests) == 0 {
+			continue
+		}
+		addCacheCapacityRequests(used, requests)
+		datasets = append(datasets, dataset.Name)
+	}
+	sort.Strings(datasets)
+	return
+}
+
+// getRuntimeCacheCapacityRequests gets the cache capacity requested by the worker statefulset of the runtime
+func getRuntimeCacheCapacityRequests(c client.Client, runtimeType, name, namespace string) (requests map[common.MediumType]resource.Quantity, err error) {
+	var tieredStore datav1alpha1.TieredStore
+	switch runtimeType {
+	case common.AlluxioRuntime:
+		runtime, err := utils.GetAlluxioRuntime(c, name, namespace)
+		if err != nil {
+			return nil, utils.IgnoreNotFound(err)
+		}
+		tieredStore = runtime.Spec.TieredStore
+	case common.GooseFSRuntime:
+		runtime, err := utils.GetGooseFSRuntime(c, name, namespace)
+		if err != nil {
+			return nil, utils.IgnoreNotFound(err)
+		}
+		tieredStore = runtime.Spec.TieredStore
+	case common.JindoRuntime, common.JindoFSxEngineImpl, common.JindoCacheEngineImpl:
+		runtime, err := utils.GetJindoRuntime(c, name, namespace)
+		if err != nil {
+			return nil, utils.IgnoreNotFound(err)
+		}
+		tieredStore = runtime.Spec.TieredStore
+	case common.JuiceFSRuntime:
+		runtime, err := utils.GetJuiceFSRuntime(c, name, namespace)
+		if err != nil {
+			return nil, utils.IgnoreNotFound(err)
+		}
+		tieredStore = runtime.Spec.TieredStore
+	default:
+		return nil, fmt.Errorf("DatasetQuota is not supported by runtime type %s", runtimeType)
+	}
+
+	runtimeInfo, err := BuildRuntimeInfo(name, namespace, runtimeType, WithTieredStore(tieredStore))
+	if err != nil {
+		return nil, err
+	}
+	workers, err := kubeclient.GetStatefulSet(c, runtimeInfo.GetWorkerStatefulsetName(), namespace)
+	if err != nil || workers == nil || workers.Spec.Replicas == nil {
+		return nil, utils.IgnoreNotFound(err)
+	}
+	return GetCacheCapacityRequests(runtimeInfo, *workers.Spec.Replicas), nil
+}
+
+func setDatasetQuotaStatus(quota *datav1alpha1.DatasetQuota, used map[common.MediumType]resource.Quantity, datasets []string) {
+	total := sumQuantities(used)
+	quota.Status = datav1alpha1.DatasetQuotaStatus{
+		CacheCapacity:       &total,
+		MediumCacheCapacity: used,
+		Datasets:            datasets,
+	}
+}
+
+// checkDatasetQuota checks the cache capacity used on each medium type against the quota
+func checkDatasetQuota(quota datav1alpha1.DatasetQuota, used map[common.MediumType]resource.Quantity) error {
+	if limit := quota.Spec.CacheCapacity; limit != nil {
+		if total := sumQuantities(used); total.Cmp(*limit) > 0 {
+			return fmt.Errorf("total cache capacity %s exceeds the limit %s", total.String(), limit.String())
+		}
+	}
+	for mediumType, limit := range quota.Spec.MediumCacheCapacity {
+		if request, found := used[mediumType]; found && request.Cmp(limit) > 0 {
+			return fmt.Errorf("cache capacity %s on medium %s exceeds the limit %s", request.String(), mediumType, limit.String())
+		}
+	}
+	return nil
+}
+
+// addCacheCapacityRequests adds the requests on each medium type to the used ones
+func addCacheCapacityRequests(used, requests map[common.MediumType]resource.Quantity) {
+	for mediumType, request := range requests {
+		total, found := used[mediumType]
+		if !found {
+			total = *resource.NewQuantity(0, resource.BinarySI)
+		}
+		total.Add(request)
+		used[mediumType] = total
+	}
+}
+
+func sumQuantities(quantities map[common.MediumType]resource.Quantity) (total resource.Quantity) {
+	total = *resource.NewQuantity(0, resource.BinarySI)
+	for _, quantity := range quantities {
+		total.Add(quantity)
+	}
+	return
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func mockQuotaRuntime(name string, replicas int32, quota string) []runtime.Object {
+	quantity := resource.MustParse(quota)
+	return []runtime.Object{
+		&datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "fluid"},
+			Status: datav1alpha1.DatasetStatus{
+				Runtimes: []datav1alpha1.Runtime{{Name: name, Namespace: "fluid", Type: common.AlluxioRuntime}},
+			},
+		},
+		&datav1alpha1.AlluxioRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "fluid"},
+			Spec: datav1alpha1.AlluxioRuntimeSpec{
+				TieredStore: datav1alpha1.TieredStore{
+					Levels: []datav1alpha1.Level{{MediumType: common.Memory, Path: "/dev/shm", Quota: &quantity}},
+				},
+			},
+		},
+		&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: name + "-worker", Namespace: "fluid"},
+			Spec:       appsv1.StatefulSetSpec{Replicas: &replicas},
+		},
+	}
+}
+
+func TestGetCacheCapacityRequests(t *testing.T) {
+	quota := resource.MustParse("10Gi")
+	runtimeInfo, err := BuildRuntimeInfo("hbase", "fluid", common.AlluxioRuntime, WithTieredStore(datav1alpha1.TieredStore{
+		Levels: []datav1alpha1.Level{
+			{MediumType: common.Memory, Path: "/dev/shm", Quota: &quota},
+			{MediumType: common.SSD, Path: "/mnt/ssd1,/mnt/ssd2", QuotaList: "20Gi,30Gi"},
+		},
+	}))
+	if err != nil {
+		t.Fatalf("failed to build runtime info: %v", err)
+	}
+
+	requests := GetCacheCapacityRequests(runtimeInfo, 2)
+	want := map[common.MediumType]string{
+		common.Memory: "20Gi",
+		common.SSD:    "100Gi",
+	}
+	if len(requests) != len(want) {
+		t.Fatalf("GetCacheCapacityRequests() = %v, want %v", requests, want)
+	}
+	for mediumType, quantity := range want {
+		if request := requests[mediumType]; request.Cmp(resource.MustParse(quantity)) != 0 {
+			t.Errorf("GetCacheCapacityRequests() on %s = %s, want %s", mediumType, request.String(), quantity)
+		}
+	}
+
+	if requests = GetCacheCapacityRequests(runtimeInfo, 0); len(requests) != 0 {
+		t.Errorf("GetCacheCapacityRequests() with no replicas = %v, want none", requests)
+	}
+}
+
+func TestReserveDatasetQuota(t *testing.T) {
+	quota := resource.MustParse("10Gi")
+	limit := resource.MustParse("50Gi")
+	memLimit := resource.MustParse("30Gi")
+	used := resource.MustParse("20Gi")
+
+	tests := []struct {
+		name        string
+		runtimeType string
+		current     int32
+		desired     int32
+		quota       *datav1alpha1.DatasetQuota
+		wantErr     bool
+		wantUsed    string
+	}{
+		{
+			name:        "no_quota",
+			runtimeType: common.AlluxioRuntime,
+			desired:     3,
+		},
+		{
+			name:        "within_quota",
+			runtimeType: common.AlluxioRuntime,
+			current:     1,
+			desired:     3,
+			quota: &datav1alpha1.DatasetQuota{
+				Spec: datav1alpha1.DatasetQuotaSpec{CacheCapacity: &limit},
+				Status: datav1alpha1.DatasetQuotaStatus{
+					CacheCapacity:       &used,
+					MediumCacheCapacity: map[common.MediumType]resource.Quantity{common.SSD: used},
+					Datasets:            []string{"spark"},
+				},
+			},
+			wantUsed: "40Gi",
+		},
+		{
+			name:        "exceeds_total_quota",
+			runtimeType: common.AlluxioRuntime,
+			desired:     4,
+			quota: &datav1alpha1.DatasetQuota{
+				Spec: datav1alpha1.DatasetQuotaSpec{CacheCapacity: &limit},
+				Status: datav1alpha1.DatasetQuotaStatus{
+					CacheCapacity:       &used,
+					MediumCacheCapacity: map[common.MediumType]resource.Quantity{common.SSD: used},
+				},
+			},
+			wantErr:  true,
+			wantUsed: "20Gi",
+		},
+		{
+			name:        "exceeds_medium_quota",
+			runtimeType: common.AlluxioRuntime,
+			desired:     2,
+			quota: &datav1alpha1.DatasetQuota{
+				Spec: datav1alpha1.DatasetQuotaSpec{
+					MediumCacheCapacity: map[common.MediumType]resource.Quantity{common.Memory: memLimit},
+				},
+				Status: datav1alpha1.DatasetQuotaStatus{
+					CacheCapacity:       &used,
+					MediumCacheCapacity: map[common.MediumType]resource.Quantity{common.Memory: used},
+				},
+			},
+			wantErr:  true,
+			wantUsed: "20Gi",
+		},
+		{
+			name:        "usage_not_calculated",
+			runtimeType: common.AlluxioRuntime,
+			desired:     1,
+			quota: &datav1alpha1.DatasetQuota{
+				Spec: datav1alpha1.DatasetQuotaSpec{CacheCapacity: &limit},
+			},
+			wantErr: true,
+		},
+		{
+			name:        "scale_in",
+			runtimeType: common.AlluxioRuntime,
+			current:     4,
+			desired:     2,
+			quota: &datav1alpha1.DatasetQuota{
+				Spec: datav1alpha1.DatasetQuotaSpec{CacheCapacity: &limit},
+				Status: datav1alpha1.DatasetQuotaStatus{
+					CacheCapacity:       &used,
+					MediumCacheCapacity: map[common.MediumType]resource.Quantity{common.Memory: used},
+				},
+			},
+			wantUsed: "20Gi",
+		},
+		{
+			name:        "runtime_not_supported",
+			runtimeType: common.ThinRuntime,
+			desired:     10,
+			quota: &datav1alpha1.DatasetQuota{
+				Spec: datav1alpha1.DatasetQuotaSpec{CacheCapacity: &limit},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objs := []runtime.Object{}
+			if tt.quota != nil {
+				tt.quota.ObjectMeta = metav1.ObjectMeta{Name: "quota", Namespace: "fluid"}
+				objs = append(objs, tt.quota)
+			}
+			client := fake.NewFakeClientWithScheme(datav1alpha1.UnitTestScheme, objs...)
+
+			runtimeInfo, err := BuildRuntimeInfo("hbase", "fluid", tt.runtimeType, WithTieredStore(datav1alpha1.TieredStore{
+				Levels: []datav1alpha1.Level{{MediumType: common.Memory, Path: "/dev/shm", Quota: &quota}},
+			}))
+			if err != nil {
+				t.Fatalf("failed to build runtime info: %v", err)
+			}
+
+			err = ReserveDatasetQuota(client, runtimeInfo, tt.current, tt.desired)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReserveDatasetQuota() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(tt.wantUsed) == 0 {
+				return
+			}
+
+			quota := &datav1alpha1.DatasetQuota{}
+			if err = client.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "quota"}, quota); err != nil {
+				t.Fatalf("failed to get quota: %v", err)
+			}
+			if quota.Status.CacheCapacity == nil || quota.Status.CacheCapacity.Cmp(resource.MustParse(tt.wantUsed)) != 0 {
+				t.Errorf("quota used = %v, want %s", quota.Status.CacheCapacity, tt.wantUsed)
+			}
+			if tt.name == "within_quota" && len(quota.Status.Datasets) != 2 {
+				t.Errorf("quota datasets = %v, want both datasets", quota.Status.Datasets)
+			}
+		})
+	}
+}
+
+func TestUpdateDatasetQuotaStatus(t *testing.T) {
+	objs := []runtime.Object{
+		&datav1alpha1.DatasetQuota{ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: "fluid"}},
+		// the dataset whose runtime is deleted
+		&datav1alpha1.Dataset{ObjectMeta: metav1.ObjectMeta{Name: "unbound", Namespace: "fluid"}},
+	}
+	objs = append(objs, mockQuotaRuntime("hbase", 2, "10Gi")...)
+	objs = append(objs, mockQuotaRuntime("spark", 3, "5Gi")...)
+	objs = append(objs, mockQuotaRuntime("idle", 0, "5Gi")...)
+	client := fake.NewFakeClientWithScheme(datav1alpha1.UnitTestScheme, objs...)
+
+	key := types.NamespacedName{Namespace: "fluid", Name: "quota"}
+	if err := UpdateDatasetQuotaStatus(client, key); err != nil {
+		t.Fatalf("UpdateDatasetQuotaStatus() error = %v", err)
+	}
+
+	quota := &datav1alpha1.DatasetQuota{}
+	if err := client.Get(context.TODO(), key, quota); err != nil {
+		t.Fatalf("failed to get quota: %v", err)
+	}
+	if quota.Status.CacheCapacity == nil || quota.Status.CacheCapacity.Cmp(resource.MustParse("35Gi")) != 0 {
+		t.Errorf("quota used = %v, want 35Gi", quota.Status.CacheCapacity)
+	}
+	if memory := quota.Status.MediumCacheCapacity[common.Memory]; memory.Cmp(resource.MustParse("35Gi")) != 0 {
+		t.Errorf("quota used on memory = %s, want 35Gi", memory.String())
+	}
+	if want := []string{"hbase", "spark"}; !reflect.DeepEqual(quota.Status.Datasets, want) {
+		t.Errorf("quota datasets = %v, want %v", quota.Status.Datasets, want)
+	}
+
+	if err := UpdateDatasetQuotaStatus(client, types.NamespacedName{Namespace: "fluid", Name: "notfound"}); err == nil {
+		t.Errorf("UpdateDatasetQuotaStatus() on a missing quota should fail")
+	}
+}
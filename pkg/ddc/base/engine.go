@@ -63,6 +63,26 @@ type DataOperatorYamlGenerator interface {
 	GetDataOperationValueFile(ctx cruntime.ReconcileRequestContext, operation dataoperation.OperationInterface) (valueFileName string, err error)
 }
 
+// DataOperationProgressReporter is optionally implemented by the runtime engines using TemplateEngine to derive the progress
+// of target paths from the runtime, e.g. the cached bytes of the paths loaded by DataLoad. It returns nil if the operation
+// is not supported. The previous progress is passed so that the values which don't change during the operation can be reused.
+type DataOperationProgressReporter interface {
+	GetDataOperationProgress(ctx cruntime.ReconcileRequestContext, operation dataoperation.OperationInterface,
+		previous []datav1alpha1.TargetPathProgress) (progress []datav1alpha1.TargetPathProgress, err error)
+}
+
+// UFSLister is optionally implemented by the runtime engines to list the files under a path of the dataset recursively,
+// the checksums of the listings are compared to find out the changed paths of the under file system.
+type UFSLister interface {
+	ListUFS(path string) (listing string, err error)
+}
+
+// RuntimeValueGenerator is optionally implemented by the runtime engines using TemplateEngine to transform the runtime into
+// the value file of the runtime chart without installing the release, so that the manifests can be rendered offline.
+type RuntimeValueGenerator interface {
+	GenerateRuntimeValueFile() (valueFileName string, chartName string, err error)
+}
+
 // Implement is what the real engine should implement if it use the TemplateEngine
 type Implement interface {
 	UnderFileSystemService
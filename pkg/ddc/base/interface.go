@@ -31,3 +31,11 @@ type RuntimeInterface interface {
 
 	client.Object
 }
+
+// AutoscalableRuntime is the runtime whose workers can be scaled automatically by the runtime controller
+type AutoscalableRuntime interface {
+	RuntimeInterface
+
+	// GetAutoscalingPolicy gets the autoscaling policy of runtime workers, nil means autoscaling is disabled
+	GetAutoscalingPolicy() *datav1alpha1.AutoscalingPolicy
+}
@@ -17,19 +17,27 @@ limitations under the License.
 package base
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"strconv"
 	"time"
 
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/metrics"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // MetadataSyncResult describes result for asynchronous metadata sync
 type MetadataSyncResult struct {
 	Done      bool
 	StartTime time.Time
+	EndTime   time.Time
 	UfsTotal  string
 	FileNum   string
 	Err       error
@@ -63,6 +71,9 @@ func SafeSend(ch chan MetadataSyncResult, result MetadataSyncResult) (closed boo
 		}
 	}()
 
+	if result.EndTime.IsZero() {
+		result.EndTime = time.Now()
+	}
 	ch <- result
 	return false
 }
@@ -81,6 +92,19 @@ func RecordDatasetMetrics(result MetadataSyncResult, datasetNamespace, datasetNa
 		return
 	}
 
+	if !result.EndTime.IsZero() && !result.StartTime.IsZero() {
+		metrics.GetOrCreateDatasetMetrics(datasetNamespace, datasetName).SetMetadataSyncDuration(result.EndTime.Sub(result.StartTime).Seconds())
+	}
+
+	if !result.Done {
+		metrics.GetOrCreateDatasetMetrics(datasetNamespace, datasetName).MetadataSyncFailureInc()
+		return
+	}
+
+	if !result.EndTime.IsZero() {
+		metrics.GetOrCreateDatasetMetrics(datasetNamespace, datasetName).SetMetadataLastSyncTime(float64(result.EndTime.Unix()))
+	}
+
 	if len(result.UfsTotal) != 0 {
 		if ufsTotal, parseErr := utils.FromHumanSize(result.UfsTotal); parseErr == nil {
 			metrics.GetOrCreateDatasetMetrics(datasetNamespace, datasetName).SetUFSTotalSize(float64(ufsTotal))
@@ -97,3 +121,71 @@ func RecordDatasetMetrics(result MetadataSyncResult, datasetNamespace, datasetNa
 		}
 	}
 }
+
+// ShouldScheduleMetadataSync checks if the metadata of the synced dataset should be re-synced according to the
+// schedule of the policy. The last sync time is the last update time of the MetadataSynced condition of the dataset.
+func ShouldScheduleMetadataSync(policy datav1alpha1.MetadataSyncPolicy, dataset *datav1alpha1.Dataset) (should bool, err error) {
+	if len(policy.Schedule) == 0 {
+		return false, nil
+	}
+
+	schedule, err := utils.ParseCronSchedule(policy.Schedule)
+	if err != nil {
+		return false, err
+	}
+
+	_, cond := utils.GetDatasetCondition(dataset.Status.Conditions, datav1alpha1.DatasetMetadataSynced)
+	if cond == nil {
+		// the dataset was synced without recording the sync time, re-sync it to start the schedule
+		return true, nil
+	}
+
+	next := schedule.Next(cond.LastUpdateTime.Time)
+	return !next.IsZero() && !time.Now().Before(next), nil
+}
+
+// IsScheduledMetadataSync checks if the metadata sync of the dataset is a scheduled re-sync, which means
+// the metadata has been synced once when setting up the runtime.
+func IsScheduledMetadataSync(dataset *datav1alpha1.Dataset, notDoneMsg string) bool {
+	return len(dataset.Status.UfsTotal) != 0 && dataset.Status.UfsTotal != notDoneMsg
+}
+
+// SetMetadataSyncedCondition records the time and the duration of the metadata sync in the MetadataSynced
+// condition of the dataset.
+func SetMetadataSyncedCondition(dataset *datav1alpha1.Dataset, result MetadataSyncResult) {
+	endTime := result.EndTime
+	if endTime.IsZero() {
+		endTime = time.Now()
+	}
+	duration := endTime.Sub(result.StartTime).Round(time.Millisecond)
+
+	var cond datav1alpha1.DatasetCondition
+	if result.Done {
+		cond = utils.NewDatasetCondition(datav1alpha1.DatasetMetadataSynced, datav1alpha1.DatasetMetadataSyncSucceededReason,
+			fmt.Sprintf("Metadata synced in %s", duration), v1.ConditionTrue)
+	} else {
+		cond = utils.NewDatasetCondition(datav1alpha1.DatasetMetadataSynced, datav1alpha1.DatasetMetadataSyncFailedReason,
+			fmt.Sprintf("Metadata sync failed after %s: %v", duration, result.Err), v1.ConditionFalse)
+	}
+	cond.LastUpdateTime = metav1.NewTime(endTime)
+	dataset.Status.Conditions = utils.UpdateDatasetCondition(dataset.Status.Conditions, cond)
+}
+
+// RecordMetadataSyncFailure records the failed metadata sync in the dataset condition and the dataset metrics,
+// so that the scheduled re-sync is retried on the next schedule.
+func RecordMetadataSyncFailure(c client.Client, result MetadataSyncResult, datasetNamespace, datasetName string, log logr.Logger) {
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		dataset, err := utils.GetDataset(c, datasetName, datasetNamespace)
+		if err != nil {
+			return err
+		}
+		datasetToUpdate := dataset.DeepCopy()
+		SetMetadataSyncedCondition(datasetToUpdate, result)
+		return c.Status().Update(context.TODO(), datasetToUpdate)
+	})
+	if err != nil {
+		log.Error(err, "Failed to record the failed metadata sync in the dataset condition")
+	}
+
+	RecordDatasetMetrics(result, datasetNamespace, datasetName, log)
+}
@@ -16,7 +16,16 @@ limitations under the License.
 
 package base
 
-import "testing"
+import (
+	"errors"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
 
 func TestSafeClose(t *testing.T) {
 	var nilCh chan MetadataSyncResult = nil
@@ -109,3 +118,110 @@ func TestSafeSend(t *testing.T) {
 		})
 	}
 }
+
+func TestShouldScheduleMetadataSync(t *testing.T) {
+	syncedDataset := func(lastSyncTime time.Time) *datav1alpha1.Dataset {
+		return &datav1alpha1.Dataset{
+			Status: datav1alpha1.DatasetStatus{
+				UfsTotal: "2Gi",
+				Conditions: []datav1alpha1.DatasetCondition{
+					{
+						Type:           datav1alpha1.DatasetMetadataSynced,
+						Status:         v1.ConditionTrue,
+						LastUpdateTime: metav1.NewTime(lastSyncTime),
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		policy  datav1alpha1.MetadataSyncPolicy
+		dataset *datav1alpha1.Dataset
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:    "no_schedule",
+			dataset: syncedDataset(time.Now().Add(-48 * time.Hour)),
+			want:    false,
+		},
+		{
+			name:    "invalid_schedule",
+			policy:  datav1alpha1.MetadataSyncPolicy{Schedule: "every hour"},
+			dataset: syncedDataset(time.Now()),
+			wantErr: true,
+		},
+		{
+			name:    "no_sync_time",
+			policy:  datav1alpha1.MetadataSyncPolicy{Schedule: "@hourly"},
+			dataset: &datav1alpha1.Dataset{Status: datav1alpha1.DatasetStatus{UfsTotal: "2Gi"}},
+			want:    true,
+		},
+		{
+			name:    "schedule_due",
+			policy:  datav1alpha1.MetadataSyncPolicy{Schedule: "@hourly"},
+			dataset: syncedDataset(time.Now().Add(-2 * time.Hour)),
+			want:    true,
+		},
+		{
+			name:    "schedule_not_due",
+			policy:  datav1alpha1.MetadataSyncPolicy{Schedule: "@daily"},
+			dataset: syncedDataset(time.Now().Add(time.Minute)),
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ShouldScheduleMetadataSync(tt.policy, tt.dataset)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ShouldScheduleMetadataSync() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ShouldScheduleMetadataSync() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetMetadataSyncedCondition(t *testing.T) {
+	startTime := time.Date(2026, time.January, 30, 10, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(90 * time.Second)
+
+	tests := []struct {
+		name       string
+		result     MetadataSyncResult
+		wantStatus v1.ConditionStatus
+		wantReason string
+	}{
+		{
+			name:       "sync_succeeded",
+			result:     MetadataSyncResult{Done: true, StartTime: startTime, EndTime: endTime},
+			wantStatus: v1.ConditionTrue,
+			wantReason: datav1alpha1.DatasetMetadataSyncSucceededReason,
+		},
+		{
+			name:       "sync_failed",
+			result:     MetadataSyncResult{Done: false, StartTime: startTime, EndTime: endTime, Err: errors.New("timeout")},
+			wantStatus: v1.ConditionFalse,
+			wantReason: datav1alpha1.DatasetMetadataSyncFailedReason,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := &datav1alpha1.Dataset{}
+			SetMetadataSyncedCondition(dataset, tt.result)
+			if len(dataset.Status.Conditions) != 1 {
+				t.Fatalf("SetMetadataSyncedCondition() conditions = %v, want one condition", dataset.Status.Conditions)
+			}
+			cond := dataset.Status.Conditions[0]
+			if cond.Type != datav1alpha1.DatasetMetadataSynced || cond.Status != tt.wantStatus || cond.Reason != tt.wantReason {
+				t.Errorf("SetMetadataSyncedCondition() condition = %v, want status %s and reason %s", cond, tt.wantStatus, tt.wantReason)
+			}
+			if !cond.LastUpdateTime.Time.Equal(endTime) {
+				t.Errorf("SetMetadataSyncedCondition() last update time = %v, want %v", cond.LastUpdateTime, endTime)
+			}
+		})
+	}
+}
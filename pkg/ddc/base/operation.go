@@ -160,7 +160,18 @@ func (t *TemplateEngine) reconcileExecuting(ctx cruntime.ReconcileRequestContext
 		log.Error(err, "status handler is nil")
 		return utils.RequeueIfError(err)
 	}
-	opStatusToUpdate, err := statusHandler.GetOperationStatus(ctx, opStatus)
+	// the progress derived from the runtime may be overridden by the status handler with the progress reported by the job
+	opStatusToGet := opStatus
+	if reporter, ok := t.Implement.(DataOperationProgressReporter); ok {
+		progress, err := reporter.GetDataOperationProgress(ctx, operation, opStatus.Progress)
+		if err != nil {
+			log.Error(err, "failed to get progress from the runtime, skip")
+		} else if progress != nil {
+			opStatusToGet = opStatus.DeepCopy()
+			opStatusToGet.Progress = progress
+		}
+	}
+	opStatusToUpdate, err := statusHandler.GetOperationStatus(ctx, opStatusToGet)
 	if err != nil {
 		log.Error(err, "failed to update status")
 		return utils.RequeueIfError(err)
@@ -221,7 +232,8 @@ func (t *TemplateEngine) reconcileComplete(ctx cruntime.ReconcileRequestContext,
 		log.Error(err, "status handler is nil")
 		return utils.RequeueIfError(err)
 	}
-	opStatusToUpdate, err := statusHandler.GetOperationStatus(ctx, opStatus)
+	// the changes of the under file system detected by polling are passed to the status handler to re-trigger the operation
+	opStatusToUpdate, err := statusHandler.GetOperationStatus(ctx, t.detectUFSChanges(ctx, opStatus, operation))
 	if err != nil {
 		log.Error(err, "failed to update status")
 		return utils.RequeueIfError(err)
@@ -246,7 +258,8 @@ func (t *TemplateEngine) reconcileComplete(ctx cruntime.ReconcileRequestContext,
 		if operation.GetParallelTaskNumber() > 1 {
 			releaseNameSpacedName := operation.GetReleaseNameSpacedName()
 			err = kubeclient.ScaleStatefulSet(t.Client, utils.GetParallelOperationWorkersName(releaseNameSpacedName.Name), releaseNameSpacedName.Namespace, 0)
-			if err != nil {
+			// the parallel tasks without workers statefulset, e.g. the sharded DataMigrate, need no scaling
+			if utils.IgnoreNotFound(err) != nil {
 				return utils.RequeueIfError(err)
 			}
 		}
@@ -258,6 +271,11 @@ func (t *TemplateEngine) reconcileComplete(ctx cruntime.ReconcileRequestContext,
 		return utils.RequeueAfterInterval(*ttl)
 	}
 
+	// 6. Requeue to poll the changes of the under file system if the data operation is triggered by events
+	if isWatchingUFSChanges(operation) {
+		return utils.RequeueAfterInterval(getUFSChangePollInterval())
+	}
+
 	return utils.NoRequeue()
 }
 
@@ -310,7 +328,8 @@ func (t *TemplateEngine) reconcileFailed(ctx cruntime.ReconcileRequestContext, o
 		log.Error(err, "status handler is nil")
 		return utils.RequeueIfError(err)
 	}
-	opStatusToUpdate, err := statusHandler.GetOperationStatus(ctx, opStatus)
+	// the changes of the under file system detected by polling are passed to the status handler to re-trigger the operation
+	opStatusToUpdate, err := statusHandler.GetOperationStatus(ctx, t.detectUFSChanges(ctx, opStatus, operation))
 	if err != nil {
 		log.Error(err, "failed to update status")
 		return utils.RequeueIfError(err)
@@ -334,7 +353,8 @@ func (t *TemplateEngine) reconcileFailed(ctx cruntime.ReconcileRequestContext, o
 		if operation.GetParallelTaskNumber() > 1 {
 			releaseNameSpacedName := operation.GetReleaseNameSpacedName()
 			err = kubeclient.ScaleStatefulSet(t.Client, utils.GetParallelOperationWorkersName(releaseNameSpacedName.Name), releaseNameSpacedName.Namespace, 0)
-			if err != nil {
+			// the parallel tasks without workers statefulset, e.g. the sharded DataMigrate, need no scaling
+			if utils.IgnoreNotFound(err) != nil {
 				return utils.RequeueIfError(err)
 			}
 		}
@@ -345,5 +365,10 @@ func (t *TemplateEngine) reconcileFailed(ctx cruntime.ReconcileRequestContext, o
 		log.V(1).Info("get remaining time to clean up data operation", "timeToLive", ttl)
 		return utils.RequeueAfterInterval(*ttl)
 	}
+
+	// 4. Requeue to poll the changes of the under file system if the data operation is triggered by events
+	if isWatchingUFSChanges(operation) {
+		return utils.RequeueAfterInterval(getUFSChangePollInterval())
+	}
 	return utils.NoRequeue()
 }
@@ -21,6 +21,7 @@ import (
 
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
 )
 
@@ -54,6 +55,10 @@ func InstallDataOperationHelmIfNotExist(ctx cruntime.ReconcileRequestContext, op
 			chartName = operation.GetChartsDirectory() + "/" + "common"
 		} else {
 			chartName = operation.GetChartsDirectory() + "/" + ctx.EngineImpl
+			// for DataMigrate, the engines without their own chart share the chart of the generic migrator
+			if operation.GetOperationType() == dataoperation.DataMigrateType && !utils.PathExists(chartName) {
+				chartName = operation.GetChartsDirectory() + "/" + "common"
+			}
 		}
 
 		err = helm.InstallRelease(releaseNamespacedName.Name, releaseNamespacedName.Namespace, valueFileName, chartName)
@@ -0,0 +1,113 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"errors"
+	"path"
+	"time"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/ufschange"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+const defaultUFSChangePollInterval = 5 * time.Minute
+
+var (
+	errUFSListingNotSupported = errors.New("the engine does not support listing the under file system")
+
+	// the root of a dataset may hold a huge number of files, e.g. a whole bucket, which is too costly to list
+	// on every reconciliation
+	errRootListingRefused = errors.New("listing the root of the dataset is refused, set the target paths to watch")
+)
+
+func getUFSChangePollInterval() time.Duration {
+	return utils.GetDurationValueFromEnv(common.EnvUFSChangePollInterval, defaultUFSChangePollInterval)
+}
+
+func isWatchingUFSChanges(operation dataoperation.OperationInterface) bool {
+	watcher, ok := operation.(dataoperation.UFSChangeWatcher)
+	return ok && len(watcher.GetWatchedPaths()) > 0
+}
+
+// detectUFSChanges takes a snapshot of the under file system for the data operations triggered by events, and records
+// the paths changed since the last snapshot as pending changes. The original status is returned if nothing changes or
+// the snapshot fails, failing to detect changes does not block the status update of the data operation.
+func (t *TemplateEngine) detectUFSChanges(ctx cruntime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus,
+	operation dataoperation.OperationInterface) *datav1alpha1.OperationStatus {
+	watcher, ok := operation.(dataoperation.UFSChangeWatcher)
+	if !ok {
+		return opStatus
+	}
+	paths := watcher.GetWatchedPaths()
+	if len(paths) == 0 {
+		return opStatus
+	}
+	log := ctx.Log.WithName("detectUFSChanges")
+
+	snapshot, err := t.takeUFSSnapshot(paths)
+	if errors.Is(err, errUFSListingNotSupported) || errors.Is(err, errRootListingRefused) {
+		log.V(1).Info("skip polling the changes of the under file system, only the ufs events are handled", "reason", err.Error())
+		return opStatus
+	}
+	if err != nil {
+		log.Error(err, "failed to take snapshot of the under file system, skip")
+		return opStatus
+	}
+	previous, err := ufschange.ParseSnapshot(opStatus.Infos[ufschange.SnapshotInfoKey])
+	if err != nil {
+		log.Error(err, "failed to parse the last snapshot of the under file system, take a new one")
+	}
+
+	result := opStatus.DeepCopy()
+	if result.Infos == nil {
+		result.Infos = map[string]string{}
+	}
+	result.Infos[ufschange.SnapshotInfoKey] = snapshot.String()
+	if changedPaths := snapshot.Diff(previous, paths); len(changedPaths) > 0 {
+		log.Info("detected changes of the under file system", "changedPaths", changedPaths)
+		ufschange.AddPendingChangedPaths(result, changedPaths)
+	}
+	return result
+}
+
+// takeUFSSnapshot checksums the recursive listings of the watched paths, nothing out of the paths is listed.
+func (t *TemplateEngine) takeUFSSnapshot(paths []string) (*ufschange.Snapshot, error) {
+	lister, ok := t.Implement.(UFSLister)
+	if !ok {
+		return nil, errUFSListingNotSupported
+	}
+	for _, p := range paths {
+		if path.Clean("/"+p) == "/" {
+			return nil, errRootListingRefused
+		}
+	}
+
+	snapshot := &ufschange.Snapshot{Checksums: make(map[string]string, len(paths))}
+	for _, p := range paths {
+		listing, err := lister.ListUFS(p)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Checksums[p] = ufschange.Checksum(listing)
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"reflect"
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/ufschange"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+type fakeUFSImplement struct {
+	Implement
+	listings map[string]string
+}
+
+func (f *fakeUFSImplement) ListUFS(path string) (string, error) {
+	return f.listings[path], nil
+}
+
+type fakeWatchingOperation struct {
+	dataoperation.OperationInterface
+	watchedPaths []string
+}
+
+func (f *fakeWatchingOperation) GetWatchedPaths() []string {
+	return f.watchedPaths
+}
+
+func TestDetectUFSChanges(t *testing.T) {
+	impl := &fakeUFSImplement{
+		listings: map[string]string{"/a": "a.csv", "/b": "b.csv"},
+	}
+	engine := &TemplateEngine{Implement: impl}
+	ctx := cruntime.ReconcileRequestContext{Log: fake.NullLogger()}
+	operation := &fakeWatchingOperation{watchedPaths: []string{"/a", "/b"}}
+
+	// the first snapshot is taken as the baseline
+	opStatus := &datav1alpha1.OperationStatus{}
+	result := engine.detectUFSChanges(ctx, opStatus, operation)
+	if len(result.Infos[ufschange.SnapshotInfoKey]) == 0 {
+		t.Fatalf("expect the snapshot to be recorded, got %v", result.Infos)
+	}
+	if len(result.Infos[ufschange.PendingChangedPathsInfoKey]) > 0 {
+		t.Errorf("expect no pending changes for the first snapshot, got %v", result.Infos)
+	}
+
+	// nothing changes
+	if again := engine.detectUFSChanges(ctx, result, operation); !reflect.DeepEqual(again, result) {
+		t.Errorf("expect the status not to change, got %v", again.Infos)
+	}
+
+	// only the changed path is pending
+	impl.listings["/b"] = "b.csv\nc.csv"
+	changed := engine.detectUFSChanges(ctx, result, operation)
+	if got := changed.Infos[ufschange.PendingChangedPathsInfoKey]; got != `["/b"]` {
+		t.Errorf("expect pending changed paths [\"/b\"], got %s", got)
+	}
+
+	// the operations not triggered by events are skipped
+	if skipped := engine.detectUFSChanges(ctx, opStatus, &fakeWatchingOperation{}); skipped != opStatus {
+		t.Errorf("expect the status not to change for the operation without watched paths")
+	}
+
+	// the root of the dataset is not listed
+	impl.listings["/"] = "a.csv\nb.csv"
+	if skipped := engine.detectUFSChanges(ctx, opStatus, &fakeWatchingOperation{watchedPaths: []string{"/a", "/"}}); skipped != opStatus {
+		t.Errorf("expect the status not to change for the operation watching the root of the dataset")
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// NewRenderCommand builds the render subcommand shared by the runtime controllers, e.g. alluxioruntime-controller render.
+// The flags specific to a runtime, e.g. the port range, are added to the returned command by its controller.
+func NewRenderCommand(opts *Options) *cobra.Command {
+	var verbose bool
+
+	cmd := &cobra.Command{
+		Use:     "render",
+		Short:   fmt.Sprintf("render the helm values and manifests of %sruntime offline without touching Kubernetes", opts.RuntimeType),
+		Example: fmt.Sprintf("  %sruntime-controller render -f dataset.yaml -f runtime.yaml --charts-dir ./charts", opts.RuntimeType),
+		// the errors are caused by the files to render rather than the usage of the command
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctrl.SetLogger(NewLogger(verbose))
+			return Render(*opts, cmd.OutOrStdout())
+		},
+	}
+
+	cmd.Flags().StringArrayVarP(&opts.Files, "file", "f", nil, "The yaml files of the dataset, the runtime and the objects referred by them, e.g. secrets")
+	cmd.Flags().StringVar(&opts.ChartsDir, "charts-dir", "", "The directory of the charts, the charts in the controller image are used by default")
+	cmd.Flags().StringVarP(&opts.Output, "output", "o", OutputAll, "What to print, available choice is all, values or manifests")
+	cmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "Print the logs of the transformation")
+	return cmd
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"go.uber.org/zap/zapcore"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/net"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base/portallocator"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
+)
+
+const (
+	// OutputAll prints both the helm values and the rendered manifests
+	OutputAll = "all"
+	// OutputValues prints the helm values only
+	OutputValues = "values"
+	// OutputManifests prints the rendered manifests only
+	OutputManifests = "manifests"
+
+	defaultNamespace = "default"
+)
+
+// clusterScopedKinds are the kinds of the cluster scoped objects which may be referred by the runtime
+var clusterScopedKinds = map[string]bool{
+	"Namespace":        true,
+	"Node":             true,
+	"PersistentVolume": true,
+	"StorageClass":     true,
+	// ThinRuntimeProfile is the only cluster scoped kind of fluid
+	"ThinRuntimeProfile": true,
+}
+
+// Options are the options to render the runtime offline
+type Options struct {
+	// Files are the yaml files of the dataset, the runtime and the objects referred by them,
+	// e.g. the secrets of the encrypt options or the ThinRuntimeProfile.
+	Files []string
+	// Runtime is an empty object of the runtime handled by the controller, e.g. &datav1alpha1.AlluxioRuntime{}
+	Runtime     client.Object
+	RuntimeType string
+	EngineImpl  string
+	Output      string
+	// ChartsDir overrides the directory of the charts, e.g. the charts in the source tree
+	ChartsDir string
+	// PortRange is the range of the ports allocated to the runtime using host network, e.g. 20000-25000
+	PortRange string
+	// GetReservedPorts is set for the runtimes which allocate ports from the port allocator
+	GetReservedPorts func(client client.Client) (ports []int, err error)
+}
+
+// Render transforms the runtime in the files into the helm values with a fake client, and renders the manifests
+// of the runtime chart without touching any cluster.
+func Render(opts Options, out io.Writer) (err error) {
+	if opts.Output != OutputAll && opts.Output != OutputValues && opts.Output != OutputManifests {
+		return fmt.Errorf("output %s is not supported, it must be one of %s, %s and %s", opts.Output, OutputAll, OutputValues, OutputManifests)
+	}
+
+	if len(opts.ChartsDir) > 0 {
+		utils.SetChartsDirectory(opts.ChartsDir)
+	}
+
+	s := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(s)
+	_ = datav1alpha1.AddToScheme(s)
+
+	objs, err := loadObjects(s, opts.Files)
+	if err != nil {
+		return err
+	}
+	runtimeObj, err := findRuntime(objs, opts.Runtime)
+	if err != nil {
+		return err
+	}
+	key := types.NamespacedName{Name: runtimeObj.GetName(), Namespace: runtimeObj.GetNamespace()}
+	if !hasDataset(objs, key) {
+		return fmt.Errorf("dataset %s is not found in the files, the dataset should have the same name and namespace as the runtime", key)
+	}
+
+	c := fake.NewFakeClientWithScheme(s, objs...)
+	if opts.GetReservedPorts != nil {
+		pr, err := net.ParsePortRange(opts.PortRange)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse port range %s", opts.PortRange)
+		}
+		portallocator.SetupRuntimePortAllocatorWithType(c, pr, portallocator.Random, opts.GetReservedPorts)
+	}
+
+	ctx := cruntime.ReconcileRequestContext{
+		Context:        context.TODO(),
+		Log:            ctrl.Log.WithName("dryrun").WithValues(opts.RuntimeType, key),
+		NamespacedName: key,
+		Recorder:       record.NewFakeRecorder(100),
+		Category:       common.AccelerateCategory,
+		RuntimeType:    opts.RuntimeType,
+		EngineImpl:     opts.EngineImpl,
+		Client:         c,
+		Runtime:        runtimeObj,
+	}
+	engine, err := ddc.CreateEngine(ddc.GenerateEngineID(key), ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to build engine %s for runtime %s", opts.EngineImpl, key)
+	}
+
+	templateEngine, ok := engine.(*base.TemplateEngine)
+	if !ok {
+		return fmt.Errorf("engine %s doesn't support rendering the runtime", opts.EngineImpl)
+	}
+	generator, ok := templateEngine.Implement.(base.RuntimeValueGenerator)
+	if !ok {
+		return fmt.Errorf("engine %s doesn't support rendering the runtime", opts.EngineImpl)
+	}
+
+	valueFileName, chartName, err := generator.GenerateRuntimeValueFile()
+	if err != nil {
+		return errors.Wrapf(err, "failed to transform runtime %s", key)
+	}
+	defer func() {
+		_ = os.Remove(valueFileName)
+	}()
+
+	if opts.Output != OutputManifests {
+		values, err := os.ReadFile(valueFileName)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "---\n# Values of chart %s\n%s", chartName, values)
+	}
+
+	if opts.Output != OutputValues {
+		manifests, err := helm.RenderRelease(key.Name, key.Namespace, valueFileName, chartName)
+		if err != nil {
+			return errors.Wrapf(err, "failed to render chart %s", chartName)
+		}
+		fmt.Fprint(out, manifests)
+	}
+	return nil
+}
+
+// loadObjects decodes the yaml documents of the files into the typed objects, the namespace of the namespaced objects
+// is defaulted if it's not set.
+func loadObjects(s *runtime.Scheme, files []string) (objs []runtime.Object, err error) {
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no file is specified, the dataset and the runtime are required")
+	}
+
+	decoder := serializer.NewCodecFactory(s).UniversalDeserializer()
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+		for {
+			doc, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to read %s", file)
+			}
+			if len(bytes.TrimSpace(doc)) == 0 {
+				continue
+			}
+
+			obj, gvk, err := decoder.Decode(doc, nil, nil)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to decode object in %s", file)
+			}
+			clientObj, ok := obj.(client.Object)
+			if !ok {
+				return nil, fmt.Errorf("object %v in %s is not supported", obj.GetObjectKind().GroupVersionKind(), file)
+			}
+			if len(clientObj.GetNamespace()) == 0 && !clusterScopedKinds[gvk.Kind] {
+				clientObj.SetNamespace(defaultNamespace)
+			}
+			objs = append(objs, clientObj)
+		}
+	}
+	return objs, nil
+}
+
+func findRuntime(objs []runtime.Object, runtimeType client.Object) (client.Object, error) {
+	var found []client.Object
+	for _, obj := range objs {
+		if reflect.TypeOf(obj) == reflect.TypeOf(runtimeType) {
+			found = append(found, obj.(client.Object))
+		}
+	}
+	if len(found) != 1 {
+		return nil, fmt.Errorf("expect exactly one %s in the files, but got %d", reflect.TypeOf(runtimeType).Elem().Name(), len(found))
+	}
+	return found[0], nil
+}
+
+func hasDataset(objs []runtime.Object, key types.NamespacedName) bool {
+	for _, obj := range objs {
+		if dataset, ok := obj.(*datav1alpha1.Dataset); ok && dataset.Name == key.Name && dataset.Namespace == key.Namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// NewLogger returns the logger writing to stderr so that the rendered output is not mixed with the logs,
+// only the errors are logged unless verbose is set.
+func NewLogger(verbose bool) logr.Logger {
+	level := zapcore.ErrorLevel
+	if verbose {
+		level = zapcore.DebugLevel
+	}
+	return zap.New(zap.WriteTo(os.Stderr), zap.Level(level), zap.StacktraceLevel(zapcore.PanicLevel), zap.ConsoleEncoder())
+}
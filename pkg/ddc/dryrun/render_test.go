@@ -0,0 +1,213 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/alluxio"
+)
+
+const (
+	testDataset = `apiVersion: data.fluid.io/v1alpha1
+kind: Dataset
+metadata:
+  name: hbase
+spec:
+  mounts:
+  - mountPoint: https://mirrors.bit.edu.cn/apache/hbase/stable/
+    name: hbase
+`
+	testAlluxioRuntime = `apiVersion: data.fluid.io/v1alpha1
+kind: AlluxioRuntime
+metadata:
+  name: hbase
+spec:
+  replicas: 2
+  tieredstore:
+    levels:
+    - mediumtype: MEM
+      path: /dev/shm
+      quota: 2Gi
+`
+	testThinRuntime = `apiVersion: data.fluid.io/v1alpha1
+kind: ThinRuntimeProfile
+metadata:
+  name: nfs
+spec:
+  fileSystemType: nfs
+  fuse:
+    image: fluid/nfs
+    imageTag: v1
+---
+apiVersion: data.fluid.io/v1alpha1
+kind: ThinRuntime
+metadata:
+  name: hbase
+spec:
+  profileName: nfs
+`
+)
+
+func writeTestFile(t *testing.T, content string) string {
+	file := filepath.Join(t.TempDir(), "objects.yaml")
+	if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func alluxioOptions(files ...string) Options {
+	return Options{
+		Files:            files,
+		Runtime:          &datav1alpha1.AlluxioRuntime{},
+		RuntimeType:      common.AlluxioRuntime,
+		EngineImpl:       common.AlluxioEngineImpl,
+		ChartsDir:        "../../../charts",
+		Output:           OutputAll,
+		PortRange:        "20000-25000",
+		GetReservedPorts: alluxio.GetReservedPorts,
+	}
+}
+
+func TestRender(t *testing.T) {
+	var out bytes.Buffer
+	err := Render(alluxioOptions(writeTestFile(t, testDataset+"---\n"+testAlluxioRuntime)), &out)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	for _, want := range []string{
+		"# Values of chart ../../../charts/alluxio",
+		"fullnameOverride: hbase",
+		"# Source: alluxio/templates/master/statefulset.yaml",
+		"name: hbase-worker",
+		"namespace: default",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("Render() = %s, want to contain %q", out.String(), want)
+		}
+	}
+
+	out.Reset()
+	opts := alluxioOptions(writeTestFile(t, testDataset), writeTestFile(t, testAlluxioRuntime))
+	opts.Output = OutputValues
+	if err = Render(opts, &out); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if strings.Contains(out.String(), "# Source:") {
+		t.Errorf("Render() = %s, want the values only", out.String())
+	}
+}
+
+func TestRenderThinRuntimeWithProfile(t *testing.T) {
+	var out bytes.Buffer
+	err := Render(Options{
+		Files:       []string{writeTestFile(t, testDataset+"---\n"+testThinRuntime)},
+		Runtime:     &datav1alpha1.ThinRuntime{},
+		RuntimeType: common.ThinRuntime,
+		EngineImpl:  common.ThinEngineImpl,
+		ChartsDir:   "../../../charts",
+		Output:      OutputManifests,
+	}, &out)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if !strings.Contains(out.String(), "image: fluid/nfs:v1") || strings.Contains(out.String(), "# Values of chart") {
+		t.Errorf("Render() = %s, want the manifests with the fuse image of the profile", out.String())
+	}
+}
+
+func TestRenderWithErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		files string
+		opts  func(opts *Options)
+	}{
+		{
+			name:  "dataset_not_found",
+			files: testAlluxioRuntime,
+		},
+		{
+			name:  "runtime_not_found",
+			files: testDataset,
+		},
+		{
+			name:  "multiple_runtimes",
+			files: testDataset + "---\n" + testAlluxioRuntime + "---\n" + strings.Replace(testAlluxioRuntime, "name: hbase", "name: spark", 1),
+		},
+		{
+			name:  "invalid_output",
+			files: testDataset + "---\n" + testAlluxioRuntime,
+			opts:  func(opts *Options) { opts.Output = "json" },
+		},
+		{
+			name:  "invalid_port_range",
+			files: testDataset + "---\n" + testAlluxioRuntime,
+			opts:  func(opts *Options) { opts.PortRange = "20000" },
+		},
+		{
+			name:  "unknown_kind",
+			files: "apiVersion: data.fluid.io/v1alpha1\nkind: Unknown\nmetadata:\n  name: hbase\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := alluxioOptions(writeTestFile(t, tt.files))
+			if tt.opts != nil {
+				tt.opts(&opts)
+			}
+			if err := Render(opts, &bytes.Buffer{}); err == nil {
+				t.Errorf("Render() is expected to fail")
+			}
+		})
+	}
+
+	if err := Render(alluxioOptions(), &bytes.Buffer{}); err == nil {
+		t.Errorf("Render() is expected to fail without files")
+	}
+}
+
+func TestNewRenderCommand(t *testing.T) {
+	opts := alluxioOptions()
+	cmd := NewRenderCommand(&opts)
+
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"-f", writeTestFile(t, testDataset+"---\n"+testAlluxioRuntime), "-o", OutputManifests})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !strings.Contains(out.String(), "name: hbase-worker") {
+		t.Errorf("Execute() = %s, want the rendered manifests", out.String())
+	}
+
+	opts = alluxioOptions()
+	cmd = NewRenderCommand(&opts)
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"-f", writeTestFile(t, testDataset), "-o", OutputManifests})
+	if err := cmd.Execute(); err == nil {
+		t.Errorf("Execute() is expected to return the error of rendering")
+	}
+}
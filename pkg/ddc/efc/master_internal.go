@@ -30,16 +30,7 @@ import (
 
 // setup the cache master
 func (e *EFCEngine) setupMasterInternal() (err error) {
-	var (
-		chartName = utils.GetChartsDirectory() + "/" + common.EFCChart
-	)
-
-	runtime, err := e.getRuntime()
-	if err != nil {
-		return
-	}
-
-	valuefileName, err := e.generateEFCValueFile(runtime)
+	valuefileName, chartName, err := e.GenerateRuntimeValueFile()
 	if err != nil {
 		return
 	}
@@ -57,6 +48,19 @@ func (e *EFCEngine) setupMasterInternal() (err error) {
 	return helm.InstallRelease(e.name, e.namespace, valuefileName, chartName)
 }
 
+// GenerateRuntimeValueFile transforms the runtime into the value file of the runtime chart
+func (e *EFCEngine) GenerateRuntimeValueFile() (valueFileName string, chartName string, err error) {
+	chartName = utils.GetChartsDirectory() + "/" + common.EFCChart
+
+	runtime, err := e.getRuntime()
+	if err != nil {
+		return
+	}
+
+	valueFileName, err = e.generateEFCValueFile(runtime)
+	return
+}
+
 // generate efc struct
 func (e *EFCEngine) generateEFCValueFile(runtime *datav1alpha1.EFCRuntime) (valueFileName string, err error) {
 
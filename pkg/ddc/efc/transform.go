@@ -111,6 +111,9 @@ func (e *EFCEngine) transformMasters(runtime *datav1alpha1.EFCRuntime,
 		value.Master.NodeSelector = runtime.Spec.Master.NodeSelector
 	}
 
+	// scheduling
+	value.Master.Scheduling = utils.TransformSchedulingSpec(runtime.Spec.Master.SchedulingSpec)
+
 	// tiered store
 	err = e.transformMasterTieredStore(runtime, value)
 	if err != nil {
@@ -160,6 +163,9 @@ func (e *EFCEngine) transformWorkers(runtime *datav1alpha1.EFCRuntime,
 		value.Worker.NodeSelector = runtime.Spec.Worker.NodeSelector
 	}
 
+	// scheduling
+	value.Worker.Scheduling = utils.TransformSchedulingSpec(runtime.Spec.Worker.SchedulingSpec)
+
 	// tiered store
 	err = e.transformWorkerTieredStore(runtime, value)
 	if err != nil {
@@ -219,6 +225,9 @@ func (e *EFCEngine) transformFuse(runtime *datav1alpha1.EFCRuntime,
 	// The label will be added by CSI Plugin when any workload pod is scheduled on the node.
 	value.Fuse.NodeSelector[utils.GetFuseLabelName(runtime.Namespace, runtime.Name, e.runtimeInfo.GetOwnerDatasetUID())] = "true"
 
+	// scheduling
+	value.Fuse.Scheduling = utils.TransformSchedulingSpec(runtime.Spec.Fuse.SchedulingSpec)
+
 	// tiered store
 	err = e.transformFuseTieredStore(runtime, value)
 	if err != nil {
@@ -46,44 +46,47 @@ type OSAdvise struct {
 
 type Master struct {
 	common.ImageInfo `yaml:",inline"`
-	MountPoint       string            `yaml:"mountPoint,omitempty"`
-	Replicas         int32             `yaml:"count,omitempty"`
-	Enabled          bool              `yaml:"enabled"`
-	Options          string            `yaml:"option,omitempty"`
-	Resources        common.Resources  `yaml:"resources,omitempty"`
-	NodeSelector     map[string]string `yaml:"nodeSelector,omitempty"`
-	HostNetwork      bool              `yaml:"hostNetwork"`
-	TieredStore      TieredStore       `yaml:"tieredstore,omitempty"`
-	Labels           map[string]string `yaml:"labels,omitempty"`
-	Annotations      map[string]string `yaml:"annotations,omitempty"`
+	MountPoint       string             `yaml:"mountPoint,omitempty"`
+	Replicas         int32              `yaml:"count,omitempty"`
+	Enabled          bool               `yaml:"enabled"`
+	Options          string             `yaml:"option,omitempty"`
+	Resources        common.Resources   `yaml:"resources,omitempty"`
+	NodeSelector     map[string]string  `yaml:"nodeSelector,omitempty"`
+	Scheduling       *common.Scheduling `yaml:"scheduling,omitempty"`
+	HostNetwork      bool               `yaml:"hostNetwork"`
+	TieredStore      TieredStore        `yaml:"tieredstore,omitempty"`
+	Labels           map[string]string  `yaml:"labels,omitempty"`
+	Annotations      map[string]string  `yaml:"annotations,omitempty"`
 }
 
 type Worker struct {
 	common.ImageInfo `yaml:",inline"`
-	Port             Port              `yaml:"port,omitempty"`
-	Enabled          bool              `yaml:"enabled"`
-	Options          string            `yaml:"option,omitempty"`
-	Resources        common.Resources  `yaml:"resources,omitempty"`
-	NodeSelector     map[string]string `yaml:"nodeSelector,omitempty"`
-	HostNetwork      bool              `yaml:"hostNetwork"`
-	TieredStore      TieredStore       `yaml:"tieredstore,omitempty"`
-	Labels           map[string]string `yaml:"labels,omitempty"`
-	Annotations      map[string]string `yaml:"annotations,omitempty"`
+	Port             Port               `yaml:"port,omitempty"`
+	Enabled          bool               `yaml:"enabled"`
+	Options          string             `yaml:"option,omitempty"`
+	Resources        common.Resources   `yaml:"resources,omitempty"`
+	NodeSelector     map[string]string  `yaml:"nodeSelector,omitempty"`
+	Scheduling       *common.Scheduling `yaml:"scheduling,omitempty"`
+	HostNetwork      bool               `yaml:"hostNetwork"`
+	TieredStore      TieredStore        `yaml:"tieredstore,omitempty"`
+	Labels           map[string]string  `yaml:"labels,omitempty"`
+	Annotations      map[string]string  `yaml:"annotations,omitempty"`
 }
 
 type Fuse struct {
 	common.ImageInfo `yaml:",inline"`
-	MountPoint       string            `yaml:"mountPoint,omitempty"`
-	HostMountPath    string            `yaml:"hostMountPath,omitempty"`
-	Port             Port              `yaml:"port,omitempty"`
-	Options          string            `yaml:"option,omitempty"`
-	Resources        common.Resources  `yaml:"resources,omitempty"`
-	NodeSelector     map[string]string `yaml:"nodeSelector,omitempty"`
-	HostNetwork      bool              `yaml:"hostNetwork"`
-	TieredStore      TieredStore       `yaml:"tieredstore,omitempty"`
-	CriticalPod      bool              `yaml:"criticalPod"`
-	Labels           map[string]string `yaml:"labels,omitempty"`
-	Annotations      map[string]string `yaml:"annotations,omitempty"`
+	MountPoint       string             `yaml:"mountPoint,omitempty"`
+	HostMountPath    string             `yaml:"hostMountPath,omitempty"`
+	Port             Port               `yaml:"port,omitempty"`
+	Options          string             `yaml:"option,omitempty"`
+	Resources        common.Resources   `yaml:"resources,omitempty"`
+	NodeSelector     map[string]string  `yaml:"nodeSelector,omitempty"`
+	Scheduling       *common.Scheduling `yaml:"scheduling,omitempty"`
+	HostNetwork      bool               `yaml:"hostNetwork"`
+	TieredStore      TieredStore        `yaml:"tieredstore,omitempty"`
+	CriticalPod      bool               `yaml:"criticalPod"`
+	Labels           map[string]string  `yaml:"labels,omitempty"`
+	Annotations      map[string]string  `yaml:"annotations,omitempty"`
 }
 
 type InitFuse struct {
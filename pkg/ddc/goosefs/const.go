@@ -54,3 +54,15 @@ const (
 
 	WokrerPodRole = "goosefs-worker"
 )
+
+const (
+	masterContainerName = "goosefs-master"
+	workerContainerName = "goosefs-worker"
+	fuseContainerName   = "goosefs-fuse"
+
+	// the keys of the java options in the config configmap rendered by the helm chart
+	javaOptsKey       = "GOOSEFS_JAVA_OPTS"
+	masterJavaOptsKey = "GOOSEFS_MASTER_JAVA_OPTS"
+	workerJavaOptsKey = "GOOSEFS_WORKER_JAVA_OPTS"
+	fuseJavaOptsKey   = "GOOSEFS_FUSE_JAVA_OPTS"
+)
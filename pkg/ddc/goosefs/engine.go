@@ -90,7 +90,7 @@ func Build(id string, ctx cruntime.ReconcileRequestContext) (base.Engine, error)
 	engine.runtimeInfo = runtimeInfo
 
 	// Build the helper
-	engine.Helper = ctrl.BuildHelper(runtimeInfo, ctx.Client, engine.Log)
+	engine.Helper = ctrl.BuildHelper(runtimeInfo, ctx.Client, engine.Log).WithWorkerDrainer(engine)
 
 	template := base.NewTemplateEngine(engine, id, ctx)
 
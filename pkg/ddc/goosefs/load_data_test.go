@@ -29,6 +29,7 @@ import (
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/goosefs/operations"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
@@ -544,3 +545,38 @@ func TestCheckRuntimeReady(t *testing.T) {
 		t.Errorf("fail to exec the function CheckRuntimeReady")
 	}
 }
+
+func TestGetDataLoadProgress(t *testing.T) {
+	engine := GooseFSEngine{
+		namespace: "fluid",
+		name:      "hbase",
+		Log:       fake.NullLogger(),
+	}
+	dataLoad := &datav1alpha1.DataLoad{}
+
+	patches := gomonkey.ApplyMethodFunc(operations.GooseFSFileUtils{}, "Du", func(goosefsPath string) (int64, int64, string, error) {
+		return 4096, 1024, "25%", nil
+	})
+	defer patches.Reset()
+	patches.ApplyMethodFunc(operations.GooseFSFileUtils{}, "Count", func(goosefsPath string) (int64, int64, int64, error) {
+		return 4, 1, 4096, nil
+	})
+
+	progress, err := engine.getDataLoadProgress(dataLoad, nil)
+	if err != nil {
+		t.Fatalf("fail to get dataload progress: %v", err)
+	}
+	want := []datav1alpha1.TargetPathProgress{{Path: "/", LoadedBytes: 1024, TotalBytes: 4096, TotalFiles: 4}}
+	if !reflect.DeepEqual(progress, want) {
+		t.Errorf("expect progress %v, got %v", want, progress)
+	}
+
+	patches.ApplyMethodFunc(operations.GooseFSFileUtils{}, "Du", func(goosefsPath string) (int64, int64, string, error) {
+		return 0, 0, "", errors.New("du failed")
+	})
+	// the du result of hbase is cached, use another runtime to run du again
+	engine.name = "spark"
+	if _, err = engine.getDataLoadProgress(dataLoad, nil); err == nil {
+		t.Errorf("expect error when failing to du the target path")
+	}
+}
@@ -30,16 +30,7 @@ import (
 
 // setup the cache master
 func (e *GooseFSEngine) setupMasterInternal() (err error) {
-	var (
-		chartName = utils.GetChartsDirectory() + "/" + common.GooseFSChart
-	)
-
-	runtime, err := e.getRuntime()
-	if err != nil {
-		return
-	}
-
-	valuefileName, err := e.generateGooseFSValueFile(runtime)
+	valuefileName, chartName, err := e.GenerateRuntimeValueFile()
 	if err != nil {
 		return
 	}
@@ -57,6 +48,19 @@ func (e *GooseFSEngine) setupMasterInternal() (err error) {
 	return helm.InstallRelease(e.name, e.namespace, valuefileName, chartName)
 }
 
+// GenerateRuntimeValueFile transforms the runtime into the value file of the runtime chart
+func (e *GooseFSEngine) GenerateRuntimeValueFile() (valueFileName string, chartName string, err error) {
+	chartName = utils.GetChartsDirectory() + "/" + common.GooseFSChart
+
+	runtime, err := e.getRuntime()
+	if err != nil {
+		return
+	}
+
+	valueFileName, err = e.generateGooseFSValueFile(runtime)
+	return
+}
+
 // generate goosefs struct
 // generateGooseFSValueFile generates the GooseFS values file for the Helm chart.
 // It first deletes any existing ConfigMap for Helm values, then transforms the runtime
@@ -116,3 +120,7 @@ func (e *GooseFSEngine) generateGooseFSValueFile(runtime *datav1alpha1.GooseFSRu
 func (e *GooseFSEngine) getHelmValuesConfigMapName() string {
 	return e.name + "-" + e.engineImpl + "-values"
 }
+
+func (e *GooseFSEngine) getConfigmapName() string {
+	return e.name + "-config"
+}
@@ -24,6 +24,7 @@ import (
 	"strconv"
 	"time"
 
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/goosefs/operations"
@@ -68,15 +69,28 @@ func (e *GooseFSEngine) shouldSyncMetadata() (should bool, err error) {
 		return should, err
 	}
 
-	//todo(xuzhihao): option to enable/disable automatic metadata sync
-	//todo: periodical metadata sync
-	if dataset.Status.UfsTotal != "" && dataset.Status.UfsTotal != MetadataSyncNotDoneMsg {
+	runtime, err := e.getRuntime()
+	if err != nil {
+		should = false
+		return should, err
+	}
+
+	if !runtime.Spec.MetadataSyncPolicy.AutoSyncEnabled() {
+		e.Log.V(1).Info("Skip syncing metadata cause runtime.Spec.MetadataSyncPolicy.AutoSync=false", "runtime name", runtime.Name, "runtime namespace", runtime.Namespace)
+		should = false
+		return should, nil
+	}
+
+	if base.IsScheduledMetadataSync(dataset, MetadataSyncNotDoneMsg) {
 		e.Log.V(1).Info("dataset ufs is ready",
 			"dataset name", dataset.Name,
 			"dataset namespace", dataset.Namespace,
 			"ufstotal", dataset.Status.UfsTotal)
-		should = false
-		return should, nil
+		// keep waiting for the result of the scheduled metadata sync in progress
+		if e.MetadataSyncDoneCh != nil {
+			return true, nil
+		}
+		return base.ShouldScheduleMetadataSync(runtime.Spec.MetadataSyncPolicy, dataset)
 	}
 	should = true
 	return should, nil
@@ -88,7 +102,8 @@ func (e *GooseFSEngine) shouldRestoreMetadata() (should bool, err error) {
 	if err != nil {
 		return
 	}
-	if dataset.Spec.DataRestoreLocation != nil {
+	// the metadata is only restored when setting up the runtime
+	if dataset.Spec.DataRestoreLocation != nil && !base.IsScheduledMetadataSync(dataset, MetadataSyncNotDoneMsg) {
 		e.Log.V(1).Info("restore metadata of dataset from backup",
 			"dataset name", dataset.Name,
 			"dataset namespace", dataset.Namespace,
@@ -190,11 +205,14 @@ func (e *GooseFSEngine) syncMetadataInternal() (err error) {
 					datasetToUpdate := dataset.DeepCopy()
 					datasetToUpdate.Status.UfsTotal = result.UfsTotal
 					datasetToUpdate.Status.FileNum = result.FileNum
+					base.SetMetadataSyncedCondition(datasetToUpdate, result)
 					if !reflect.DeepEqual(datasetToUpdate, dataset) {
 						err = e.Client.Status().Update(context.TODO(), datasetToUpdate)
 						if err != nil {
 							return
 						}
+						// Update dataset metrics after a successful status update
+						base.RecordDatasetMetrics(result, datasetToUpdate.Namespace, datasetToUpdate.Name, e.Log)
 					}
 					return
 				})
@@ -204,6 +222,7 @@ func (e *GooseFSEngine) syncMetadataInternal() (err error) {
 				}
 			} else {
 				e.Log.Error(result.Err, "Metadata sync failed")
+				base.RecordMetadataSyncFailure(e.Client, result, e.namespace, e.name, e.Log)
 				return result.Err
 			}
 		case <-time.After(CheckMetadataSyncDoneTimeoutMillisec * time.Millisecond):
@@ -216,6 +235,10 @@ func (e *GooseFSEngine) syncMetadataInternal() (err error) {
 			if err != nil {
 				return
 			}
+			// keep the ufs total and file num of the dataset during the scheduled metadata sync
+			if base.IsScheduledMetadataSync(dataset, MetadataSyncNotDoneMsg) {
+				return
+			}
 			datasetToUpdate := dataset.DeepCopy()
 			datasetToUpdate.Status.UfsTotal = MetadataSyncNotDoneMsg
 			datasetToUpdate.Status.FileNum = MetadataSyncNotDoneMsg
@@ -232,7 +255,7 @@ func (e *GooseFSEngine) syncMetadataInternal() (err error) {
 		}
 		e.MetadataSyncDoneCh = make(chan base.MetadataSyncResult)
 		go func(resultChan chan base.MetadataSyncResult) {
-			defer close(resultChan)
+			defer base.SafeClose(resultChan)
 			result := base.MetadataSyncResult{
 				StartTime: time.Now(),
 				UfsTotal:  "",
@@ -242,7 +265,7 @@ func (e *GooseFSEngine) syncMetadataInternal() (err error) {
 				e.Log.Error(err, "Can't get dataset when syncing metadata", "name", e.name, "namespace", e.namespace)
 				result.Err = err
 				result.Done = false
-				resultChan <- result
+				base.SafeSend(resultChan, result)
 				return
 			}
 
@@ -261,18 +284,18 @@ func (e *GooseFSEngine) syncMetadataInternal() (err error) {
 						e.Log.Error(err, fmt.Sprintf("Sync local dir failed when syncing metadata, path: %s", localDirPath), "name", e.name, "namespace", e.namespace)
 						result.Err = err
 						result.Done = false
-						resultChan <- result
+						base.SafeSend(resultChan, result)
 						return
 					}
 				}
 			}
 			// load metadata
-			err = fileUtils.LoadMetadataWithoutTimeout("/")
+			err = e.loadMetadataOfDataset(fileUtils, dataset)
 			if err != nil {
 				e.Log.Error(err, "LoadMetadata failed when syncing metadata", "name", e.name, "namespace", e.namespace)
 				result.Err = err
 				result.Done = false
-				resultChan <- result
+				base.SafeSend(resultChan, result)
 				return
 			}
 			result.Done = true
@@ -297,8 +320,34 @@ func (e *GooseFSEngine) syncMetadataInternal() (err error) {
 			} else {
 				result.Err = nil
 			}
-			resultChan <- result
+			base.SafeSend(resultChan, result)
 		}(e.MetadataSyncDoneCh)
 	}
 	return
 }
+
+// loadMetadataOfDataset loads the metadata of the whole dataset when setting up the runtime, and loads the metadata
+// under the paths of the metadata sync policy in the given mode on schedule.
+func (e *GooseFSEngine) loadMetadataOfDataset(fileUtils operations.GooseFSFileUtils, dataset *datav1alpha1.Dataset) (err error) {
+	if !base.IsScheduledMetadataSync(dataset, MetadataSyncNotDoneMsg) {
+		return fileUtils.LoadMetadataWithoutTimeout("/")
+	}
+
+	runtime, err := e.getRuntime()
+	if err != nil {
+		return err
+	}
+	policy := runtime.Spec.MetadataSyncPolicy
+	for _, path := range policy.ScheduledSyncPaths() {
+		e.Log.Info("Scheduled metadata sync", "path", path, "incremental", policy.IncrementalSyncEnabled())
+		if policy.IncrementalSyncEnabled() {
+			err = fileUtils.LoadMetadataWithoutTimeout(path)
+		} else {
+			err = fileUtils.ReloadMetadataWithoutTimeout(path)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
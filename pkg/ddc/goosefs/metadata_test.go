@@ -70,6 +70,7 @@ func TestSyncMetadata(t *testing.T) {
 	testObjs := []runtime.Object{}
 	for _, datasetInput := range datasetInputs {
 		testObjs = append(testObjs, datasetInput.DeepCopy())
+		testObjs = append(testObjs, &datav1alpha1.GooseFSRuntime{ObjectMeta: datasetInput.ObjectMeta})
 	}
 	client := fake.NewFakeClientWithScheme(testScheme, testObjs...)
 
@@ -135,6 +136,7 @@ func TestShouldSyncMetadata(t *testing.T) {
 	testObjs := []runtime.Object{}
 	for _, datasetInput := range datasetInputs {
 		testObjs = append(testObjs, datasetInput.DeepCopy())
+		testObjs = append(testObjs, &datav1alpha1.GooseFSRuntime{ObjectMeta: datasetInput.ObjectMeta})
 	}
 	client := fake.NewFakeClientWithScheme(testScheme, testObjs...)
 
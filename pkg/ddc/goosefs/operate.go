@@ -17,6 +17,7 @@ limitations under the License.
 package goosefs
 
 import (
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
 	"github.com/fluid-cloudnative/fluid/pkg/errors"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
@@ -37,9 +38,29 @@ func (e *GooseFSEngine) GetDataOperationValueFile(ctx cruntime.ReconcileRequestC
 		return valueFileName, err
 	}
 
+	if operateType == dataoperation.DataFreeType {
+		valueFileName, err = e.generateDataFreeValueFile(ctx, object)
+		return valueFileName, err
+	}
+
+	if operateType == dataoperation.DataMigrateType {
+		valueFileName, err = e.generateDataMigrateValueFile(ctx, object)
+		return valueFileName, err
+	}
+
 	return "", errors.NewNotSupported(
 		schema.GroupResource{
 			Group:    object.GetObjectKind().GroupVersionKind().Group,
 			Resource: object.GetObjectKind().GroupVersionKind().Kind,
 		}, "GooseFSRuntime")
 }
+
+// GetDataOperationProgress derives the progress of the data operation from the cache of the runtime, only DataLoad is supported.
+func (e *GooseFSEngine) GetDataOperationProgress(ctx cruntime.ReconcileRequestContext, operation dataoperation.OperationInterface,
+	previous []datav1alpha1.TargetPathProgress) (progress []datav1alpha1.TargetPathProgress, err error) {
+	if operation.GetOperationType() != dataoperation.DataLoadType {
+		return nil, nil
+	}
+
+	return e.getDataLoadProgress(operation.GetOperationObject(), previous)
+}
@@ -37,6 +37,9 @@ type GooseFSFileUtils struct {
 	log       logr.Logger
 }
 
+// minReplicationPattern matches the minimum replication in the file info printed by `fs stat`, e.g. replicationMin=0
+var minReplicationPattern = regexp.MustCompile(`replicationMin=(-?\d+)`)
+
 func NewGooseFSFileUtils(podName string, containerName string, namespace string, log logr.Logger) GooseFSFileUtils {
 
 	return GooseFSFileUtils{
@@ -88,10 +91,19 @@ func (a GooseFSFileUtils) ReportSummary() (summary string, err error) {
 
 // Load the metadata without timeout
 func (a GooseFSFileUtils) LoadMetadataWithoutTimeout(goosefsPath string) (err error) {
+	return a.loadMetadataWithoutTimeout([]string{"goosefs", "fs", "loadMetadata", "-R", goosefsPath})
+}
+
+// ReloadMetadataWithoutTimeout reloads the metadata under the path without timeout, the metadata
+// of the files already loaded is updated forcibly
+func (a GooseFSFileUtils) ReloadMetadataWithoutTimeout(goosefsPath string) (err error) {
+	return a.loadMetadataWithoutTimeout([]string{"goosefs", "fs", "loadMetadata", "-R", "-F", goosefsPath})
+}
+
+func (a GooseFSFileUtils) loadMetadataWithoutTimeout(command []string) (err error) {
 	var (
-		command = []string{"goosefs", "fs", "loadMetadata", "-R", goosefsPath}
-		stdout  string
-		stderr  string
+		stdout string
+		stderr string
 	)
 
 	start := time.Now()
@@ -183,6 +195,35 @@ func (a GooseFSFileUtils) QueryMetaDataInfoIntoFile(key KeyOfMetaDataFile, filen
 	return
 }
 
+// ListRecursively lists the files under the path recursively with the metadata synced from the under file system.
+// The percentages of the files cached in GooseFS are dropped so that the listing only changes with the under file system.
+func (a GooseFSFileUtils) ListRecursively(goosefsPath string) (listing string, err error) {
+	var (
+		command = []string{"goosefs", "fs", "-Dgoosefs.user.file.metadata.sync.interval=0", "ls", "-R", goosefsPath}
+		stdout  string
+		stderr  string
+	)
+
+	stdout, stderr, err = a.exec(command, false)
+	if err != nil {
+		err = fmt.Errorf("execute command %v with expectedErr: %v stdout %s and stderr %s", command, err, stdout, stderr)
+		return
+	}
+
+	lines := strings.Split(stdout, "\n")
+	for i, line := range lines {
+		fields := strings.Fields(line)
+		kept := make([]string, 0, len(fields))
+		for _, field := range fields {
+			if !strings.HasSuffix(field, "%") {
+				kept = append(kept, field)
+			}
+		}
+		lines[i] = strings.Join(kept, " ")
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
 func (a GooseFSFileUtils) Mkdir(goosefsPath string) (err error) {
 	var (
 		command = []string{"goosefs", "fs", "mkdir", goosefsPath}
@@ -445,6 +486,103 @@ func (a GooseFSFileUtils) ReportMetrics() (metrics string, err error) {
 	return stdout, err
 }
 
+// SetMinReplication sets the minimum number of the block replicas of the files under the path recursively
+// by running `goosefs fs setReplication -R --min <replicas> <path>` command
+func (a GooseFSFileUtils) SetMinReplication(path string, replicas int32) (err error) {
+	var (
+		command = []string{"goosefs", "fs", "setReplication", "-R", "--min", strconv.Itoa(int(replicas)), path}
+		stdout  string
+		stderr  string
+	)
+	stdout, stderr, err = a.exec(command, false)
+	if err != nil {
+		err = fmt.Errorf("execute command %v with expectedErr: %v stdout %s and stderr %s", command, err, stdout, stderr)
+		return
+	}
+	return
+}
+
+// ListCachedFiles lists the files under the path recursively which are cached partially or fully
+// by running `goosefs fs ls -R <path>` command
+func (a GooseFSFileUtils) ListCachedFiles(path string) (files []string, err error) {
+	var (
+		command = []string{"goosefs", "fs", "ls", "-R", path}
+		stdout  string
+		stderr  string
+	)
+	stdout, stderr, err = a.exec(command, false)
+	if err != nil {
+		err = fmt.Errorf("execute command %v with expectedErr: %v stdout %s and stderr %s", command, err, stdout, stderr)
+		return
+	}
+
+	// e.g. -rw-r--r--  root  root  6  PERSISTED 06-17-2024 10:00:00:000  50% /a/data.csv
+	for _, line := range strings.Split(stdout, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "d") {
+			continue
+		}
+		for i, field := range fields {
+			if strings.HasSuffix(field, "%") {
+				if field != "0%" && i+1 < len(fields) {
+					files = append(files, strings.Join(fields[i+1:], " "))
+				}
+				break
+			}
+		}
+	}
+	return
+}
+
+// GetFileLocations returns the workers holding the blocks of the file by running `goosefs fs location <path>` command
+func (a GooseFSFileUtils) GetFileLocations(path string) (locations []string, err error) {
+	var (
+		command = []string{"goosefs", "fs", "location", path}
+		stdout  string
+		stderr  string
+	)
+	stdout, stderr, err = a.exec(command, false)
+	if err != nil {
+		err = fmt.Errorf("execute command %v with expectedErr: %v stdout %s and stderr %s", command, err, stdout, stderr)
+		return
+	}
+
+	// e.g. /a/data.csv with file id 33554431 is on nodes:
+	//      192.168.0.1
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	for _, line := range lines[1:] {
+		if location := strings.TrimSpace(line); len(location) > 0 {
+			locations = append(locations, location)
+		}
+	}
+	return
+}
+
+// GetMinReplication returns the minimum number of the block replicas of the file by running `goosefs fs stat <path>` command
+func (a GooseFSFileUtils) GetMinReplication(path string) (replicas int32, err error) {
+	var (
+		command = []string{"goosefs", "fs", "stat", path}
+		stdout  string
+		stderr  string
+	)
+	stdout, stderr, err = a.exec(command, false)
+	if err != nil {
+		err = fmt.Errorf("execute command %v with expectedErr: %v stdout %s and stderr %s", command, err, stdout, stderr)
+		return
+	}
+
+	matches := minReplicationPattern.FindStringSubmatch(stdout)
+	if len(matches) != 2 {
+		err = fmt.Errorf("failed to find the minimum replication of %s in %s", path, stdout)
+		return
+	}
+	value, err := strconv.ParseInt(matches[1], 10, 32)
+	if err != nil {
+		return
+	}
+	return int32(value), nil
+}
+
 // ReportCapacity get goosefs capacity info by running `goosefs fsadmin report capacity` command
 func (a GooseFSFileUtils) ReportCapacity() (report string, err error) {
 	var (
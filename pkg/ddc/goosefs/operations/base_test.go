@@ -636,3 +636,37 @@ func TestUnMount(t *testing.T) {
 		t.Error("check failure, want err, got nil")
 	}
 }
+
+func TestGooseFSFileUtils_WorkerCacheReplication(t *testing.T) {
+	mockExec := func(a GooseFSFileUtils, command []string, verbose bool) (stdout string, stderr string, err error) {
+		switch command[2] {
+		case "ls":
+			return `drwxr-xr-x  root  root  2  PERSISTED 06-17-2024 10:00:00:000  DIR /a
+-rw-r--r--  root  root  6  PERSISTED 06-17-2024 10:00:00:000  50% /a/data.csv
+-rw-r--r--  root  root  6  PERSISTED 06-17-2024 10:00:00:000  0% /a/uncached.csv`, "", nil
+		case "location":
+			return "/a/data.csv with file id 33554431 is on nodes: \n192.168.0.1\n192.168.0.2\n", "", nil
+		case "stat":
+			return "/a/data.csv is a file path.\nFileInfo{fileId=33554431, replicationMax=-1, replicationMin=1}", "", nil
+		}
+		return "", "", errors.New("unexpected command")
+	}
+	patches := gomonkey.ApplyPrivateMethod(GooseFSFileUtils{}, "exec", mockExec)
+	defer patches.Reset()
+
+	a := GooseFSFileUtils{log: fake.NullLogger()}
+	files, err := a.ListCachedFiles("/")
+	if err != nil || !reflect.DeepEqual(files, []string{"/a/data.csv"}) {
+		t.Errorf("expect cached files [/a/data.csv], got %v, err %v", files, err)
+	}
+
+	locations, err := a.GetFileLocations("/a/data.csv")
+	if err != nil || !reflect.DeepEqual(locations, []string{"192.168.0.1", "192.168.0.2"}) {
+		t.Errorf("expect locations [192.168.0.1 192.168.0.2], got %v, err %v", locations, err)
+	}
+
+	replicas, err := a.GetMinReplication("/a/data.csv")
+	if err != nil || replicas != 1 {
+		t.Errorf("expect minimum replication 1, got %d, err %v", replicas, err)
+	}
+}
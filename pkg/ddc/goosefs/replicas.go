@@ -17,9 +17,12 @@ limitations under the License.
 package goosefs
 
 import (
+	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/ctrl"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/goosefs/operations"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
 )
@@ -50,3 +53,31 @@ func (e *GooseFSEngine) SyncReplicas(ctx cruntime.ReconcileRequestContext) (err
 	return
 
 }
+
+// DrainWorkers replicates the cache on the draining workers to the remaining workers before scaling in if the runtime
+// is annotated with runtime.fluid.io/replicate-cache-on-scale-in=true. The minimum replication of the files cached on
+// the draining workers alone is raised so that GooseFS replicates their blocks to the remaining workers.
+func (e *GooseFSEngine) DrainWorkers(pods []corev1.Pod) (drained bool, err error) {
+	runtime, err := e.getRuntime()
+	if err != nil {
+		return
+	}
+	if runtime.Annotations[common.AnnotationReplicateCacheOnScaleIn] != "true" {
+		return true, nil
+	}
+
+	podName, containerName := e.getMasterPodInfo()
+	fileUtils := operations.NewGooseFSFileUtils(podName, containerName, e.namespace, e.Log)
+	return e.Helper.ReplicateWorkerCache(pods, fileUtils)
+}
+
+// FinishDrainingWorkers restores the minimum replication of the files replicated off the draining workers
+func (e *GooseFSEngine) FinishDrainingWorkers(pods []corev1.Pod) (err error) {
+	if !ctrl.IsWorkerCacheReplicating(pods) {
+		return
+	}
+
+	podName, containerName := e.getMasterPodInfo()
+	fileUtils := operations.NewGooseFSFileUtils(podName, containerName, e.namespace, e.Log)
+	return e.Helper.RestoreWorkerCacheReplication(pods, fileUtils)
+}
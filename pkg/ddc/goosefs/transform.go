@@ -31,6 +31,25 @@ import (
 )
 
 func (e *GooseFSEngine) transform(runtime *datav1alpha1.GooseFSRuntime) (value *GooseFS, err error) {
+	value, err = e.transformWithoutPorts(runtime)
+	if err != nil {
+		return
+	}
+
+	// 12.allocate port for fluid engine
+	err = e.allocatePorts(value)
+	if err != nil {
+		return
+	}
+
+	// 13.set engine properties
+	e.setPortProperties(runtime, value)
+	return
+}
+
+// transformWithoutPorts transforms the runtime into the values without allocating the ports, so that it can be
+// used to compare the latest values with the ones of the running runtime.
+func (e *GooseFSEngine) transformWithoutPorts(runtime *datav1alpha1.GooseFSRuntime) (value *GooseFS, err error) {
 	if runtime == nil {
 		err = fmt.Errorf("the goosefsRuntime is null")
 		return
@@ -89,19 +108,13 @@ func (e *GooseFSEngine) transform(runtime *datav1alpha1.GooseFSRuntime) (value *
 	// 9. set optimization parameters if all the mounts are HTTP
 	e.optimizeDefaultPropertiesAndFuseForHTTP(runtime, dataset, value)
 
-	// 10.allocate port for fluid engine
-	err = e.allocatePorts(value)
+	// 10.set API Gateway
+	err = e.transformAPIGateway(runtime, value)
 	if err != nil {
 		return
 	}
 
-	// 11.set engine properties
-	e.setPortProperties(runtime, value)
-
-	// 12.set API Gateway
-	err = e.transformAPIGateway(runtime, value)
-
-	// 13.set the placementMode
+	// 11.set the placementMode
 	e.transformPlacementMode(dataset, value)
 	return
 }
@@ -273,6 +286,7 @@ func (e *GooseFSEngine) transformMasters(runtime *datav1alpha1.GooseFSRuntime,
 	if len(nodeSelector) != 0 {
 		value.Master.NodeSelector = nodeSelector
 	}
+	value.Master.Scheduling = utils.TransformSchedulingSpec(runtime.Spec.Master.SchedulingSpec)
 
 	// // check the run as
 	// if runtime.Spec.RunAs != nil {
@@ -327,6 +341,7 @@ func (e *GooseFSEngine) transformWorkers(runtime *datav1alpha1.GooseFSRuntime, v
 	if len(value.Worker.NodeSelector) == 0 {
 		value.Worker.NodeSelector = map[string]string{}
 	}
+	value.Worker.Scheduling = utils.TransformSchedulingSpec(runtime.Spec.Worker.SchedulingSpec)
 
 	if len(runtime.Spec.Worker.Properties) > 0 {
 		value.Worker.Properties = runtime.Spec.Worker.Properties
@@ -88,6 +88,7 @@ func (e *GooseFSEngine) transformFuse(runtime *datav1alpha1.GooseFSRuntime, data
 	}
 
 	value.Fuse.NodeSelector[utils.GetFuseLabelName(runtime.Namespace, runtime.Name, e.runtimeInfo.GetOwnerDatasetUID())] = "true"
+	value.Fuse.Scheduling = utils.TransformSchedulingSpec(runtime.Spec.Fuse.SchedulingSpec)
 	value.Fuse.HostNetwork = true
 	value.Fuse.HostPID = common.HostPIDEnabled(runtime.Annotations)
 	value.Fuse.Enabled = true
@@ -130,29 +130,31 @@ type JobWorker struct {
 }
 
 type Worker struct {
-	JvmOptions   []string          `yaml:"jvmOptions,omitempty"`
-	Env          map[string]string `yaml:"env,omitempty"`
-	NodeSelector map[string]string `yaml:"nodeSelector,omitempty"`
-	Properties   map[string]string `yaml:"properties,omitempty"`
-	HostNetwork  bool              `yaml:"hostNetwork,omitempty"`
-	Resources    common.Resources  `yaml:"resources,omitempty"`
-	Ports        Ports             `yaml:"ports,omitempty"`
-	Annotations  map[string]string `json:"annotations,omitempty"`
+	JvmOptions   []string           `yaml:"jvmOptions,omitempty"`
+	Env          map[string]string  `yaml:"env,omitempty"`
+	NodeSelector map[string]string  `yaml:"nodeSelector,omitempty"`
+	Scheduling   *common.Scheduling `yaml:"scheduling,omitempty"`
+	Properties   map[string]string  `yaml:"properties,omitempty"`
+	HostNetwork  bool               `yaml:"hostNetwork,omitempty"`
+	Resources    common.Resources   `yaml:"resources,omitempty"`
+	Ports        Ports              `yaml:"ports,omitempty"`
+	Annotations  map[string]string  `json:"annotations,omitempty"`
 }
 
 type Master struct {
-	JvmOptions   []string          `yaml:"jvmOptions,omitempty"`
-	Env          map[string]string `yaml:"env,omitempty"`
-	Affinity     Affinity          `yaml:"affinity"`
-	NodeSelector map[string]string `yaml:"nodeSelector,omitempty"`
-	Properties   map[string]string `yaml:"properties,omitempty"`
-	Replicas     int32             `yaml:"replicaCount,omitempty"`
-	HostNetwork  bool              `yaml:"hostNetwork,omitempty"`
-	Resources    common.Resources  `yaml:"resources,omitempty"`
-	Ports        Ports             `yaml:"ports,omitempty"`
-	BackupPath   string            `yaml:"backupPath,omitempty"`
-	Restore      Restore           `yaml:"restore,omitempty"`
-	Annotations  map[string]string `json:"annotations,omitempty"`
+	JvmOptions   []string           `yaml:"jvmOptions,omitempty"`
+	Env          map[string]string  `yaml:"env,omitempty"`
+	Affinity     Affinity           `yaml:"affinity"`
+	NodeSelector map[string]string  `yaml:"nodeSelector,omitempty"`
+	Scheduling   *common.Scheduling `yaml:"scheduling,omitempty"`
+	Properties   map[string]string  `yaml:"properties,omitempty"`
+	Replicas     int32              `yaml:"replicaCount,omitempty"`
+	HostNetwork  bool               `yaml:"hostNetwork,omitempty"`
+	Resources    common.Resources   `yaml:"resources,omitempty"`
+	Ports        Ports              `yaml:"ports,omitempty"`
+	BackupPath   string             `yaml:"backupPath,omitempty"`
+	Restore      Restore            `yaml:"restore,omitempty"`
+	Annotations  map[string]string  `json:"annotations,omitempty"`
 }
 
 type Restore struct {
@@ -162,23 +164,24 @@ type Restore struct {
 }
 
 type Fuse struct {
-	Image              string            `yaml:"image,omitempty"`
-	NodeSelector       map[string]string `yaml:"nodeSelector,omitempty"`
-	ImageTag           string            `yaml:"imageTag,omitempty"`
-	ImagePullPolicy    string            `yaml:"imagePullPolicy,omitempty"`
-	Properties         map[string]string `yaml:"properties,omitempty"`
-	Env                map[string]string `yaml:"env,omitempty"`
-	JvmOptions         []string          `yaml:"jvmOptions,omitempty"`
-	MountPath          string            `yaml:"mountPath,omitempty"`
-	ShortCircuitPolicy string            `yaml:"shortCircuitPolicy,omitempty"`
-	Args               []string          `yaml:"args,omitempty"`
-	HostNetwork        bool              `yaml:"hostNetwork,omitempty"`
-	HostPID            bool              `json:"hostPID,omitempty"`
-	Enabled            bool              `yaml:"enabled,omitempty"`
-	Resources          common.Resources  `yaml:"resources,omitempty"`
-	Global             bool              `yaml:"global,omitempty"`
-	CriticalPod        bool              `yaml:"criticalPod,omitempty"`
-	Annotations        map[string]string `json:"annotations,omitempty"`
+	Image              string             `yaml:"image,omitempty"`
+	NodeSelector       map[string]string  `yaml:"nodeSelector,omitempty"`
+	Scheduling         *common.Scheduling `yaml:"scheduling,omitempty"`
+	ImageTag           string             `yaml:"imageTag,omitempty"`
+	ImagePullPolicy    string             `yaml:"imagePullPolicy,omitempty"`
+	Properties         map[string]string  `yaml:"properties,omitempty"`
+	Env                map[string]string  `yaml:"env,omitempty"`
+	JvmOptions         []string           `yaml:"jvmOptions,omitempty"`
+	MountPath          string             `yaml:"mountPath,omitempty"`
+	ShortCircuitPolicy string             `yaml:"shortCircuitPolicy,omitempty"`
+	Args               []string           `yaml:"args,omitempty"`
+	HostNetwork        bool               `yaml:"hostNetwork,omitempty"`
+	HostPID            bool               `json:"hostPID,omitempty"`
+	Enabled            bool               `yaml:"enabled,omitempty"`
+	Resources          common.Resources   `yaml:"resources,omitempty"`
+	Global             bool               `yaml:"global,omitempty"`
+	CriticalPod        bool               `yaml:"criticalPod,omitempty"`
+	Annotations        map[string]string  `json:"annotations,omitempty"`
 }
 
 type Tieredstore struct {
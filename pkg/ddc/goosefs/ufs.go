@@ -18,6 +18,7 @@ package goosefs
 
 import (
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/goosefs/operations"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 )
 
@@ -45,6 +46,13 @@ func (e *GooseFSEngine) TotalFileNums() (value int64, err error) {
 	return e.totalFileNumsInternal()
 }
 
+// ListUFS lists the files under the path of the dataset recursively to detect the changes of the under file system
+func (e *GooseFSEngine) ListUFS(path string) (listing string, err error) {
+	podName, containerName := e.getMasterPodInfo()
+	fileUtils := operations.NewGooseFSFileUtils(podName, containerName, e.namespace, e.Log)
+	return fileUtils.ListRecursively(path)
+}
+
 // ShouldCheckUFS checks if it requires checking UFS
 func (e *GooseFSEngine) ShouldCheckUFS() (should bool, err error) {
 	// For GooseFS Engine, always attempt to prepare UFS
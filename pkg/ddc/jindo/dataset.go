@@ -57,6 +57,11 @@ func (e *JindoEngine) UpdateDatasetStatus(phase datav1alpha1.DatasetPhase) (err
 		if phase != dataset.Status.Phase {
 			switch phase {
 			case datav1alpha1.BoundDatasetPhase:
+				// Stores dataset mount info
+				if len(datasetToUpdate.Status.Mounts) == 0 {
+					datasetToUpdate.Status.Mounts = datasetToUpdate.Spec.Mounts
+				}
+
 				cond = utils.NewDatasetCondition(datav1alpha1.DatasetReady, datav1alpha1.DatasetReadyReason,
 					"The ddc runtime is ready.",
 					corev1.ConditionTrue)
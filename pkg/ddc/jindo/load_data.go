@@ -19,6 +19,7 @@ package jindo
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
@@ -160,7 +161,7 @@ func (e *JindoEngine) genDataLoadValue(image string, runtime *datav1alpha1.Jindo
 	}
 
 	targetPaths := []cdataload.TargetPath{}
-	for _, target := range dataload.Spec.Target {
+	for _, target := range cdataload.GetTargetsToLoad(dataload) {
 		fluidNative := utils.IsTargetPathUnderFluidNativeMounts(target.Path, *targetDataset)
 		targetPaths = append(targetPaths, cdataload.TargetPath{
 			Path:        target.Path,
@@ -205,3 +206,37 @@ func (e *JindoEngine) CheckRuntimeReady() (ready bool) {
 	}
 	return true
 }
+
+// getDataLoadProgress reports the total bytes under the target paths. Jindo doesn't expose the cached bytes of a path,
+// so the paths are only regarded as loaded when the DataLoad job succeeds. The totals are only counted once because
+// it's costly for large directories.
+func (e *JindoEngine) getDataLoadProgress(object client.Object, previous []datav1alpha1.TargetPathProgress) (progress []datav1alpha1.TargetPathProgress, err error) {
+	dataLoad, ok := object.(*datav1alpha1.DataLoad)
+	if !ok {
+		return nil, fmt.Errorf("object %v is not a DataLoad", object)
+	}
+
+	podName, containerName := e.getMasterPodInfo()
+	fileUtils := operations.NewJindoFileUtils(podName, containerName, e.namespace, e.Log)
+	for _, path := range cdataload.GetTargetPaths(dataLoad) {
+		pathProgress := datav1alpha1.TargetPathProgress{Path: path}
+		if last := cdataload.FindProgress(previous, path); last != nil {
+			pathProgress.TotalBytes = last.TotalBytes
+		}
+
+		if pathProgress.TotalBytes == 0 {
+			size, err := fileUtils.GetUfsTotalSize("jfs://jindo"+path, false)
+			if err != nil {
+				return nil, err
+			}
+			pathProgress.TotalBytes, err = strconv.ParseInt(size, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		progress = append(progress, pathProgress)
+	}
+
+	return progress, nil
+}
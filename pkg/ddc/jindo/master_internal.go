@@ -27,10 +27,7 @@ import (
 )
 
 func (e *JindoEngine) setupMasterInernal() (err error) {
-	var (
-		chartName = utils.GetChartsDirectory() + "/jindofs"
-	)
-	valueFileName, err := e.generateJindoValueFile()
+	valueFileName, chartName, err := e.GenerateRuntimeValueFile()
 	if err != nil {
 		return
 	}
@@ -46,6 +43,13 @@ func (e *JindoEngine) setupMasterInernal() (err error) {
 	return helm.InstallRelease(e.name, e.namespace, valueFileName, chartName)
 }
 
+// GenerateRuntimeValueFile transforms the runtime into the value file of the runtime chart
+func (e *JindoEngine) GenerateRuntimeValueFile() (valueFileName string, chartName string, err error) {
+	chartName = utils.GetChartsDirectory() + "/jindofs"
+	valueFileName, err = e.generateJindoValueFile()
+	return
+}
+
 func (e *JindoEngine) generateJindoValueFile() (valueFileName string, err error) {
 	// why need to delete configmap e.name+"-jindofs-config" ? Or it should be
 	// err = kubeclient.DeleteConfigMap(e.Client, e.name+"-jindofs-config", e.namespace)
@@ -59,13 +59,28 @@ func (e *JindoEngine) shouldSyncMetadata() (should bool, err error) {
 		return should, err
 	}
 
-	if dataset.Status.UfsTotal != "" && dataset.Status.UfsTotal != MetadataSyncNotDoneMsg {
+	runtime, err := e.getRuntime()
+	if err != nil {
+		should = false
+		return should, err
+	}
+
+	if !runtime.Spec.MetadataSyncPolicy.AutoSyncEnabled() {
+		e.Log.V(1).Info("Skip syncing metadata cause runtime.Spec.MetadataSyncPolicy.AutoSync=false", "runtime name", runtime.Name, "runtime namespace", runtime.Namespace)
+		should = false
+		return should, nil
+	}
+
+	if base.IsScheduledMetadataSync(dataset, MetadataSyncNotDoneMsg) {
 		e.Log.V(1).Info("dataset ufs is ready",
 			"dataset name", dataset.Name,
 			"dataset namespace", dataset.Namespace,
 			"ufstotal", dataset.Status.UfsTotal)
-		should = false
-		return should, nil
+		// keep waiting for the result of the scheduled metadata sync in progress
+		if e.MetadataSyncDoneCh != nil {
+			return true, nil
+		}
+		return base.ShouldScheduleMetadataSync(runtime.Spec.MetadataSyncPolicy, dataset)
 	}
 	should = true
 	return should, nil
@@ -93,11 +108,14 @@ func (e *JindoEngine) syncMetadataInternal() (err error) {
 					}
 					datasetToUpdate := dataset.DeepCopy()
 					datasetToUpdate.Status.UfsTotal = result.UfsTotal
+					base.SetMetadataSyncedCondition(datasetToUpdate, result)
 					if !reflect.DeepEqual(datasetToUpdate, dataset) {
 						err = e.Client.Status().Update(context.TODO(), datasetToUpdate)
 						if err != nil {
 							return
 						}
+						// Update dataset metrics after a successful status update
+						base.RecordDatasetMetrics(result, datasetToUpdate.Namespace, datasetToUpdate.Name, e.Log)
 					}
 					return
 				})
@@ -107,6 +125,7 @@ func (e *JindoEngine) syncMetadataInternal() (err error) {
 				}
 			} else {
 				e.Log.Error(result.Err, "Metadata sync failed")
+				base.RecordMetadataSyncFailure(e.Client, result, e.namespace, e.name, e.Log)
 				return result.Err
 			}
 		case <-time.After(CheckMetadataSyncDoneTimeoutMillisec * time.Millisecond):
@@ -119,6 +138,10 @@ func (e *JindoEngine) syncMetadataInternal() (err error) {
 			if err != nil {
 				return
 			}
+			// keep the ufs total of the dataset during the scheduled metadata sync
+			if base.IsScheduledMetadataSync(dataset, MetadataSyncNotDoneMsg) {
+				return
+			}
 			datasetToUpdate := dataset.DeepCopy()
 			datasetToUpdate.Status.UfsTotal = MetadataSyncNotDoneMsg
 			datasetToUpdate.Status.FileNum = MetadataSyncNotDoneMsg
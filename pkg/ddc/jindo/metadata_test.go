@@ -69,6 +69,7 @@ func TestSyncMetadata(t *testing.T) {
 	testObjs := []runtime.Object{}
 	for _, datasetInput := range datasetInputs {
 		testObjs = append(testObjs, datasetInput.DeepCopy())
+		testObjs = append(testObjs, &datav1alpha1.JindoRuntime{ObjectMeta: datasetInput.ObjectMeta})
 	}
 	client := fake.NewFakeClientWithScheme(testScheme, testObjs...)
 
@@ -140,6 +141,7 @@ func TestShouldSyncMetadata(t *testing.T) {
 	testObjs := []runtime.Object{}
 	for _, datasetInput := range datasetInputs {
 		testObjs = append(testObjs, datasetInput.DeepCopy())
+		testObjs = append(testObjs, &datav1alpha1.JindoRuntime{ObjectMeta: datasetInput.ObjectMeta})
 	}
 	client := fake.NewFakeClientWithScheme(testScheme, testObjs...)
 
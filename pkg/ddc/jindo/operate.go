@@ -17,6 +17,7 @@ limitations under the License.
 package jindo
 
 import (
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
 	"github.com/fluid-cloudnative/fluid/pkg/errors"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
@@ -38,3 +39,13 @@ func (e *JindoEngine) GetDataOperationValueFile(ctx cruntime.ReconcileRequestCon
 			Resource: object.GetObjectKind().GroupVersionKind().Kind,
 		}, "JindoRuntime")
 }
+
+// GetDataOperationProgress derives the progress of the data operation from the runtime, only DataLoad is supported.
+func (e *JindoEngine) GetDataOperationProgress(ctx cruntime.ReconcileRequestContext, operation dataoperation.OperationInterface,
+	previous []datav1alpha1.TargetPathProgress) (progress []datav1alpha1.TargetPathProgress, err error) {
+	if operation.GetOperationType() != dataoperation.DataLoadType {
+		return nil, nil
+	}
+
+	return e.getDataLoadProgress(operation.GetOperationObject(), previous)
+}
@@ -170,6 +170,7 @@ func (e *JindoEngine) transform(runtime *datav1alpha1.JindoRuntime) (value *Jind
 		return
 	}
 	e.transformTolerations(dataset, runtime, value)
+	e.transformScheduling(runtime, value)
 	e.transformResources(runtime, value)
 	e.transformLogConfig(runtime, value)
 	value.Master.DnsServer = dnsServer
@@ -700,6 +701,18 @@ func (e *JindoEngine) transformTolerations(dataset *datav1alpha1.Dataset, runtim
 	}
 }
 
+// transformScheduling transforms the scheduling constraints of the components except the tolerations,
+// which are merged with the tolerations of the dataset in transformTolerations.
+func (e *JindoEngine) transformScheduling(runtime *datav1alpha1.JindoRuntime, value *Jindo) {
+	transform := func(spec datav1alpha1.SchedulingSpec) *common.Scheduling {
+		spec.Tolerations = nil
+		return utils.TransformSchedulingSpec(spec)
+	}
+	value.Master.Scheduling = transform(runtime.Spec.Master.SchedulingSpec)
+	value.Worker.Scheduling = transform(runtime.Spec.Worker.SchedulingSpec)
+	value.Fuse.Scheduling = transform(runtime.Spec.Fuse.SchedulingSpec)
+}
+
 func (e *JindoEngine) transformLabels(runtime *datav1alpha1.JindoRuntime, value *Jindo) (err error) {
 	// the labels will not be merged here because they will be sequentially added into yaml templates
 	// If two labels share the same label key, the last one in yaml templates overrides the former ones
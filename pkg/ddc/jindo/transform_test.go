@@ -75,25 +75,31 @@ var _ = Describe("TestTransformTolerations", func() {
 							}},
 						},
 						Master: datav1alpha1.JindoCompTemplateSpec{
-							Tolerations: []corev1.Toleration{{
-								Key:      "master",
-								Operator: "Equals",
-								Value:    "true",
-							}},
+							SchedulingSpec: datav1alpha1.SchedulingSpec{
+								Tolerations: []corev1.Toleration{{
+									Key:      "master",
+									Operator: "Equals",
+									Value:    "true",
+								}},
+							},
 						},
 						Worker: datav1alpha1.JindoCompTemplateSpec{
-							Tolerations: []corev1.Toleration{{
-								Key:      "worker",
-								Operator: "Equals",
-								Value:    "true",
-							}},
+							SchedulingSpec: datav1alpha1.SchedulingSpec{
+								Tolerations: []corev1.Toleration{{
+									Key:      "worker",
+									Operator: "Equals",
+									Value:    "true",
+								}},
+							},
 						},
 						Fuse: datav1alpha1.JindoFuseSpec{
-							Tolerations: []corev1.Toleration{{
-								Key:      "fuse",
-								Operator: "Equals",
-								Value:    "true",
-							}},
+							SchedulingSpec: datav1alpha1.SchedulingSpec{
+								Tolerations: []corev1.Toleration{{
+									Key:      "fuse",
+									Operator: "Equals",
+									Value:    "true",
+								}},
+							},
 						},
 					},
 				}
@@ -154,16 +160,18 @@ var _ = Describe("TestTransformTolerations", func() {
 						}},
 					},
 					Master: datav1alpha1.JindoCompTemplateSpec{
-						Tolerations: []corev1.Toleration{
-							{
-								Key:      "toleration1",
-								Operator: "Equals",
-								Value:    "master",
-							},
-							{
-								Key:      "toleration2",
-								Operator: "Equals",
-								Value:    "master",
+						SchedulingSpec: datav1alpha1.SchedulingSpec{
+							Tolerations: []corev1.Toleration{
+								{
+									Key:      "toleration1",
+									Operator: "Equals",
+									Value:    "master",
+								},
+								{
+									Key:      "toleration2",
+									Operator: "Equals",
+									Value:    "master",
+								},
 							},
 						},
 					},
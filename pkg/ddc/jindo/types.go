@@ -66,6 +66,7 @@ type Master struct {
 	OssKey           string                    `yaml:"osskey,omitempty"`
 	OssSecret        string                    `yaml:"osssecret,omitempty"`
 	Tolerations      []v1.Toleration           `yaml:"tolerations,omitempty"`
+	Scheduling       *common.Scheduling        `yaml:"scheduling,omitempty"`
 	DnsServer        string                    `yaml:"dnsServer,omitempty"`
 	NameSpace        string                    `yaml:"namespace,omitempty"`
 	Labels           map[string]string         `yaml:"labels,omitempty"`
@@ -73,11 +74,12 @@ type Master struct {
 }
 
 type Worker struct {
-	Resources        Resources         `yaml:"resources,omitempty"`
-	NodeSelector     map[string]string `yaml:"nodeSelector,omitempty"`
-	WorkerProperties map[string]string `yaml:"properties"`
-	Port             Ports             `yaml:"ports,omitempty"`
-	Tolerations      []v1.Toleration   `yaml:"tolerations,omitempty"`
+	Resources        Resources          `yaml:"resources,omitempty"`
+	NodeSelector     map[string]string  `yaml:"nodeSelector,omitempty"`
+	WorkerProperties map[string]string  `yaml:"properties"`
+	Port             Ports              `yaml:"ports,omitempty"`
+	Tolerations      []v1.Toleration    `yaml:"tolerations,omitempty"`
+	Scheduling       *common.Scheduling `yaml:"scheduling,omitempty"`
 	// Affinity         v1.Affinity       `yaml:"affinity,omitempty"`
 	Labels           map[string]string         `yaml:"labels,omitempty"`
 	ImagePullSecrets []v1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
@@ -96,6 +98,7 @@ type Fuse struct {
 	Global            bool                      `yaml:"global,omitempty"`
 	RunAs             string                    `yaml:"runAs,omitempty"`
 	Tolerations       []v1.Toleration           `yaml:"tolerations,omitempty"`
+	Scheduling        *common.Scheduling        `yaml:"scheduling,omitempty"`
 	Labels            map[string]string         `yaml:"labels,omitempty"`
 	CriticalPod       bool                      `yaml:"criticalPod,omitempty"`
 	Resources         Resources                 `yaml:"resources,omitempty"`
@@ -17,6 +17,13 @@ limitations under the License.
 package jindo
 
 import (
+	"context"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/jindo/operations"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 )
@@ -61,11 +68,58 @@ func (e *JindoEngine) GetReportSummary() (summary string, err error) {
 	return fileUtils.ReportSummary()
 }
 
-// JindoEngine hasn't support UpdateOnUFSChange
+// ShouldUpdateUFS checks if the mount points of the dataset are changed and returns the mount paths to add or remove
 func (e *JindoEngine) ShouldUpdateUFS() (ufsToUpdate *utils.UFSToUpdate) {
+	// 1. get the dataset
+	dataset, err := utils.GetDataset(e.Client, e.name, e.namespace)
+	if err != nil {
+		e.Log.Error(err, "Failed to get the dataset")
+		return
+	}
+
+	// 2. get the ufs to update
+	ufsToUpdate = utils.NewUFSToUpdate(dataset)
+	ufsToUpdate.AnalyzePathsDelta()
+
 	return
 }
 
-func (e *JindoEngine) UpdateOnUFSChange(*utils.UFSToUpdate) (updateReady bool, err error) {
+// UpdateOnUFSChange reports the mount points can't be updated dynamically. JindoEngine renders the only mount point
+// into the namespace "jindo" of the bigboot config, which is loaded when the master starts, so the runtime has to be
+// recreated to apply the changes, or migrated to JindoCacheEngine which mounts the namespaces dynamically.
+// The mount points are recorded in the dataset status after warning, so that the same change is reported only once.
+func (e *JindoEngine) UpdateOnUFSChange(ufsToUpdate *utils.UFSToUpdate) (updateReady bool, err error) {
+	if !ufsToUpdate.ShouldUpdate() {
+		return
+	}
+
+	dataset, err := utils.GetDataset(e.Client, e.name, e.namespace)
+	if err != nil {
+		return
+	}
+
+	// the datasets bound before the mount points are recorded have nothing to report
+	if len(dataset.Status.Mounts) > 0 {
+		e.Log.Info("JindoEngine doesn't support updating mount points dynamically",
+			"toAdd", ufsToUpdate.ToAdd(), "toRemove", ufsToUpdate.ToRemove())
+		if e.Recorder != nil {
+			e.Recorder.Eventf(dataset, corev1.EventTypeWarning, common.RuntimeMountsUpdateNotSupported,
+				"JindoRuntime doesn't support updating mounts dynamically, mount points to add %v and to remove %v are ignored",
+				ufsToUpdate.ToAdd(), ufsToUpdate.ToRemove())
+		}
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		dataset, err := utils.GetDataset(e.Client, e.name, e.namespace)
+		if err != nil {
+			return err
+		}
+		datasetToUpdate := dataset.DeepCopy()
+		datasetToUpdate.Status.Mounts = datasetToUpdate.Spec.Mounts
+		if reflect.DeepEqual(dataset.Status, datasetToUpdate.Status) {
+			return nil
+		}
+		return e.Client.Status().Update(context.TODO(), datasetToUpdate)
+	})
 	return
 }
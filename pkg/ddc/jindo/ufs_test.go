@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jindo
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestUpdateOnUFSChange(t *testing.T) {
+	specMounts := []datav1alpha1.Mount{
+		{Name: "spark", MountPoint: "oss://bucket/spark"},
+		{Name: "hive", MountPoint: "oss://bucket/hive"},
+	}
+
+	tests := []struct {
+		name         string
+		statusMounts []datav1alpha1.Mount
+		wantEvents   int
+	}{
+		{
+			name:         "mounts_changed",
+			statusMounts: []datav1alpha1.Mount{{Name: "spark", MountPoint: "oss://bucket/spark"}},
+			wantEvents:   1,
+		},
+		{
+			name:       "mounts_not_recorded",
+			wantEvents: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataset := &datav1alpha1.Dataset{
+				ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "fluid"},
+				Spec:       datav1alpha1.DatasetSpec{Mounts: specMounts},
+				Status:     datav1alpha1.DatasetStatus{Mounts: tt.statusMounts},
+			}
+			client := fake.NewFakeClientWithScheme(testScheme, dataset)
+			recorder := record.NewFakeRecorder(10)
+			engine := &JindoEngine{
+				name:      "hbase",
+				namespace: "fluid",
+				Client:    client,
+				Log:       fake.NullLogger(),
+				Recorder:  recorder,
+			}
+
+			ufsToUpdate := engine.ShouldUpdateUFS()
+			if ufsToUpdate == nil || !ufsToUpdate.ShouldUpdate() {
+				t.Fatalf("ShouldUpdateUFS() = %v, want mount points to update", ufsToUpdate)
+			}
+			updateReady, err := engine.UpdateOnUFSChange(ufsToUpdate)
+			if err != nil || updateReady {
+				t.Fatalf("UpdateOnUFSChange() = %v, %v, want not ready", updateReady, err)
+			}
+			if len(recorder.Events) != tt.wantEvents {
+				t.Errorf("got %d events, want %d", len(recorder.Events), tt.wantEvents)
+			}
+
+			updatedDataset, err := utils.GetDataset(client, "hbase", "fluid")
+			if err != nil {
+				t.Fatalf("failed to get dataset: %v", err)
+			}
+			if !reflect.DeepEqual(updatedDataset.Status.Mounts, specMounts) {
+				t.Errorf("dataset status mounts = %v, want %v", updatedDataset.Status.Mounts, specMounts)
+			}
+
+			// the change is reported only once
+			if ufsToUpdate = engine.ShouldUpdateUFS(); ufsToUpdate == nil || ufsToUpdate.ShouldUpdate() {
+				t.Errorf("ShouldUpdateUFS() = %v, want nothing to update", ufsToUpdate)
+			}
+		})
+	}
+}
@@ -48,6 +48,11 @@ func (e *JindoCacheEngine) UpdateDatasetStatus(phase datav1alpha1.DatasetPhase)
 		if phase != dataset.Status.Phase {
 			switch phase {
 			case datav1alpha1.BoundDatasetPhase:
+				// Stores dataset mount info
+				if len(datasetToUpdate.Status.Mounts) == 0 {
+					datasetToUpdate.Status.Mounts = datasetToUpdate.Spec.Mounts
+				}
+
 				// Stores binding relation between dataset and runtime
 				if len(datasetToUpdate.Status.Runtimes) == 0 {
 					datasetToUpdate.Status.Runtimes = []datav1alpha1.Runtime{}
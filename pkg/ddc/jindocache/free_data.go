@@ -0,0 +1,69 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jindocache
+
+import (
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdatafree "github.com/fluid-cloudnative/fluid/pkg/datafree"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/docker"
+)
+
+// generateDataFreeValueFile builds a DataFreeValue by extracted specifications from the given DataFree, and
+// marshals the DataFreeValue to a temporary yaml file where stores values that'll be used by fluid datafree helm chart.
+// The cache of the target paths is freed by `jindocache -uncache`.
+func (e *JindoCacheEngine) generateDataFreeValueFile(r cruntime.ReconcileRequestContext, object client.Object) (valueFileName string, err error) {
+	dataFree, ok := object.(*datav1alpha1.DataFree)
+	if !ok {
+		err = fmt.Errorf("object %v is not a DataFree", object)
+		return "", err
+	}
+
+	targetDataset, err := utils.GetDataset(r.Client, dataFree.Spec.Dataset.Name, dataFree.Spec.Dataset.Namespace)
+	if err != nil {
+		return "", err
+	}
+
+	imageName, imageTag := docker.GetWorkerImage(r.Client, dataFree.Spec.Dataset.Name, common.JindoCacheEngineImpl, dataFree.Spec.Dataset.Namespace)
+
+	defaultImageInfo := strings.Split(defaultJindofsxRuntimeImage, ":")
+	if len(imageName) == 0 {
+		imageName = defaultImageInfo[0]
+	}
+	if len(imageTag) == 0 {
+		if len(defaultImageInfo) < 2 {
+			return "", fmt.Errorf("invalid default jindocache runtime image %s", defaultJindofsxRuntimeImage)
+		}
+		imageTag = defaultImageInfo[1]
+	}
+
+	image := fmt.Sprintf("%s:%s", imageName, imageTag)
+
+	dataFreeValue, err := cdatafree.GenDataFreeValue(e.Client, targetDataset, dataFree, image)
+	if err != nil {
+		return "", err
+	}
+
+	return cdatafree.WriteDataFreeValueFile(dataFreeValue, dataFree, common.JindoCacheEngineImpl)
+}
@@ -27,10 +27,7 @@ import (
 )
 
 func (e *JindoCacheEngine) setupMasterInernal() (err error) {
-	var (
-		chartName = utils.GetChartsDirectory() + "/jindocache"
-	)
-	valueFileName, err := e.generateJindoValueFile()
+	valueFileName, chartName, err := e.GenerateRuntimeValueFile()
 	if err != nil {
 		return
 	}
@@ -46,6 +43,13 @@ func (e *JindoCacheEngine) setupMasterInernal() (err error) {
 	return helm.InstallRelease(e.name, e.namespace, valueFileName, chartName)
 }
 
+// GenerateRuntimeValueFile transforms the runtime into the value file of the runtime chart
+func (e *JindoCacheEngine) GenerateRuntimeValueFile() (valueFileName string, chartName string, err error) {
+	chartName = utils.GetChartsDirectory() + "/jindocache"
+	valueFileName, err = e.generateJindoValueFile()
+	return
+}
+
 func (e *JindoCacheEngine) generateJindoValueFile() (valueFileName string, err error) {
 	// why need to delete configmap e.name+"-jindofs-config" ? Or it should be
 	// err = kubeclient.DeleteConfigMap(e.Client, e.name+"-jindofs-config", e.namespace)
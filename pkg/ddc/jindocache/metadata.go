@@ -62,13 +62,22 @@ func (e *JindoCacheEngine) shouldSyncMetadata() (should bool, err error) {
 		return
 	}
 
-	if dataset.Status.UfsTotal != "" && dataset.Status.UfsTotal != METADATA_SYNC_NOT_DONE_MSG {
+	if !runtime.Spec.MetadataSyncPolicy.AutoSyncEnabled() {
+		e.Log.V(1).Info("Skip syncing metadata cause runtime.Spec.MetadataSyncPolicy.AutoSync=false", "runtime name", runtime.Name, "runtime namespace", runtime.Namespace)
+		should = false
+		return should, nil
+	}
+
+	if base.IsScheduledMetadataSync(dataset, METADATA_SYNC_NOT_DONE_MSG) {
 		e.Log.V(1).Info("dataset ufs is ready",
 			"dataset name", dataset.Name,
 			"dataset namespace", dataset.Namespace,
 			"ufstotal", dataset.Status.UfsTotal)
-		should = false
-		return should, nil
+		// keep waiting for the result of the scheduled metadata sync in progress
+		if e.MetadataSyncDoneCh != nil {
+			return true, nil
+		}
+		return base.ShouldScheduleMetadataSync(runtime.Spec.MetadataSyncPolicy, dataset)
 	}
 	should = true
 	return should, nil
@@ -96,6 +105,7 @@ func (e *JindoCacheEngine) syncMetadataInternal() (err error) {
 					}
 					datasetToUpdate := dataset.DeepCopy()
 					datasetToUpdate.Status.UfsTotal = result.UfsTotal
+					base.SetMetadataSyncedCondition(datasetToUpdate, result)
 					if !reflect.DeepEqual(datasetToUpdate, dataset) {
 						err = e.Client.Status().Update(context.TODO(), datasetToUpdate)
 						if err != nil {
@@ -112,6 +122,7 @@ func (e *JindoCacheEngine) syncMetadataInternal() (err error) {
 				}
 			} else {
 				e.Log.Error(result.Err, "Metadata sync failed")
+				base.RecordMetadataSyncFailure(e.Client, result, e.namespace, e.name, e.Log)
 				return result.Err
 			}
 		case <-time.After(CHECK_METADATA_SYNC_DONE_TIMEOUT_MILLISEC * time.Millisecond):
@@ -124,6 +135,10 @@ func (e *JindoCacheEngine) syncMetadataInternal() (err error) {
 			if err != nil {
 				return
 			}
+			// keep the ufs total of the dataset during the scheduled metadata sync
+			if base.IsScheduledMetadataSync(dataset, METADATA_SYNC_NOT_DONE_MSG) {
+				return
+			}
 			datasetToUpdate := dataset.DeepCopy()
 			datasetToUpdate.Status.UfsTotal = METADATA_SYNC_NOT_DONE_MSG
 			datasetToUpdate.Status.FileNum = METADATA_SYNC_NOT_DONE_MSG
@@ -175,7 +190,6 @@ func (e *JindoCacheEngine) syncMetadataInternal() (err error) {
 			if closed := base.SafeSend(resultChan, result); closed {
 				e.Log.Info("Recover from sending result to a closed channel", "result", result)
 			}
-			resultChan <- result
 		}(e.MetadataSyncDoneCh)
 	}
 	return
@@ -0,0 +1,38 @@
+/*
+  Copyright 2026 The Fluid Authors.
+
+  Licensed under the Apache License, Version 2.0 (the "License");
+  you may not use this file except in compliance with the License.
+  You may obtain a copy of the License at
+
+      http://www.apache.org/licenses/LICENSE-2.0
+
+  Unless required by applicable law or agreed to in writing, software
+  distributed under the License is distributed on an "AS IS" BASIS,
+  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+  See the License for the specific language governing permissions and
+  limitations under the License.
+*/
+
+package jindocache
+
+import (
+	"fmt"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/datamigrate"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// generateDataMigrateValueFile generates the value file of the generic migrator, which accesses the dataset through
+// its fuse PVC, so the data migrated to the dataset is written through to the UFS.
+func (e *JindoCacheEngine) generateDataMigrateValueFile(ctx cruntime.ReconcileRequestContext, object client.Object) (valueFileName string, err error) {
+	dataMigrate, ok := object.(*datav1alpha1.DataMigrate)
+	if !ok {
+		err = fmt.Errorf("object %v is not of type DataMigrate", object)
+		return "", err
+	}
+
+	return datamigrate.GenDataMigrateValueFile(e.Client, dataMigrate)
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package jindocache
 
 import (
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
 	"github.com/fluid-cloudnative/fluid/pkg/errors"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
@@ -34,6 +35,12 @@ func (e *JindoCacheEngine) GetDataOperationValueFile(ctx cruntime.ReconcileReque
 	case dataoperation.DataProcessType:
 		valueFileName, err = e.generateDataProcessValueFile(ctx, object)
 		return valueFileName, err
+	case dataoperation.DataFreeType:
+		valueFileName, err = e.generateDataFreeValueFile(ctx, object)
+		return valueFileName, err
+	case dataoperation.DataMigrateType:
+		valueFileName, err = e.generateDataMigrateValueFile(ctx, object)
+		return valueFileName, err
 	default:
 		return "", errors.NewNotSupported(
 			schema.GroupResource{
@@ -42,3 +49,13 @@ func (e *JindoCacheEngine) GetDataOperationValueFile(ctx cruntime.ReconcileReque
 			}, "JindoRuntime")
 	}
 }
+
+// GetDataOperationProgress derives the progress of the data operation from the runtime, only DataLoad is supported.
+func (e *JindoCacheEngine) GetDataOperationProgress(ctx cruntime.ReconcileRequestContext, operation dataoperation.OperationInterface,
+	previous []datav1alpha1.TargetPathProgress) (progress []datav1alpha1.TargetPathProgress, err error) {
+	if operation.GetOperationType() != dataoperation.DataLoadType {
+		return nil, nil
+	}
+
+	return e.getDataLoadProgress(operation.GetOperationObject(), previous)
+}
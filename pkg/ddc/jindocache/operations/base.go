@@ -18,6 +18,7 @@ package operations
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/fluid-cloudnative/fluid/pkg/common"
@@ -106,14 +107,17 @@ func (a JindoFileUtils) IsMounted(mountPoint string) (mounted bool, err error) {
 	return mounted, err
 }
 
-func (a JindoFileUtils) Mount(mountPathInJindo string, ufsPath string) (err error) {
+// Mount mounts the ufs path to the namespace of JindoCache, the options of the mount point are passed as -D options
+func (a JindoFileUtils) Mount(mountPathInJindo string, ufsPath string, options map[string]string) (err error) {
 
 	var (
-		command = []string{"jindocache", "-mount", mountPathInJindo, ufsPath}
+		command = []string{"jindocache"}
 		stdout  string
 		stderr  string
 	)
-	// jindo fsxadmin -mount /path oss://xyz/
+	// jindocache -Dfs.oss.endpoint=xyz -mount /path oss://xyz/
+	command = append(command, genMountOptionArgs(options)...)
+	command = append(command, "-mount", mountPathInJindo, ufsPath)
 
 	stdout, stderr, err = a.exec(command, false)
 	if err != nil {
@@ -129,6 +133,23 @@ func (a JindoFileUtils) Mount(mountPathInJindo string, ufsPath string) (err erro
 	return nil
 }
 
+// UnMount removes the mount point from the namespace of JindoCache
+func (a JindoFileUtils) UnMount(mountPathInJindo string) (err error) {
+	var (
+		command = []string{"jindocache", "-unmount", mountPathInJindo}
+		stdout  string
+		stderr  string
+	)
+
+	stdout, stderr, err = a.exec(command, false)
+	if err != nil {
+		a.log.Error(err, "JindoFileUtils.UnMount() failed", "stdout", stdout, "stderr", stderr)
+		return
+	}
+
+	return nil
+}
+
 func (a JindoFileUtils) GetUfsTotalSize(url string) (summary string, err error) {
 	var (
 		command = []string{"jindo", "fs", "-count", url}
@@ -204,3 +225,17 @@ func (a JindoFileUtils) RefreshCacheSet() (err error) {
 	}
 	return
 }
+
+// genMountOptionArgs generates the -D options in the order of the keys
+func genMountOptionArgs(options map[string]string) (args []string) {
+	keys := make([]string, 0, len(options))
+	for key := range options {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		args = append(args, fmt.Sprintf("-D%s=%s", key, options[key]))
+	}
+	return
+}
@@ -187,6 +187,7 @@ func (e *JindoCacheEngine) transform(runtime *datav1alpha1.JindoRuntime) (value
 	e.transformPlacementMode(dataset, value)
 	e.transformRunAsUser(runtime, value)
 	e.transformTolerations(dataset, runtime, value)
+	e.transformScheduling(runtime, value)
 	err = e.transformResources(runtime, value, userQuotas)
 	if err != nil {
 		return
@@ -1060,6 +1061,18 @@ func (e *JindoCacheEngine) transformTolerations(dataset *datav1alpha1.Dataset, r
 	}
 }
 
+// transformScheduling transforms the scheduling constraints of the components except the tolerations,
+// which are merged with the tolerations of the dataset in transformTolerations.
+func (e *JindoCacheEngine) transformScheduling(runtime *datav1alpha1.JindoRuntime, value *Jindo) {
+	transform := func(spec datav1alpha1.SchedulingSpec) *common.Scheduling {
+		spec.Tolerations = nil
+		return utils.TransformSchedulingSpec(spec)
+	}
+	value.Master.Scheduling = transform(runtime.Spec.Master.SchedulingSpec)
+	value.Worker.Scheduling = transform(runtime.Spec.Worker.SchedulingSpec)
+	value.Fuse.Scheduling = transform(runtime.Spec.Fuse.SchedulingSpec)
+}
+
 func (e *JindoCacheEngine) transformPodMetadata(runtime *datav1alpha1.JindoRuntime, value *Jindo) (err error) {
 	// check if setting labels with deprecated API(i.e. spec.labels)
 	deprecatedLabelSet := len(runtime.Spec.Labels) != 0 ||
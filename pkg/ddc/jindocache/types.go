@@ -73,6 +73,7 @@ type Master struct {
 	OssKey                string                        `json:"osskey,omitempty"`
 	OssSecret             string                        `json:"osssecret,omitempty"`
 	Tolerations           []corev1.Toleration           `json:"tolerations,omitempty"`
+	Scheduling            *common.Scheduling            `json:"scheduling,omitempty"`
 	DnsServer             string                        `json:"dnsServer,omitempty"`
 	NameSpace             string                        `json:"namespace,omitempty"`
 	Labels                map[string]string             `json:"labels,omitempty"`
@@ -93,6 +94,7 @@ type Worker struct {
 	WorkerProperties map[string]string   `json:"properties"`
 	Port             Ports               `json:"ports,omitempty"`
 	Tolerations      []corev1.Toleration `json:"tolerations,omitempty"`
+	Scheduling       *common.Scheduling  `json:"scheduling,omitempty"`
 	// Affinity         corev1.Affinity       `json:"affinity,omitempty"`
 	Labels                map[string]string             `json:"labels,omitempty"`
 	Annotations           map[string]string             `json:"annotations,omitempty"`
@@ -116,6 +118,7 @@ type Fuse struct {
 	Global                bool                          `json:"global,omitempty"`
 	RunAs                 string                        `json:"runAs,omitempty"`
 	Tolerations           []corev1.Toleration           `json:"tolerations,omitempty"`
+	Scheduling            *common.Scheduling            `json:"scheduling,omitempty"`
 	Labels                map[string]string             `json:"labels,omitempty"`
 	Annotations           map[string]string             `json:"annotations,omitempty"`
 	CriticalPod           bool                          `json:"criticalPod,omitempty"`
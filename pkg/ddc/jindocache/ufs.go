@@ -17,6 +17,7 @@ limitations under the License.
 package jindocache
 
 import (
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/jindocache/operations"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 )
@@ -103,11 +104,49 @@ func (e *JindoCacheEngine) GetReportSummary() (summary string, err error) {
 	return fileUtils.ReportSummary()
 }
 
-// JindoCacheEngine hasn't support UpdateOnUFSChange
+// ShouldUpdateUFS checks if the mount points of the dataset are changed and returns the mount paths to add or remove
 func (e *JindoCacheEngine) ShouldUpdateUFS() (ufsToUpdate *utils.UFSToUpdate) {
+	if e.runtime != nil && e.runtime.Spec.Master.Disabled {
+		return
+	}
+
+	// 1. get the dataset
+	dataset, err := utils.GetDataset(e.Client, e.name, e.namespace)
+	if err != nil {
+		e.Log.Error(err, "Failed to get the dataset")
+		return
+	}
+
+	// 2. get the ufs to update
+	ufsToUpdate = utils.NewUFSToUpdate(dataset)
+	ufsToUpdate.AnalyzePathsDelta()
+
 	return
 }
 
-func (e *JindoCacheEngine) UpdateOnUFSChange(*utils.UFSToUpdate) (updateReady bool, err error) {
+// UpdateOnUFSChange mounts the added mount points and unmounts the removed ones in the namespace of Jindo
+func (e *JindoCacheEngine) UpdateOnUFSChange(ufsToUpdate *utils.UFSToUpdate) (updateReady bool, err error) {
+	// 1. check if need to update ufs
+	if !ufsToUpdate.ShouldUpdate() {
+		e.Log.Info("no need to update ufs",
+			"namespace", e.namespace,
+			"name", e.name)
+		return
+	}
+
+	// 2. set update status to updating
+	err = utils.UpdateMountStatus(e.Client, e.name, e.namespace, datav1alpha1.UpdatingDatasetPhase)
+	if err != nil {
+		e.Log.Error(err, "Failed to update dataset status to updating")
+		return
+	}
+
+	// 3. process added and removed
+	updateReady, err = e.processUpdatingUFS(ufsToUpdate)
+	if err != nil {
+		e.Log.Error(err, "Failed to add or remove mount points")
+		return
+	}
+
 	return
 }
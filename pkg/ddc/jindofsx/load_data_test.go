@@ -29,6 +29,7 @@ import (
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/jindofsx/operations"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
@@ -680,3 +681,37 @@ func TestCheckRuntimeReady(t *testing.T) {
 		t.Errorf("fail to exec the function CheckRuntimeReady")
 	}
 }
+
+func TestGetDataLoadProgress(t *testing.T) {
+	engine := JindoFSxEngine{
+		namespace: "fluid",
+		name:      "hbase",
+		Log:       fake.NullLogger(),
+	}
+	dataLoad := &datav1alpha1.DataLoad{
+		Spec: datav1alpha1.DataLoadSpec{
+			Target: []datav1alpha1.TargetPath{{Path: "/a"}, {Path: "/b"}},
+		},
+	}
+
+	var countedPaths []string
+	patches := gomonkey.ApplyMethodFunc(operations.JindoFileUtils{}, "GetUfsTotalSize", func(url string) (string, error) {
+		countedPaths = append(countedPaths, url)
+		return "4096", nil
+	})
+	defer patches.Reset()
+
+	previous := []datav1alpha1.TargetPathProgress{{Path: "/a", TotalBytes: 1024}}
+	progress, err := engine.getDataLoadProgress(dataLoad, previous)
+	if err != nil {
+		t.Fatalf("fail to get dataload progress: %v", err)
+	}
+
+	want := []datav1alpha1.TargetPathProgress{{Path: "/a", TotalBytes: 1024}, {Path: "/b", TotalBytes: 4096}}
+	if !reflect.DeepEqual(progress, want) {
+		t.Errorf("expect progress %v, got %v", want, progress)
+	}
+	if !reflect.DeepEqual(countedPaths, []string{"jindo:///b"}) {
+		t.Errorf("expect only jindo:///b to be counted, got %v", countedPaths)
+	}
+}
@@ -19,11 +19,13 @@ package operations
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/fluid-cloudnative/fluid/pkg/utils/cmdguard"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+	securityutils "github.com/fluid-cloudnative/fluid/pkg/utils/security"
 	"github.com/go-logr/logr"
 )
 
@@ -57,9 +59,9 @@ func (a JindoFileUtils) exec(command []string, verbose bool) (stdout string, std
 
 	select {
 	case <-ch:
-		a.log.V(1).Info("execute in time", "command", command)
+		a.log.V(1).Info("execute in time", "command", securityutils.FilterCommand(command))
 	case <-ctx.Done():
-		err = fmt.Errorf("timeout when executing %v", command)
+		err = fmt.Errorf("timeout when executing %v", securityutils.FilterCommand(command))
 	}
 
 	return
@@ -72,14 +74,16 @@ func (a JindoFileUtils) execWithoutTimeout(command []string, verbose bool) (stdo
 		return
 	}
 
+	// redact sensitive info in command for printing
+	redactedCommand := securityutils.FilterCommand(command)
 	stdout, stderr, err = kubeclient.ExecCommandInContainer(a.podName, a.container, a.namespace, command)
 	if err != nil {
-		a.log.Info("Stdout", "Command", command, "Stdout", stdout)
-		a.log.Error(err, "Failed", "Command", command, "FailedReason", stderr)
+		a.log.Info("Stdout", "Command", redactedCommand, "Stdout", stdout)
+		a.log.Error(err, "Failed", "Command", redactedCommand, "FailedReason", stderr)
 		return
 	}
 	if verbose {
-		a.log.Info("Stdout", "Command", command, "Stdout", stdout)
+		a.log.Info("Stdout", "Command", redactedCommand, "Stdout", stdout)
 	}
 	return
 }
@@ -125,10 +129,31 @@ func (a JindoFileUtils) IsMounted(mountPoint string) (mounted bool, err error) {
 	return mounted, err
 }
 
-func (a JindoFileUtils) Mount(mountPathInJindo string, ufsPath string) (err error) {
+// Mount mounts the ufs path to the namespace of Jindo, the options of the mount point are passed as -D options
+func (a JindoFileUtils) Mount(mountPathInJindo string, ufsPath string, options map[string]string) (err error) {
 
 	var (
-		command = []string{"jindo", "admin", "-mount", mountPathInJindo, ufsPath}
+		command = []string{"jindo", "admin"}
+		stdout  string
+		stderr  string
+	)
+
+	command = append(command, genMountOptionArgs(options)...)
+	command = append(command, "-mount", mountPathInJindo, ufsPath)
+
+	stdout, stderr, err = a.exec(command, false)
+	if err != nil {
+		err = fmt.Errorf("execute command %v with expectedErr: %v stdout %s and stderr %s", securityutils.FilterCommand(command), err, stdout, stderr)
+		return
+	}
+
+	return nil
+}
+
+// UnMount removes the mount point from the namespace of Jindo
+func (a JindoFileUtils) UnMount(mountPathInJindo string) (err error) {
+	var (
+		command = []string{"jindo", "admin", "-unmount", mountPathInJindo}
 		stdout  string
 		stderr  string
 	)
@@ -180,3 +205,17 @@ func (a JindoFileUtils) Ready() (ready bool) {
 
 	return ready
 }
+
+// genMountOptionArgs generates the -D options in the order of the keys
+func genMountOptionArgs(options map[string]string) (args []string) {
+	keys := make([]string, 0, len(options))
+	for key := range options {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		args = append(args, fmt.Sprintf("-D%s=%s", key, options[key]))
+	}
+	return
+}
@@ -131,3 +131,53 @@ func TestJindoFileUtils_Ready(t *testing.T) {
 		t.Errorf("check failure, want true, got %t", ready)
 	}
 }
+
+func TestJindoFileUtils_UnMount(t *testing.T) {
+	var gotCommand []string
+	ExecCommon := func(a JindoFileUtils, command []string, verbose bool) (stdout string, stderr string, err error) {
+		gotCommand = command
+		return "", "", nil
+	}
+	ExecErr := func(a JindoFileUtils, command []string, verbose bool) (stdout string, stderr string, err error) {
+		return "", "", errors.New("fail to run the command")
+	}
+
+	a := &JindoFileUtils{log: fake.NullLogger()}
+	patches := gomonkey.ApplyPrivateMethod(JindoFileUtils{}, "exec", ExecErr)
+	defer patches.Reset()
+
+	err := a.UnMount("/spark")
+	if err == nil {
+		t.Error("check failure, want err, got nil")
+	}
+
+	patches.ApplyPrivateMethod(JindoFileUtils{}, "exec", ExecCommon)
+	err = a.UnMount("/spark")
+	if err != nil {
+		t.Errorf("check failure, want nil, got err: %v", err)
+	}
+	if gotCommand[len(gotCommand)-1] != "/spark" {
+		t.Errorf("check failure, want unmounting /spark, got command %v", gotCommand)
+	}
+}
+
+func TestJindoFileUtils_Mount(t *testing.T) {
+	var gotCommand []string
+	ExecCommon := func(a JindoFileUtils, command []string, verbose bool) (stdout string, stderr string, err error) {
+		gotCommand = command
+		return "", "", nil
+	}
+
+	a := &JindoFileUtils{log: fake.NullLogger()}
+	patches := gomonkey.ApplyPrivateMethod(JindoFileUtils{}, "exec", ExecCommon)
+	defer patches.Reset()
+
+	err := a.Mount("/spark", "oss://bucket/spark", map[string]string{"fs.oss.endpoint": "endpoint", "fs.oss.accessKeyId": "id"})
+	if err != nil {
+		t.Errorf("check failure, want nil, got err: %v", err)
+	}
+	want := []string{"jindo", "admin", "-Dfs.oss.accessKeyId=id", "-Dfs.oss.endpoint=endpoint", "-mount", "/spark", "oss://bucket/spark"}
+	if !reflect.DeepEqual(gotCommand, want) {
+		t.Errorf("check failure, want command %v, got %v", want, gotCommand)
+	}
+}
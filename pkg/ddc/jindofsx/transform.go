@@ -187,6 +187,7 @@ func (e *JindoFSxEngine) transform(runtime *datav1alpha1.JindoRuntime) (value *J
 	e.transformPlacementMode(dataset, value)
 	e.transformRunAsUser(runtime, value)
 	e.transformTolerations(dataset, runtime, value)
+	e.transformScheduling(runtime, value)
 	err = e.transformResources(runtime, value, userQuotas)
 	if err != nil {
 		return
@@ -1074,6 +1075,18 @@ func (e *JindoFSxEngine) transformTolerations(dataset *datav1alpha1.Dataset, run
 	}
 }
 
+// transformScheduling transforms the scheduling constraints of the components except the tolerations,
+// which are merged with the tolerations of the dataset in transformTolerations.
+func (e *JindoFSxEngine) transformScheduling(runtime *datav1alpha1.JindoRuntime, value *Jindo) {
+	transform := func(spec datav1alpha1.SchedulingSpec) *common.Scheduling {
+		spec.Tolerations = nil
+		return utils.TransformSchedulingSpec(spec)
+	}
+	value.Master.Scheduling = transform(runtime.Spec.Master.SchedulingSpec)
+	value.Worker.Scheduling = transform(runtime.Spec.Worker.SchedulingSpec)
+	value.Fuse.Scheduling = transform(runtime.Spec.Fuse.SchedulingSpec)
+}
+
 func (e *JindoFSxEngine) transformPodMetadata(runtime *datav1alpha1.JindoRuntime, value *Jindo) (err error) {
 	// check if setting labels with deprecated API(i.e. spec.labels)
 	deprecatedLabelSet := len(runtime.Spec.Labels) != 0 ||
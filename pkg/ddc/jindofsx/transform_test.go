@@ -80,25 +80,31 @@ var _ = Describe("TestTransformTolerations", func() {
 							}},
 						},
 						Master: datav1alpha1.JindoCompTemplateSpec{
-							Tolerations: []corev1.Toleration{{
-								Key:      "master",
-								Operator: "Equals",
-								Value:    "true",
-							}},
+							SchedulingSpec: datav1alpha1.SchedulingSpec{
+								Tolerations: []corev1.Toleration{{
+									Key:      "master",
+									Operator: "Equals",
+									Value:    "true",
+								}},
+							},
 						},
 						Worker: datav1alpha1.JindoCompTemplateSpec{
-							Tolerations: []corev1.Toleration{{
-								Key:      "worker",
-								Operator: "Equals",
-								Value:    "true",
-							}},
+							SchedulingSpec: datav1alpha1.SchedulingSpec{
+								Tolerations: []corev1.Toleration{{
+									Key:      "worker",
+									Operator: "Equals",
+									Value:    "true",
+								}},
+							},
 						},
 						Fuse: datav1alpha1.JindoFuseSpec{
-							Tolerations: []corev1.Toleration{{
-								Key:      "fuse",
-								Operator: "Equals",
-								Value:    "true",
-							}},
+							SchedulingSpec: datav1alpha1.SchedulingSpec{
+								Tolerations: []corev1.Toleration{{
+									Key:      "fuse",
+									Operator: "Equals",
+									Value:    "true",
+								}},
+							},
 						},
 					},
 				}
@@ -159,16 +165,18 @@ var _ = Describe("TestTransformTolerations", func() {
 						}},
 					},
 					Master: datav1alpha1.JindoCompTemplateSpec{
-						Tolerations: []corev1.Toleration{
-							{
-								Key:      "toleration1",
-								Operator: "Equals",
-								Value:    "master",
-							},
-							{
-								Key:      "toleration2",
-								Operator: "Equals",
-								Value:    "master",
+						SchedulingSpec: datav1alpha1.SchedulingSpec{
+							Tolerations: []corev1.Toleration{
+								{
+									Key:      "toleration1",
+									Operator: "Equals",
+									Value:    "master",
+								},
+								{
+									Key:      "toleration2",
+									Operator: "Equals",
+									Value:    "master",
+								},
 							},
 						},
 					},
@@ -1161,3 +1169,35 @@ func TestTransformMasterVolume(t *testing.T) {
 		}
 	}
 }
+
+func TestJindoFSxEngine_transformScheduling(t *testing.T) {
+	runtime := &datav1alpha1.JindoRuntime{
+		Spec: datav1alpha1.JindoRuntimeSpec{
+			Master: datav1alpha1.JindoCompTemplateSpec{
+				SchedulingSpec: datav1alpha1.SchedulingSpec{
+					Tolerations:       []corev1.Toleration{{Key: "master", Operator: corev1.TolerationOpExists}},
+					PriorityClassName: "high-priority",
+				},
+			},
+			Worker: datav1alpha1.JindoCompTemplateSpec{
+				SchedulingSpec: datav1alpha1.SchedulingSpec{
+					SchedulerName: "volcano",
+				},
+			},
+		},
+	}
+	engine := &JindoFSxEngine{Log: fake.NullLogger()}
+	value := &Jindo{}
+	engine.transformScheduling(runtime, value)
+
+	// the tolerations are transformed together with the tolerations of the dataset
+	if want := (&common.Scheduling{PriorityClassName: "high-priority"}); !reflect.DeepEqual(value.Master.Scheduling, want) {
+		t.Errorf("transformScheduling() master = %v, want %v", value.Master.Scheduling, want)
+	}
+	if want := (&common.Scheduling{SchedulerName: "volcano"}); !reflect.DeepEqual(value.Worker.Scheduling, want) {
+		t.Errorf("transformScheduling() worker = %v, want %v", value.Worker.Scheduling, want)
+	}
+	if value.Fuse.Scheduling != nil {
+		t.Errorf("transformScheduling() fuse = %v, want nil", value.Fuse.Scheduling)
+	}
+}
@@ -17,13 +17,20 @@ limitations under the License.
 package jindofsx
 
 import (
+	"context"
 	"fmt"
+	"reflect"
 	"strings"
 
+	"k8s.io/client-go/util/retry"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/jindofsx/operations"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+	securityutils "github.com/fluid-cloudnative/fluid/pkg/utils/security"
 )
 
 // shouldMountUFS checks if there's any UFS that need to be mounted
@@ -87,12 +94,144 @@ func (e *JindoFSxEngine) mountUFS() (err error) {
 		}
 		if !mounted {
 			mountPathInJindo := utils.UFSPathBuilder{}.GenUFSPathInUnifiedNamespace(mount)
-			err = fileUtils.Mount(mountPathInJindo, mount.MountPoint)
+			// the options are rendered into the config of the master when it starts
+			err = fileUtils.Mount(mountPathInJindo, mount.MountPoint, nil)
 			if err != nil {
 				return err
 			}
 		}
 
 	}
-	return nil
+	return e.updateMountsInRuntimeStatus(dataset.Spec.Mounts)
+}
+
+// processUpdatingUFS mounts the added mount points and unmounts the removed mount points according to ufsToUpdate
+func (e *JindoFSxEngine) processUpdatingUFS(ufsToUpdate *utils.UFSToUpdate) (updateReady bool, err error) {
+	dataset, err := utils.GetDataset(e.Client, e.name, e.namespace)
+	if err != nil {
+		return false, err
+	}
+
+	podName, containerName := e.getMasterPodInfo()
+	fileUtils := operations.NewJindoFileUtils(podName, containerName, e.namespace, e.Log)
+
+	ready := fileUtils.Ready()
+	if !ready {
+		return false, fmt.Errorf("the UFS is not ready, namespace:%s,name:%s", e.namespace, e.name)
+	}
+
+	// Iterate all the mount points, do mount if the mount point is in added array
+	for _, mount := range dataset.Spec.Mounts {
+		if common.IsFluidNativeScheme(mount.MountPoint) {
+			continue
+		}
+
+		mountPathInJindo := utils.UFSPathBuilder{}.GenUFSPathInUnifiedNamespace(mount)
+		if !utils.ContainsString(ufsToUpdate.ToAdd(), mountPathInJindo) {
+			continue
+		}
+
+		// the mount points of the datasets bound before they are recorded in the dataset status may be mounted already
+		mounted, err := fileUtils.IsMounted(mountPathInJindo)
+		if err != nil {
+			return false, err
+		}
+		if mounted {
+			continue
+		}
+
+		// the mount points added dynamically are not rendered into the config of the master, so the options are passed
+		options, err := e.genUFSMountOptions(mount, dataset.Spec.SharedOptions, dataset.Spec.SharedEncryptOptions)
+		if err != nil {
+			return false, err
+		}
+
+		if strings.HasPrefix(mount.MountPoint, common.VolumeScheme.String()) {
+			ufsVolumesPath := utils.UFSPathBuilder{}.GenLocalStoragePath(mount)
+			mount.MountPoint = "local://" + ufsVolumesPath
+		}
+		err = fileUtils.Mount(mountPathInJindo, mount.MountPoint, options)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	// unmount the mount point in the removed array
+	for _, mountPathInJindo := range ufsToUpdate.ToRemove() {
+		err = fileUtils.UnMount(mountPathInJindo)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	err = e.updateMountsInRuntimeStatus(dataset.Spec.Mounts)
+	if err != nil {
+		return false, err
+	}
+
+	// need to reset ufsTotal to Calculating so that SyncMetadata will work
+	datasetToUpdate := dataset.DeepCopy()
+	datasetToUpdate.Status.UfsTotal = METADATA_SYNC_NOT_DONE_MSG
+	if !reflect.DeepEqual(dataset.Status, datasetToUpdate.Status) {
+		err = e.Client.Status().Update(context.TODO(), datasetToUpdate)
+		if err != nil {
+			e.Log.Error(err, "fail to update ufsTotal of dataset to Calculating")
+		}
+	}
+
+	err = e.SyncMetadata()
+	if err != nil {
+		// just report this error and ignore it because SyncMetadata isn't on the critical path of updating mount points
+		e.Log.Error(err, "SyncMetadata", "dataset", e.name)
+	}
+
+	return true, nil
+}
+
+// genUFSMountOptions merges the shared options and the options of the mount point, the encrypt options are read from
+// the secrets and redacted in the logs
+func (e *JindoFSxEngine) genUFSMountOptions(m datav1alpha1.Mount, sharedOptions map[string]string,
+	sharedEncryptOptions []datav1alpha1.EncryptOption) (map[string]string, error) {
+	options := map[string]string{}
+	for key, value := range sharedOptions {
+		options[key] = value
+	}
+	for key, value := range m.Options {
+		options[key] = value
+	}
+
+	for _, encryptOption := range append(append([]datav1alpha1.EncryptOption{}, sharedEncryptOptions...), m.EncryptOptions...) {
+		secretKeyRef := encryptOption.ValueFrom.SecretKeyRef
+		secret, err := kubeclient.GetSecret(e.Client, secretKeyRef.Name, e.namespace)
+		if err != nil {
+			return nil, err
+		}
+		securityutils.UpdateSensitiveKey(encryptOption.Name)
+		options[encryptOption.Name] = string(secret.Data[secretKeyRef.Key])
+	}
+	return options, nil
+}
+
+// updateMountsInRuntimeStatus reports the mount points in the runtime status without options which may contain secrets
+func (e *JindoFSxEngine) updateMountsInRuntimeStatus(mounts []datav1alpha1.Mount) error {
+	var statusMounts []datav1alpha1.Mount
+	for _, mount := range mounts {
+		optionExcludedMount := mount.DeepCopy()
+		optionExcludedMount.EncryptOptions = nil
+		optionExcludedMount.Options = nil
+		statusMounts = append(statusMounts, *optionExcludedMount)
+	}
+
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		runtime, err := e.getRuntime()
+		if err != nil {
+			return err
+		}
+		if reflect.DeepEqual(runtime.Status.Mounts, statusMounts) {
+			return nil
+		}
+		runtimeToUpdate := runtime.DeepCopy()
+		runtimeToUpdate.Status.Mounts = statusMounts
+		return e.Client.Status().Update(context.TODO(), runtimeToUpdate)
+	})
 }
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jindofsx
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/jindofsx/operations"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestUpdateOnUFSChange(t *testing.T) {
+	dataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "fluid"},
+		Spec: datav1alpha1.DatasetSpec{
+			Mounts: []datav1alpha1.Mount{
+				{Name: "spark", MountPoint: "oss://bucket/spark", Options: map[string]string{"fs.oss.endpoint": "endpoint"}},
+				{Name: "hive", MountPoint: "oss://bucket/hive", Options: map[string]string{"fs.oss.endpoint": "hive-endpoint"},
+					EncryptOptions: []datav1alpha1.EncryptOption{{Name: "fs.oss.accessKeySecret", ValueFrom: datav1alpha1.EncryptOptionSource{
+						SecretKeyRef: datav1alpha1.SecretKeySelector{Name: "oss-secret", Key: "secret"},
+					}}}},
+			},
+			SharedOptions: map[string]string{"fs.oss.endpoint": "endpoint", "fs.oss.accessKeyId": "id"},
+		},
+		Status: datav1alpha1.DatasetStatus{
+			Mounts: []datav1alpha1.Mount{
+				{Name: "spark", MountPoint: "oss://bucket/spark"},
+				{Name: "hadoop", MountPoint: "oss://bucket/hadoop"},
+			},
+		},
+	}
+	runtime := &datav1alpha1.JindoRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "fluid"},
+		Spec: datav1alpha1.JindoRuntimeSpec{
+			MetadataSyncPolicy: datav1alpha1.MetadataSyncPolicy{AutoSync: ptr.To(false)},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "oss-secret", Namespace: "fluid"},
+		Data:       map[string][]byte{"secret": []byte("key")},
+	}
+	client := fake.NewFakeClientWithScheme(testScheme, dataset, runtime, secret)
+	engine := &JindoFSxEngine{
+		name:      "hbase",
+		namespace: "fluid",
+		runtime:   runtime,
+		Client:    client,
+		Log:       fake.NullLogger(),
+	}
+
+	var mounted, unmounted []string
+	patches := gomonkey.ApplyMethod(operations.JindoFileUtils{}, "Ready", func(_ operations.JindoFileUtils) bool {
+		return true
+	})
+	defer patches.Reset()
+	patches.ApplyMethod(operations.JindoFileUtils{}, "IsMounted", func(_ operations.JindoFileUtils, mountPoint string) (bool, error) {
+		return false, nil
+	})
+	var mountOptions map[string]string
+	patches.ApplyMethod(operations.JindoFileUtils{}, "Mount", func(_ operations.JindoFileUtils, mountPathInJindo string, ufsPath string, options map[string]string) error {
+		mounted = append(mounted, mountPathInJindo+"="+ufsPath)
+		mountOptions = options
+		return nil
+	})
+	patches.ApplyMethod(operations.JindoFileUtils{}, "UnMount", func(_ operations.JindoFileUtils, mountPathInJindo string) error {
+		unmounted = append(unmounted, mountPathInJindo)
+		return nil
+	})
+
+	ufsToUpdate := engine.ShouldUpdateUFS()
+	if ufsToUpdate == nil || !ufsToUpdate.ShouldUpdate() {
+		t.Fatalf("ShouldUpdateUFS() = %v, want mount points to update", ufsToUpdate)
+	}
+
+	updateReady, err := engine.UpdateOnUFSChange(ufsToUpdate)
+	if err != nil || !updateReady {
+		t.Fatalf("UpdateOnUFSChange() = %v, %v, want ready", updateReady, err)
+	}
+	if want := []string{"/hive=oss://bucket/hive"}; !reflect.DeepEqual(mounted, want) {
+		t.Errorf("mounted %v, want %v", mounted, want)
+	}
+	wantOptions := map[string]string{"fs.oss.endpoint": "hive-endpoint", "fs.oss.accessKeyId": "id", "fs.oss.accessKeySecret": "key"}
+	if !reflect.DeepEqual(mountOptions, wantOptions) {
+		t.Errorf("mount options %v, want %v", mountOptions, wantOptions)
+	}
+	if want := []string{"/hadoop"}; !reflect.DeepEqual(unmounted, want) {
+		t.Errorf("unmounted %v, want %v", unmounted, want)
+	}
+
+	updatedDataset, err := utils.GetDataset(client, "hbase", "fluid")
+	if err != nil {
+		t.Fatalf("failed to get dataset: %v", err)
+	}
+	if updatedDataset.Status.Phase != datav1alpha1.UpdatingDatasetPhase {
+		t.Errorf("dataset phase = %v, want %v", updatedDataset.Status.Phase, datav1alpha1.UpdatingDatasetPhase)
+	}
+
+	updatedRuntime := &datav1alpha1.JindoRuntime{}
+	if err = client.Get(context.TODO(), types.NamespacedName{Name: "hbase", Namespace: "fluid"}, updatedRuntime); err != nil {
+		t.Fatalf("failed to get runtime: %v", err)
+	}
+	wantMounts := []datav1alpha1.Mount{
+		{Name: "spark", MountPoint: "oss://bucket/spark"},
+		{Name: "hive", MountPoint: "oss://bucket/hive"},
+	}
+	if !reflect.DeepEqual(updatedRuntime.Status.Mounts, wantMounts) {
+		t.Errorf("runtime status mounts = %v, want %v", updatedRuntime.Status.Mounts, wantMounts)
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package juicefs
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdatabackup "github.com/fluid-cloudnative/fluid/pkg/databackup"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/docker"
+)
+
+// generateDataBackupValueFile builds a DataBackupValue by extracted specifications from the given DataBackup, and
+// marshals the DataBackupValue to a temporary yaml file where stores values that'll be used by fluid dataBackup helm chart.
+// Only the community edition is supported, the metadata engine is dumped by `juicefs dump`.
+func (j *JuiceFSEngine) generateDataBackupValueFile(ctx cruntime.ReconcileRequestContext, object client.Object) (valueFileName string, err error) {
+	databackup, ok := object.(*datav1alpha1.DataBackup)
+	if !ok {
+		err = fmt.Errorf("object %v is not a DataBackup", object)
+		return "", err
+	}
+
+	runtime, err := j.getRuntime()
+	if err != nil {
+		return "", err
+	}
+
+	fsInfo, err := GetFSInfoFromConfigMap(j.Client, j.name, j.namespace)
+	if err != nil {
+		return "", err
+	}
+	if fsInfo[Edition] != CommunityEdition {
+		err = fmt.Errorf("DataBackup is only supported by JuiceFS community edition, but the edition of %s/%s is %s", j.namespace, j.name, fsInfo[Edition])
+		return "", err
+	}
+
+	imageName, imageTag, _, err := j.parseJuiceFSImage(CommunityEdition, runtime.Spec.JuiceFSVersion.Image, runtime.Spec.JuiceFSVersion.ImageTag, runtime.Spec.JuiceFSVersion.ImagePullPolicy)
+	if err != nil {
+		return "", err
+	}
+
+	workdir := os.Getenv("FLUID_WORKDIR")
+	if workdir == "" {
+		workdir = "/tmp"
+	}
+
+	// image pull secrets
+	// if the environment variable is not set, it is still an empty slice
+	imagePullSecrets := docker.GetImagePullSecretsFromEnv(common.EnvImagePullSecretsKey)
+
+	dataBackup := cdatabackup.DataBackup{
+		Namespace:        databackup.Namespace,
+		Dataset:          databackup.Spec.Dataset,
+		Name:             databackup.Name,
+		Image:            fmt.Sprintf("%s:%s", imageName, imageTag),
+		Workdir:          workdir,
+		RuntimeType:      common.JuiceFSRuntime,
+		ImagePullSecrets: imagePullSecrets,
+		MetaUrlSecret:    fsInfo[MetaurlSecret],
+		MetaUrlSecretKey: fsInfo[MetaurlSecretKey],
+	}
+	pvcName, path, err := utils.ParseBackupRestorePath(databackup.Spec.BackupPath)
+	if err != nil {
+		return
+	}
+	dataBackup.PVCName = pvcName
+	dataBackup.Path = path
+
+	// inject the node affinity by previous operation pod.
+	dataBackup.Affinity, err = dataflow.InjectAffinityByRunAfterOp(j.Client, databackup.Spec.RunAfter, databackup.Namespace, nil)
+	if err != nil {
+		return "", err
+	}
+
+	dataBackupValue := cdatabackup.DataBackupValue{DataBackup: dataBackup}
+
+	dataBackupValue.InitUsers = common.InitUsers{
+		Enabled: false,
+	}
+
+	// databackup.Spec.RunAs > root
+	if runAs := databackup.Spec.RunAs; runAs != nil {
+		dataBackupValue.UserInfo.User = int(*runAs.UID)
+		dataBackupValue.UserInfo.Group = int(*runAs.GID)
+		dataBackupValue.UserInfo.FSGroup = 0
+	}
+
+	data, err := yaml.Marshal(dataBackupValue)
+	if err != nil {
+		return
+	}
+
+	valueFile, err := os.CreateTemp(os.TempDir(), fmt.Sprintf("%s-%s-%s-backuper-values.yaml", databackup.Namespace, databackup.Name, dataBackup.RuntimeType))
+	if err != nil {
+		return
+	}
+
+	err = os.WriteFile(valueFile.Name(), data, 0400)
+	if err != nil {
+		return
+	}
+
+	return valueFile.Name(), nil
+}
+
+// getMetadataBackupFileName returns the name of the metadata dump file, the `.json.gz` suffix makes
+// `juicefs dump` compress the file and `juicefs load` decompress it.
+func (j *JuiceFSEngine) getMetadataBackupFileName() string {
+	return "metadata-backup-" + j.name + "-" + j.namespace + ".json.gz"
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package juicefs
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	cdatabackup "github.com/fluid-cloudnative/fluid/pkg/databackup"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestJuiceFSEngine_generateDataBackupValueFile(t *testing.T) {
+	juicefsRuntime := &datav1alpha1.JuiceFSRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "jfsdemo", Namespace: "default"},
+	}
+	ceConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "jfsdemo-juicefs-values", Namespace: "default"},
+		Data: map[string]string{
+			"data": "edition: community\nconfigs:\n  metaurlSecret: jfs-secret\n  metaurlSecretKey: metaurl\n",
+		},
+	}
+	eeConfigMap := ceConfigMap.DeepCopy()
+	eeConfigMap.Data["data"] = "edition: enterprise\nconfigs:\n  tokenSecret: jfs-secret\n  tokenSecretKey: token\n"
+
+	databackup := &datav1alpha1.DataBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "jfsdemo-backup", Namespace: "default"},
+		Spec: datav1alpha1.DataBackupSpec{
+			Dataset:    "jfsdemo",
+			BackupPath: "pvc://backup-pvc/jfsdemo",
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		objs    []runtime.Object
+		object  *datav1alpha1.DataBackup
+		wantErr bool
+	}{
+		{
+			name:    "community edition",
+			objs:    []runtime.Object{juicefsRuntime, ceConfigMap},
+			object:  databackup,
+			wantErr: false,
+		},
+		{
+			name:    "enterprise edition",
+			objs:    []runtime.Object{juicefsRuntime, eeConfigMap},
+			object:  databackup,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		engine := &JuiceFSEngine{
+			name:      "jfsdemo",
+			namespace: "default",
+			Client:    fake.NewFakeClientWithScheme(testScheme, tc.objs...),
+			Log:       fake.NullLogger(),
+		}
+		ctx := cruntime.ReconcileRequestContext{Log: fake.NullLogger()}
+
+		valueFileName, err := engine.generateDataBackupValueFile(ctx, tc.object)
+		if (err != nil) != tc.wantErr {
+			t.Fatalf("testcase %s: expect error %v, got %v", tc.name, tc.wantErr, err)
+		}
+		if tc.wantErr {
+			continue
+		}
+
+		data, err := os.ReadFile(valueFileName)
+		if err != nil {
+			t.Fatalf("testcase %s: failed to read value file: %v", tc.name, err)
+		}
+		var value cdatabackup.DataBackupValue
+		if err = yaml.Unmarshal(data, &value); err != nil {
+			t.Fatalf("testcase %s: failed to unmarshal value file: %v", tc.name, err)
+		}
+		if value.DataBackup.PVCName != "backup-pvc" || value.DataBackup.Path != "/jfsdemo/" {
+			t.Errorf("testcase %s: unexpected backup location %s%s", tc.name, value.DataBackup.PVCName, value.DataBackup.Path)
+		}
+		if value.DataBackup.MetaUrlSecret != "jfs-secret" || value.DataBackup.MetaUrlSecretKey != "metaurl" {
+			t.Errorf("testcase %s: unexpected metaurl secret %s/%s", tc.name, value.DataBackup.MetaUrlSecret, value.DataBackup.MetaUrlSecretKey)
+		}
+	}
+}
+
+func TestJuiceFSEngine_transformWorkerRestore(t *testing.T) {
+	testCases := []struct {
+		name             string
+		edition          string
+		location         *datav1alpha1.DataRestoreLocation
+		wantErr          bool
+		wantRestoreCmd   string
+		wantNodeSelector map[string]string
+	}{
+		{
+			name:    "no restore location",
+			edition: CommunityEdition,
+		},
+		{
+			name:     "enterprise edition",
+			edition:  EnterpriseEdition,
+			location: &datav1alpha1.DataRestoreLocation{Path: "pvc://backup-pvc/jfsdemo"},
+			wantErr:  true,
+		},
+		{
+			name:           "restore from pvc",
+			edition:        CommunityEdition,
+			location:       &datav1alpha1.DataRestoreLocation{Path: "pvc://backup-pvc/jfsdemo"},
+			wantRestoreCmd: "/juicefs_backups/jfsdemo/metadata-backup-jfsdemo-default.json.gz",
+		},
+		{
+			name:             "restore from local path",
+			edition:          CommunityEdition,
+			location:         &datav1alpha1.DataRestoreLocation{Path: "local:///mnt/backup", NodeName: "node1"},
+			wantRestoreCmd:   "/juicefs_backups/metadata-backup-jfsdemo-default.json.gz",
+			wantNodeSelector: map[string]string{"kubernetes.io/hostname": "node1"},
+		},
+		{
+			name:     "restore from local path without node name",
+			edition:  CommunityEdition,
+			location: &datav1alpha1.DataRestoreLocation{Path: "local:///mnt/backup"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		engine := &JuiceFSEngine{name: "jfsdemo", namespace: "default", Log: fake.NullLogger()}
+		dataset := &datav1alpha1.Dataset{Spec: datav1alpha1.DatasetSpec{DataRestoreLocation: tc.location}}
+		value := &JuiceFS{Edition: tc.edition, Source: "${METAURL}", Worker: Worker{NodeSelector: map[string]string{}}}
+
+		err := engine.transformWorkerRestore(dataset, value)
+		if (err != nil) != tc.wantErr {
+			t.Fatalf("testcase %s: expect error %v, got %v", tc.name, tc.wantErr, err)
+		}
+		if tc.wantErr {
+			continue
+		}
+
+		if tc.wantRestoreCmd == "" {
+			if value.Configs.RestoreCmd != "" || len(value.Worker.Volumes) != 0 {
+				t.Errorf("testcase %s: expect no restore, got cmd %q", tc.name, value.Configs.RestoreCmd)
+			}
+			continue
+		}
+		if !strings.HasSuffix(value.Configs.RestoreCmd, "load ${METAURL} "+tc.wantRestoreCmd) {
+			t.Errorf("testcase %s: unexpected restore cmd %q", tc.name, value.Configs.RestoreCmd)
+		}
+		if len(value.Worker.Volumes) != 1 || len(value.Worker.VolumeMounts) != 1 {
+			t.Errorf("testcase %s: expect restore volume to be mounted, got %v", tc.name, value.Worker.Volumes)
+		}
+		for k, v := range tc.wantNodeSelector {
+			if value.Worker.NodeSelector[k] != v {
+				t.Errorf("testcase %s: expect node selector %s=%s, got %v", tc.name, k, v, value.Worker.NodeSelector)
+			}
+		}
+	}
+}
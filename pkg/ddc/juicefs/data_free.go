@@ -0,0 +1,140 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package juicefs
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdatafree "github.com/fluid-cloudnative/fluid/pkg/datafree"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/docker"
+)
+
+// generateDataFreeValueFile builds a DataFreeValue by extracted specifications from the given DataFree, and
+// marshals the DataFreeValue to a temporary yaml file where stores values that'll be used by fluid datafree helm chart.
+// The cache of the target paths is evicted by `juicefs warmup --evict` in the worker pods.
+func (j *JuiceFSEngine) generateDataFreeValueFile(r cruntime.ReconcileRequestContext, object client.Object) (valueFileName string, err error) {
+	dataFree, ok := object.(*datav1alpha1.DataFree)
+	if !ok {
+		err = fmt.Errorf("object %v is not a DataFree", object)
+		return "", err
+	}
+
+	targetDataset, err := utils.GetDataset(r.Client, dataFree.Spec.Dataset.Name, dataFree.Spec.Dataset.Namespace)
+	if err != nil {
+		return "", err
+	}
+
+	imageName, imageTag := docker.GetWorkerImage(r.Client, dataFree.Spec.Dataset.Name, common.JuiceFSRuntime, dataFree.Spec.Dataset.Namespace)
+	if len(imageName) == 0 || len(imageTag) == 0 {
+		defaultJuiceFSImage := common.DefaultCEImage
+		if j.GetEdition() == EnterpriseEdition {
+			defaultJuiceFSImage = common.DefaultEEImage
+		}
+		defaultImageInfo := strings.Split(defaultJuiceFSImage, ":")
+		if len(defaultImageInfo) < 2 {
+			return "", fmt.Errorf("invalid default juicefs image %s", defaultJuiceFSImage)
+		}
+		if len(imageName) == 0 {
+			imageName = defaultImageInfo[0]
+		}
+		if len(imageTag) == 0 {
+			imageTag = defaultImageInfo[1]
+		}
+	}
+
+	cacheinfo, err := GetCacheInfoFromConfigmap(j.Client, dataFree.Spec.Dataset.Name, dataFree.Spec.Dataset.Namespace)
+	if err != nil {
+		return "", err
+	}
+
+	pods, err := j.GetRunningPodsOfStatefulSet(j.getWorkerName(), j.namespace)
+	if err != nil {
+		return "", err
+	}
+	if len(pods) == 0 {
+		return "", fmt.Errorf("no running worker pod of JuiceFSRuntime %s/%s, cannot free the cache", j.namespace, j.name)
+	}
+
+	dataFreeValue, err := j.genDataFreeValue(fmt.Sprintf("%s:%s", imageName, imageTag), cacheinfo, pods, targetDataset, dataFree)
+	if err != nil {
+		return "", err
+	}
+
+	return cdatafree.WriteDataFreeValueFile(dataFreeValue, dataFree, common.JuiceFSRuntime)
+}
+
+func (j *JuiceFSEngine) genDataFreeValue(image string, cacheinfo map[string]string, pods []v1.Pod, targetDataset *datav1alpha1.Dataset, dataFree *datav1alpha1.DataFree) (*cdatafree.DataFreeValue, error) {
+	dataFreeValue, err := cdatafree.GenDataFreeValue(j.Client, targetDataset, dataFree, image)
+	if err != nil {
+		return nil, err
+	}
+
+	options := map[string]string{}
+	for key, value := range cacheinfo {
+		options[key] = value
+	}
+
+	podNames := []string{}
+	for _, pod := range pods {
+		podNames = append(podNames, pod.Name)
+	}
+	// the cache of community edition is local to each worker, while the one of enterprise edition is
+	// distributed, so the cache is evicted in every worker for community edition only.
+	if cacheinfo[Edition] == CommunityEdition {
+		options["podNames"] = strings.Join(podNames, ":")
+	} else {
+		options["podNames"] = podNames[0]
+	}
+	options["edition"] = cacheinfo[Edition]
+	options["runtimeName"] = j.name
+
+	timeout := DefaultDataLoadTimeout
+	evictOptions := []string{}
+	keys := make([]string, 0, len(dataFree.Spec.Options))
+	for k := range dataFree.Spec.Options {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v := dataFree.Spec.Options[k]
+		if k == "timeout" {
+			if v != "" {
+				timeout = v
+			}
+			continue
+		}
+		if v != "" {
+			evictOptions = append(evictOptions, fmt.Sprintf("--%s=%s", k, v))
+		} else {
+			evictOptions = append(evictOptions, fmt.Sprintf("--%s", k))
+		}
+	}
+	options["option"] = strings.Join(evictOptions, " ")
+	options["timeout"] = timeout
+
+	dataFreeValue.DataFreeInfo.Options = options
+	return dataFreeValue, nil
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package juicefs
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestJuiceFSEngine_genDataFreeValue(t *testing.T) {
+	targetDataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-dataset", Namespace: "fluid"},
+	}
+	pods := []v1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Name: "test-worker-0", Namespace: "fluid"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "test-worker-1", Namespace: "fluid"}},
+	}
+
+	testCases := []struct {
+		name        string
+		cacheInfo   map[string]string
+		options     map[string]string
+		paths       []string
+		wantOptions map[string]string
+		wantPaths   []string
+	}{
+		{
+			name:      "community edition evicts cache in every worker",
+			cacheInfo: map[string]string{Edition: CommunityEdition, "mountpath": "/runtime-mnt/juicefs/fluid/test-dataset/juicefs-fuse"},
+			options:   map[string]string{"threads": "10", "timeout": "1h"},
+			paths:     []string{"/a"},
+			wantOptions: map[string]string{
+				Edition:       CommunityEdition,
+				"mountpath":   "/runtime-mnt/juicefs/fluid/test-dataset/juicefs-fuse",
+				"podNames":    "test-worker-0:test-worker-1",
+				"runtimeName": "test-dataset",
+				"option":      "--threads=10",
+				"timeout":     "1h",
+			},
+			wantPaths: []string{"/a"},
+		},
+		{
+			name:      "enterprise edition evicts cache in one worker",
+			cacheInfo: map[string]string{Edition: EnterpriseEdition},
+			wantOptions: map[string]string{
+				Edition:       EnterpriseEdition,
+				"podNames":    "test-worker-0",
+				"runtimeName": "test-dataset",
+				"option":      "",
+				"timeout":     DefaultDataLoadTimeout,
+			},
+			wantPaths: []string{"/"},
+		},
+	}
+
+	for _, tc := range testCases {
+		engine := JuiceFSEngine{
+			name:      "test-dataset",
+			namespace: "fluid",
+			Client:    fake.NewFakeClientWithScheme(testScheme),
+			Log:       fake.NullLogger(),
+		}
+		dataFree := &datav1alpha1.DataFree{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-datafree", Namespace: "fluid"},
+			Spec: datav1alpha1.DataFreeSpec{
+				Dataset: datav1alpha1.TargetDataset{Name: "test-dataset", Namespace: "fluid"},
+				Paths:   tc.paths,
+				Options: tc.options,
+			},
+		}
+
+		value, err := engine.genDataFreeValue("juicedata/juicefs-fuse:ce-v1.1.0", tc.cacheInfo, pods, targetDataset, dataFree)
+		if err != nil {
+			t.Fatalf("testcase %s: unexpected error %v", tc.name, err)
+		}
+		if !reflect.DeepEqual(value.DataFreeInfo.Options, tc.wantOptions) {
+			t.Errorf("testcase %s: expect options %v, got %v", tc.name, tc.wantOptions, value.DataFreeInfo.Options)
+		}
+		if !reflect.DeepEqual(value.DataFreeInfo.TargetPaths, tc.wantPaths) {
+			t.Errorf("testcase %s: expect target paths %v, got %v", tc.name, tc.wantPaths, value.DataFreeInfo.TargetPaths)
+		}
+		if _, ok := dataFree.Spec.Options["timeout"]; len(tc.options) > 0 && !ok {
+			t.Errorf("testcase %s: the options of DataFree should not be modified", tc.name)
+		}
+	}
+}
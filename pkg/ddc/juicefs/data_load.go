@@ -33,6 +33,7 @@ import (
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/juicefs/operations"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/docker"
@@ -167,7 +168,7 @@ func (j *JuiceFSEngine) genDataLoadValue(image string, cacheinfo map[string]stri
 	}
 
 	targetPaths := []cdataload.TargetPath{}
-	for _, target := range dataload.Spec.Target {
+	for _, target := range cdataload.GetTargetsToLoad(dataload) {
 		fluidNative := utils.IsTargetPathUnderFluidNativeMounts(target.Path, *targetDataset)
 		path := strings.TrimSpace(target.Path)
 		targetPaths = append(targetPaths, cdataload.TargetPath{
@@ -223,6 +224,47 @@ func (j *JuiceFSEngine) genDataLoadValue(image string, cacheinfo map[string]stri
 	return dataLoadValue, nil
 }
 
+// getDataLoadProgress reports the total bytes and files under the target paths. JuiceFS doesn't expose the cached bytes
+// of a path, so the paths are only regarded as loaded when the DataLoad job succeeds. The totals are only counted once
+// because it's costly for large directories.
+func (j *JuiceFSEngine) getDataLoadProgress(object client.Object, previous []datav1alpha1.TargetPathProgress) (progress []datav1alpha1.TargetPathProgress, err error) {
+	dataLoad, ok := object.(*datav1alpha1.DataLoad)
+	if !ok {
+		return nil, fmt.Errorf("object %v is not a DataLoad", object)
+	}
+
+	pods, err := j.GetRunningPodsOfStatefulSet(j.getWorkerName(), j.namespace)
+	if err != nil || len(pods) == 0 {
+		return nil, err
+	}
+
+	fileUtils := operations.NewJuiceFileUtils(pods[0].Name, common.JuiceFSWorkerContainer, j.namespace, j.Log)
+	for _, path := range cdataload.GetTargetPaths(dataLoad) {
+		pathProgress := datav1alpha1.TargetPathProgress{Path: path}
+		if last := cdataload.FindProgress(previous, path); last != nil {
+			pathProgress.TotalBytes, pathProgress.TotalFiles = last.TotalBytes, last.TotalFiles
+		}
+
+		juicefsPath := j.getMountPoint() + path
+		if pathProgress.TotalBytes == 0 {
+			pathProgress.TotalBytes, err = fileUtils.Count(juicefsPath)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if pathProgress.TotalFiles == 0 {
+			pathProgress.TotalFiles, err = fileUtils.GetFileCount(juicefsPath)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		progress = append(progress, pathProgress)
+	}
+
+	return progress, nil
+}
+
 func (j *JuiceFSEngine) CheckRuntimeReady() (ready bool) {
 	stsName := j.getWorkerName()
 	pods, err := j.GetRunningPodsOfStatefulSet(stsName, j.namespace)
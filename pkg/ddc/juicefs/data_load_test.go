@@ -24,7 +24,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/agiledragon/gomonkey/v2"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/juicefs/operations"
 
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
@@ -969,3 +971,52 @@ func TestJuiceFSEngine_genDataLoadValue(t *testing.T) {
 		}
 	}
 }
+
+func TestJuiceFSEngine_getDataLoadProgress(t *testing.T) {
+	engine := &JuiceFSEngine{
+		name:      "test",
+		namespace: "default",
+		Log:       fake.NullLogger(),
+	}
+	dataLoad := &datav1alpha1.DataLoad{
+		Spec: datav1alpha1.DataLoadSpec{
+			Target: []datav1alpha1.TargetPath{{Path: "/a"}},
+		},
+	}
+
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(engine), "GetRunningPodsOfStatefulSet",
+		func(_ *JuiceFSEngine, stsName string, namespace string) ([]v1.Pod, error) {
+			return []v1.Pod{{ObjectMeta: metav1.ObjectMeta{Name: "test-worker-0", Namespace: "default"}}}, nil
+		})
+	defer patches.Reset()
+
+	var countedPath string
+	patches.ApplyMethodFunc(operations.JuiceFileUtils{}, "Count", func(juiceSubPath string) (int64, error) {
+		countedPath = juiceSubPath
+		return 4096, nil
+	})
+	patches.ApplyMethodFunc(operations.JuiceFileUtils{}, "GetFileCount", func(juiceSubPath string) (int64, error) {
+		return 4, nil
+	})
+
+	progress, err := engine.getDataLoadProgress(dataLoad, nil)
+	if err != nil {
+		t.Fatalf("fail to get dataload progress: %v", err)
+	}
+	want := []datav1alpha1.TargetPathProgress{{Path: "/a", TotalBytes: 4096, TotalFiles: 4}}
+	if !reflect.DeepEqual(progress, want) {
+		t.Errorf("expect progress %v, got %v", want, progress)
+	}
+	if !strings.HasSuffix(countedPath, "/default/test/juicefs-fuse/a") {
+		t.Errorf("expect the path to be counted in the mount point, got %s", countedPath)
+	}
+
+	// the totals are reused once counted
+	patches.ApplyMethodFunc(operations.JuiceFileUtils{}, "Count", func(juiceSubPath string) (int64, error) {
+		t.Errorf("expect total bytes not to be counted again")
+		return 0, nil
+	})
+	if _, err = engine.getDataLoadProgress(dataLoad, progress); err != nil {
+		t.Errorf("fail to get dataload progress: %v", err)
+	}
+}
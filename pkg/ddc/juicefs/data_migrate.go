@@ -103,6 +103,7 @@ func (j *JuiceFSEngine) generateDataMigrateValueFile(r cruntime.ReconcileRequest
 		ImagePullSecrets: imagePullSecrets,
 		Policy:           string(dataMigrate.Spec.Policy),
 		Schedule:         dataMigrate.Spec.Schedule,
+		Mode:             string(dataMigrate.Spec.Mode),
 		Resources:        dataMigrate.Spec.Resources,
 		Parallelism:      dataMigrate.Spec.Parallelism,
 	}
@@ -230,6 +231,10 @@ func addWorkerPodPreferredAntiAffinity(dataMigrateInfo *cdatamigrate.DataMigrate
 
 func (j *JuiceFSEngine) setParallelMigrateOptions(dataMigrateInfo *cdatamigrate.DataMigrateInfo, dataMigrate *datav1alpha1.DataMigrate) error {
 	var err error
+	if cdatamigrate.GetParallelMode(dataMigrate.Spec.ParallelOptions) == cdatamigrate.ParallelModeSharded {
+		return j.setShardedMigrateOptions(dataMigrateInfo, dataMigrate)
+	}
+
 	dataMigrateInfo.ParallelOptions = cdatamigrate.ParallelOptions{
 		SSHPort:                cdatamigrate.DefaultSSHPort,
 		SSHReadyTimeoutSeconds: cdatamigrate.DefaultSSHReadyTimeoutSeconds,
@@ -256,6 +261,26 @@ func (j *JuiceFSEngine) setParallelMigrateOptions(dataMigrateInfo *cdatamigrate.
 	return nil
 }
 
+// setShardedMigrateOptions sets the options of the sharded mode, in which the parallel tasks are the pods of an
+// indexed job and get their shards of the source from a ConfigMap instead of being driven by ssh.
+func (j *JuiceFSEngine) setShardedMigrateOptions(dataMigrateInfo *cdatamigrate.DataMigrateInfo, dataMigrate *datav1alpha1.DataMigrate) (err error) {
+	dataMigrateInfo.ParallelOptions = cdatamigrate.ParallelOptions{
+		Mode:                      cdatamigrate.ParallelModeSharded,
+		CoordinatorImage:          dataMigrate.Spec.ParallelOptions[cdatamigrate.CoordinatorImage],
+		ShardsReadyTimeoutSeconds: cdatamigrate.DefaultShardsReadyTimeoutSeconds,
+	}
+
+	shardsReadyTimeoutSeconds, exist := dataMigrate.Spec.ParallelOptions[cdatamigrate.ShardsReadyTimeoutSeconds]
+	if exist {
+		dataMigrateInfo.ParallelOptions.ShardsReadyTimeoutSeconds, err = strconv.Atoi(shardsReadyTimeoutSeconds)
+		if err != nil {
+			j.Log.Error(err, "shardsReadyTimeoutSeconds in the parallelOptions is not a int")
+			return errors.Wrap(err, "shardsReadyTimeoutSeconds in the parallelOptions is not a int")
+		}
+	}
+	return nil
+}
+
 func (j *JuiceFSEngine) genDataUrl(data datav1alpha1.DataToMigrate, targetDataset *datav1alpha1.Dataset, info *cdatamigrate.DataMigrateInfo) (dataUrl string, err error) {
 	if data.DataSet != nil {
 		fsInfo, err := GetFSInfoFromConfigMap(j.Client, data.DataSet.Name, data.DataSet.Namespace)
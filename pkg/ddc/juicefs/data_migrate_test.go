@@ -700,6 +700,66 @@ func TestJuiceFSEngine_setParallelMigrateOptions(t *testing.T) {
 			want:    cdatamigrate.ParallelOptions{},
 			wanterr: true,
 		},
+		{
+			name: "test-sharded-migrate-options",
+			args: args{
+				dataMigrateInfo: &cdatamigrate.DataMigrateInfo{},
+				dataMigrate: &v1alpha1.DataMigrate{
+					Spec: v1alpha1.DataMigrateSpec{
+						Parallelism: 3,
+						ParallelOptions: map[string]string{
+							cdatamigrate.ParallelMode:              cdatamigrate.ParallelModeSharded,
+							cdatamigrate.CoordinatorImage:          "kubectl:test",
+							cdatamigrate.ShardsReadyTimeoutSeconds: "60",
+						},
+					},
+				},
+			},
+			want: cdatamigrate.ParallelOptions{
+				Mode:                      cdatamigrate.ParallelModeSharded,
+				CoordinatorImage:          "kubectl:test",
+				ShardsReadyTimeoutSeconds: 60,
+			},
+			wanterr: false,
+		},
+		{
+			name: "test-sharded-migrate-options-default",
+			args: args{
+				dataMigrateInfo: &cdatamigrate.DataMigrateInfo{},
+				dataMigrate: &v1alpha1.DataMigrate{
+					Spec: v1alpha1.DataMigrateSpec{
+						Parallelism: 3,
+						ParallelOptions: map[string]string{
+							cdatamigrate.ParallelMode:     cdatamigrate.ParallelModeSharded,
+							cdatamigrate.CoordinatorImage: "kubectl:test",
+						},
+					},
+				},
+			},
+			want: cdatamigrate.ParallelOptions{
+				Mode:                      cdatamigrate.ParallelModeSharded,
+				CoordinatorImage:          "kubectl:test",
+				ShardsReadyTimeoutSeconds: cdatamigrate.DefaultShardsReadyTimeoutSeconds,
+			},
+			wanterr: false,
+		},
+		{
+			name: "test-sharded-migrate-options-wrong",
+			args: args{
+				dataMigrateInfo: &cdatamigrate.DataMigrateInfo{},
+				dataMigrate: &v1alpha1.DataMigrate{
+					Spec: v1alpha1.DataMigrateSpec{
+						Parallelism: 3,
+						ParallelOptions: map[string]string{
+							cdatamigrate.ParallelMode:              cdatamigrate.ParallelModeSharded,
+							cdatamigrate.ShardsReadyTimeoutSeconds: "1m",
+						},
+					},
+				},
+			},
+			want:    cdatamigrate.ParallelOptions{},
+			wanterr: true,
+		},
 	}
 	client := fake.NewFakeClientWithScheme(testScheme)
 
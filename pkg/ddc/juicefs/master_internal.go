@@ -30,16 +30,7 @@ import (
 )
 
 func (j *JuiceFSEngine) installJuiceFS() (err error) {
-	var (
-		chartName = utils.GetChartsDirectory() + "/" + common.JuiceFSChart
-	)
-
-	runtime, err := j.getRuntime()
-	if err != nil {
-		return
-	}
-
-	valueFileName, err := j.generateJuicefsValueFile(runtime)
+	valueFileName, chartName, err := j.GenerateRuntimeValueFile()
 	if err != nil {
 		return
 	}
@@ -57,6 +48,19 @@ func (j *JuiceFSEngine) installJuiceFS() (err error) {
 	return helm.InstallRelease(j.name, j.namespace, valueFileName, chartName)
 }
 
+// GenerateRuntimeValueFile transforms the runtime into the value file of the runtime chart
+func (j *JuiceFSEngine) GenerateRuntimeValueFile() (valueFileName string, chartName string, err error) {
+	chartName = utils.GetChartsDirectory() + "/" + common.JuiceFSChart
+
+	runtime, err := j.getRuntime()
+	if err != nil {
+		return
+	}
+
+	valueFileName, err = j.generateJuicefsValueFile(runtime)
+	return
+}
+
 // generate juicefs struct
 func (j *JuiceFSEngine) generateJuicefsValueFile(runtime *datav1alpha1.JuiceFSRuntime) (valueFileName string, err error) {
 	//0. Check if the configmap exists
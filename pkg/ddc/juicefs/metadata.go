@@ -62,14 +62,16 @@ func (j *JuiceFSEngine) shouldSyncMetadata() (should bool, err error) {
 		return should, nil
 	}
 
-	//todo: periodical metadata sync
-	if dataset.Status.UfsTotal != "" && dataset.Status.UfsTotal != MetadataSyncNotDoneMsg {
+	if base.IsScheduledMetadataSync(dataset, MetadataSyncNotDoneMsg) {
 		j.Log.V(1).Info("dataset ufs is ready",
 			"dataset name", dataset.Name,
 			"dataset namespace", dataset.Namespace,
 			"ufstotal", dataset.Status.UfsTotal)
-		should = false
-		return should, nil
+		// keep waiting for the result of the scheduled metadata sync in progress
+		if j.MetadataSyncDoneCh != nil {
+			return true, nil
+		}
+		return base.ShouldScheduleMetadataSync(runtime.Spec.RuntimeManagement.MetadataSyncPolicy, dataset)
 	}
 	should = true
 	return should, nil
@@ -106,6 +108,7 @@ func (j *JuiceFSEngine) syncMetadataInternal() (err error) {
 					datasetToUpdate := dataset.DeepCopy()
 					datasetToUpdate.Status.UfsTotal = result.UfsTotal
 					datasetToUpdate.Status.FileNum = result.FileNum
+					base.SetMetadataSyncedCondition(datasetToUpdate, result)
 					if !reflect.DeepEqual(datasetToUpdate, dataset) {
 						err = j.Client.Status().Update(context.TODO(), datasetToUpdate)
 						if err != nil {
@@ -122,6 +125,7 @@ func (j *JuiceFSEngine) syncMetadataInternal() (err error) {
 				}
 			} else {
 				j.Log.Error(result.Err, "Metadata sync failed")
+				base.RecordMetadataSyncFailure(j.Client, result, j.namespace, j.name, j.Log)
 				return result.Err
 			}
 		case <-time.After(CheckMetadataSyncDoneTimeoutMillisec * time.Millisecond):
@@ -134,6 +138,10 @@ func (j *JuiceFSEngine) syncMetadataInternal() (err error) {
 			if err != nil {
 				return
 			}
+			// keep the ufs total and file num of the dataset during the scheduled metadata sync
+			if base.IsScheduledMetadataSync(dataset, MetadataSyncNotDoneMsg) {
+				return
+			}
 			datasetToUpdate := dataset.DeepCopy()
 			datasetToUpdate.Status.UfsTotal = MetadataSyncNotDoneMsg
 			datasetToUpdate.Status.FileNum = MetadataSyncNotDoneMsg
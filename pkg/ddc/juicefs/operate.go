@@ -17,6 +17,7 @@ limitations under the License.
 package juicefs
 
 import (
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
 	"github.com/fluid-cloudnative/fluid/pkg/errors"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
@@ -37,6 +38,12 @@ func (j *JuiceFSEngine) GetDataOperationValueFile(ctx cruntime.ReconcileRequestC
 	case dataoperation.DataProcessType:
 		valueFileName, err = j.generateDataProcessValueFile(ctx, object)
 		return valueFileName, err
+	case dataoperation.DataBackupType:
+		valueFileName, err = j.generateDataBackupValueFile(ctx, object)
+		return valueFileName, err
+	case dataoperation.DataFreeType:
+		valueFileName, err = j.generateDataFreeValueFile(ctx, object)
+		return valueFileName, err
 	default:
 		return "", errors.NewNotSupported(
 			schema.GroupResource{
@@ -45,3 +52,13 @@ func (j *JuiceFSEngine) GetDataOperationValueFile(ctx cruntime.ReconcileRequestC
 			}, "JuiceFSRuntime")
 	}
 }
+
+// GetDataOperationProgress derives the progress of the data operation from the runtime, only DataLoad is supported.
+func (j *JuiceFSEngine) GetDataOperationProgress(ctx cruntime.ReconcileRequestContext, operation dataoperation.OperationInterface,
+	previous []datav1alpha1.TargetPathProgress) (progress []datav1alpha1.TargetPathProgress, err error) {
+	if operation.GetOperationType() != dataoperation.DataLoadType {
+		return nil, nil
+	}
+
+	return j.getDataLoadProgress(operation.GetOperationObject(), previous)
+}
@@ -103,6 +103,22 @@ func (j JuiceFileUtils) GetFileCount(juiceSubPath string) (fileCount int64, err
 	return fileCount, nil
 }
 
+// ListRecursively lists the files under the path of the JuiceFS Filesystem recursively in juicefs container
+func (j JuiceFileUtils) ListRecursively(juiceSubPath string) (listing string, err error) {
+	var (
+		command = []string{"ls", "-lR", juiceSubPath}
+		stdout  string
+		stderr  string
+	)
+
+	stdout, stderr, err = j.exec(command, false)
+	if err != nil {
+		err = fmt.Errorf("execute command %v with expectedErr: %v stdout %s and stderr %s", command, err, stdout, stderr)
+		return
+	}
+	return stdout, nil
+}
+
 // Mkdir mkdir in juicefs container
 func (j JuiceFileUtils) Mkdir(juiceSubPath string) (err error) {
 	var (
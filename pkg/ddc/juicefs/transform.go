@@ -25,6 +25,7 @@ import (
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdatabackup "github.com/fluid-cloudnative/fluid/pkg/databackup"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base/portallocator"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/docker"
@@ -142,6 +143,9 @@ func (j *JuiceFSEngine) transformWorkers(runtime *datav1alpha1.JuiceFSRuntime, d
 		value.Worker.NodeSelector = runtime.Spec.Worker.NodeSelector
 	}
 
+	// scheduling
+	value.Worker.Scheduling = utils.TransformSchedulingSpec(runtime.Spec.Worker.SchedulingSpec)
+
 	// options
 	mount := dataset.Spec.Mounts[0]
 	var tieredStoreLevel *datav1alpha1.Level
@@ -185,11 +189,71 @@ func (j *JuiceFSEngine) transformWorkers(runtime *datav1alpha1.JuiceFSRuntime, d
 		return err
 	}
 
+	// transform metadata restore for worker
+	err = j.transformWorkerRestore(dataset, value)
+	if err != nil {
+		j.Log.Error(err, "failed to transform metadata restore for worker")
+		return err
+	}
+
 	// parse work pod network mode
 	value.Worker.HostNetwork = datav1alpha1.IsHostNetwork(runtime.Spec.Worker.NetworkMode)
 	return
 }
 
+// transformWorkerRestore loads the metadata dump generated by DataBackup into the metadata engine
+// if the dataset indicates a restore path. The worker is the first component to format the volume,
+// so the dump is loaded there before `juicefs format`. It's skipped if the volume is already formatted.
+func (j *JuiceFSEngine) transformWorkerRestore(dataset *datav1alpha1.Dataset, value *JuiceFS) (err error) {
+	if dataset.Spec.DataRestoreLocation == nil || dataset.Spec.DataRestoreLocation.Path == "" {
+		return
+	}
+	if value.Edition != CommunityEdition {
+		return fmt.Errorf("DataRestoreLocation is only supported by JuiceFS community edition, but the edition of %s/%s is %s", j.namespace, j.name, value.Edition)
+	}
+
+	pvcName, path, err := utils.ParseBackupRestorePath(dataset.Spec.DataRestoreLocation.Path)
+	if err != nil {
+		return err
+	}
+
+	volume := corev1.Volume{Name: "juicefs-restore"}
+	if pvcName != "" {
+		// RestorePath is in the form of pvc://<pvcName>/subpath
+		volume.VolumeSource = corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: pvcName,
+				ReadOnly:  true,
+			},
+		}
+	} else if dataset.Spec.DataRestoreLocation.NodeName != "" {
+		// RestorePath is in the form of local://subpath
+		volume.VolumeSource = corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{
+				Path: path,
+			},
+		}
+		value.Worker.NodeSelector = utils.UnionMapsWithOverride(value.Worker.NodeSelector, map[string]string{
+			"kubernetes.io/hostname": dataset.Spec.DataRestoreLocation.NodeName,
+		})
+		path = "/"
+	} else {
+		return fmt.Errorf("nodeName of DataRestoreLocation must be set when restoring from a local path %s", dataset.Spec.DataRestoreLocation.Path)
+	}
+
+	value.Worker.Volumes = append(value.Worker.Volumes, volume)
+	value.Worker.VolumeMounts = append(value.Worker.VolumeMounts, corev1.VolumeMount{
+		Name:      volume.Name,
+		MountPath: cdatabackup.JuiceFSBackupPathPod,
+		ReadOnly:  true,
+	})
+
+	backupFile := cdatabackup.JuiceFSBackupPathPod + path + j.getMetadataBackupFileName()
+	value.Configs.RestoreCmd = fmt.Sprintf("%s status %s >/dev/null 2>&1 || %s load %s %s",
+		common.JuiceCeCliPath, value.Source, common.JuiceCeCliPath, value.Source, security.EscapeBashStr(backupFile))
+	return
+}
+
 // genMount: generate mount args
 func (j *JuiceFSEngine) genWorkerMount(value *JuiceFS, workerOptionMap map[string]string) {
 	var mountArgsWorker []string
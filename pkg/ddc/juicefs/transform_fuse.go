@@ -92,6 +92,7 @@ func (j *JuiceFSEngine) transformFuse(runtime *datav1alpha1.JuiceFSRuntime, data
 
 	// transform nodeSelector
 	j.transformFuseNodeSelector(runtime, value)
+	value.Fuse.Scheduling = utils.TransformSchedulingSpec(runtime.Spec.Fuse.SchedulingSpec)
 	value.Fuse.Enabled = true
 
 	// transform resource
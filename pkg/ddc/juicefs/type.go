@@ -60,6 +60,7 @@ type Configs struct {
 	Storage            string             `json:"storage,omitempty"`
 	FormatCmd          string             `json:"formatCmd,omitempty"`
 	QuotaCmd           string             `json:"quotaCmd,omitempty"`
+	RestoreCmd         string             `json:"restoreCmd,omitempty"`
 	EncryptEnvOptions  []EncryptEnvOption `json:"encryptEnvOptions,omitempty"`
 }
 
@@ -74,6 +75,7 @@ type EncryptEnvOption struct {
 type Worker struct {
 	Privileged      bool                 `json:"privileged"`
 	NodeSelector    map[string]string    `json:"nodeSelector,omitempty"`
+	Scheduling      *common.Scheduling   `json:"scheduling,omitempty"`
 	ImagePullPolicy string               `json:"imagePullPolicy,omitempty"`
 	Resources       common.Resources     `json:"resources,omitempty"`
 	Envs            []corev1.EnvVar      `json:"envs,omitempty"`
@@ -95,6 +97,7 @@ type Fuse struct {
 	Enabled         bool                 `json:"enabled,omitempty"`
 	Image           string               `json:"image,omitempty"`
 	NodeSelector    map[string]string    `json:"nodeSelector,omitempty"`
+	Scheduling      *common.Scheduling   `json:"scheduling,omitempty"`
 	Envs            []corev1.EnvVar      `json:"envs,omitempty"`
 	ImageTag        string               `json:"imageTag,omitempty"`
 	ImagePullPolicy string               `json:"imagePullPolicy,omitempty"`
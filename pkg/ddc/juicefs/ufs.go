@@ -17,6 +17,12 @@ limitations under the License.
 package juicefs
 
 import (
+	"fmt"
+	"reflect"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/juicefs/operations"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 )
 
@@ -36,6 +42,20 @@ func (j JuiceFSEngine) TotalFileNums() (int64, error) {
 	return j.totalFileNumsInternal()
 }
 
+// ListUFS lists the files under the path of the dataset recursively to detect the changes of the under file system
+func (j JuiceFSEngine) ListUFS(path string) (listing string, err error) {
+	pods, err := j.GetRunningPodsOfStatefulSet(j.getWorkerName(), j.namespace)
+	if err != nil {
+		return
+	}
+	if len(pods) == 0 {
+		return "", fmt.Errorf("no running worker pod of %s/%s", j.namespace, j.name)
+	}
+
+	fileUtils := operations.NewJuiceFileUtils(pods[0].Name, common.JuiceFSWorkerContainer, j.namespace, j.Log)
+	return fileUtils.ListRecursively(j.getMountPoint() + path)
+}
+
 func (j JuiceFSEngine) ShouldCheckUFS() (should bool, err error) {
 	return false, nil
 }
@@ -44,11 +64,67 @@ func (j JuiceFSEngine) PrepareUFS() (err error) {
 	return
 }
 
-// ShouldUpdateUFS JuiceFSEngine hasn't support UpdateOnUFSChange
+// ShouldUpdateUFS checks if the mount points of the dataset are changed, including the subpath and the options of
+// the mount point which don't change the mount path in the unified namespace.
 func (j JuiceFSEngine) ShouldUpdateUFS() (ufsToUpdate *utils.UFSToUpdate) {
-	return nil
+	dataset, err := utils.GetDataset(j.Client, j.name, j.namespace)
+	if err != nil {
+		j.Log.Error(err, "Failed to get the dataset")
+		return
+	}
+
+	// the mount points are recorded in the dataset status once the dataset is bound
+	if len(dataset.Status.Mounts) == 0 {
+		return
+	}
+
+	ufsToUpdate = utils.NewUFSToUpdate(dataset)
+	ufsToUpdate.AnalyzePathsDelta()
+
+	var changedMountPaths []string
+	for _, mount := range dataset.Spec.Mounts {
+		if !isMountRecorded(dataset.Status.Mounts, mount) {
+			changedMountPaths = append(changedMountPaths, utils.UFSPathBuilder{}.GenUFSPathInUnifiedNamespace(mount))
+		}
+	}
+	ufsToUpdate.AddMountPaths(changedMountPaths)
+
+	return
 }
 
+// UpdateOnUFSChange regenerates the fuse command with the changed mount points, and rolls the fuse pods according to
+// the mount update strategy of the fuse.
 func (j JuiceFSEngine) UpdateOnUFSChange(ufsToUpdate *utils.UFSToUpdate) (ready bool, err error) {
-	return true, nil
+	// 1. check if need to update ufs
+	if ufsToUpdate == nil || !ufsToUpdate.ShouldUpdate() {
+		j.Log.Info("no need to update ufs",
+			"namespace", j.namespace,
+			"name", j.name)
+		return
+	}
+
+	// 2. set update status to updating
+	err = utils.UpdateMountStatus(j.Client, j.name, j.namespace, datav1alpha1.UpdatingDatasetPhase)
+	if err != nil {
+		j.Log.Error(err, "Failed to update dataset status to updating")
+		return
+	}
+
+	// 3. update the fuse command and roll the fuse pods
+	ready, err = j.processUpdatingUFS(ufsToUpdate)
+	if err != nil {
+		j.Log.Error(err, "Failed to update the mount points")
+		return
+	}
+
+	return
+}
+
+func isMountRecorded(mounts []datav1alpha1.Mount, mount datav1alpha1.Mount) bool {
+	for _, recorded := range mounts {
+		if reflect.DeepEqual(recorded, mount) {
+			return true
+		}
+	}
+	return false
 }
@@ -17,8 +17,20 @@ limitations under the License.
 package juicefs
 
 import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/juicefs/operations"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
 )
 
 func (j *JuiceFSEngine) totalStorageBytesInternal() (total int64, err error) {
@@ -65,3 +77,88 @@ func (j *JuiceFSEngine) usedSpaceInternal() (usedSpace int64, err error) {
 
 	return
 }
+
+// processUpdatingUFS regenerates the fuse command with the changed mount points and rolls the fuse pods
+func (j *JuiceFSEngine) processUpdatingUFS(ufsToUpdate *utils.UFSToUpdate) (ready bool, err error) {
+	runtime, err := j.getRuntime()
+	if err != nil {
+		return
+	}
+
+	latestValue, err := j.transform(runtime)
+	if err != nil {
+		return
+	}
+
+	// 1. update the fuse command configmap, it may have been updated when syncing the runtime
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		valueToSync, innerErr := j.GetValueFromConfigmap()
+		if innerErr != nil {
+			return innerErr
+		}
+		oldCommand := valueToSync.Fuse.Command
+		if innerErr = j.updateFuseCmdConfigmapOnChanged(valueToSync, latestValue); innerErr != nil {
+			return innerErr
+		}
+		if valueToSync.Fuse.Command == oldCommand {
+			return nil
+		}
+		return j.SaveValueToConfigmap(valueToSync)
+	})
+	if err != nil {
+		return
+	}
+
+	// 2. roll the fuse pods to mount the changed mount points
+	j.Log.Info("Rolling fuse pods for the changed mount points", "toAdd", ufsToUpdate.ToAdd(), "toRemove", ufsToUpdate.ToRemove(),
+		"mountUpdateStrategy", runtime.Spec.Fuse.MountUpdateStrategy)
+	err = j.rollFusePods(runtime.Spec.Fuse.MountUpdateStrategy)
+	if err != nil {
+		return
+	}
+
+	return true, nil
+}
+
+// rollFusePods increases the fuse generation so that the fuse pods are recreated with the latest fuse command once they
+// are not needed if the OnFuseChanged clean policy is used. The running fuse pods are deleted immediately if the Recreate
+// mount update strategy is used.
+func (j *JuiceFSEngine) rollFusePods(strategy datav1alpha1.FuseMountUpdateStrategy) error {
+	fuses, err := kubeclient.GetDaemonset(j.Client, j.getFuseName(), j.namespace)
+	if err != nil {
+		return err
+	}
+
+	fusesToUpdate := fuses.DeepCopy()
+	if fusesToUpdate.Spec.Template.Labels == nil {
+		fusesToUpdate.Spec.Template.Labels = map[string]string{}
+	}
+	if err = j.increaseFuseGeneration(fusesToUpdate); err != nil {
+		return err
+	}
+	if err = j.Client.Update(context.TODO(), fusesToUpdate); err != nil {
+		j.Log.Error(err, "rollFusePods: failed to update the fuse generation", "fuse ds", types.NamespacedName{Namespace: fuses.Namespace, Name: fuses.Name})
+		return err
+	}
+
+	if strategy != datav1alpha1.RecreateMountUpdateStrategy {
+		return nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(fuses.Spec.Selector)
+	if err != nil {
+		return err
+	}
+	podList := &corev1.PodList{}
+	err = j.Client.List(context.TODO(), podList, &client.ListOptions{Namespace: j.namespace, LabelSelector: selector})
+	if err != nil {
+		return err
+	}
+	for i := range podList.Items {
+		j.Log.Info("rollFusePods: deleting fuse pod to remount", "pod", podList.Items[i].Name)
+		if err = j.Client.Delete(context.TODO(), &podList.Items[i]); err != nil && !apierrs.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
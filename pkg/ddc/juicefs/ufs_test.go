@@ -25,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
 	appsv1 "k8s.io/api/apps/v1"
@@ -305,58 +306,161 @@ func TestJuiceFSEngine_ShouldCheckUFS(t *testing.T) {
 }
 
 func TestJuiceFSEngine_ShouldUpdateUFS(t *testing.T) {
-	type fields struct{}
+	mount := datav1alpha1.Mount{Name: "test", MountPoint: "juicefs:///demo"}
+	subpathChanged := datav1alpha1.Mount{Name: "test", MountPoint: "juicefs:///demo/subpath"}
+
 	tests := []struct {
-		name            string
-		fields          fields
-		wantUfsToUpdate *utils.UFSToUpdate
+		name       string
+		dataset    *datav1alpha1.Dataset
+		wantUpdate bool
 	}{
 		{
-			name:            "test",
-			fields:          fields{},
-			wantUfsToUpdate: nil,
+			name: "not_bound",
+			dataset: &datav1alpha1.Dataset{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "fluid"},
+				Spec:       datav1alpha1.DatasetSpec{Mounts: []datav1alpha1.Mount{mount}},
+			},
+			wantUpdate: false,
+		},
+		{
+			name: "not_changed",
+			dataset: &datav1alpha1.Dataset{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "fluid"},
+				Spec:       datav1alpha1.DatasetSpec{Mounts: []datav1alpha1.Mount{mount}},
+				Status:     datav1alpha1.DatasetStatus{Mounts: []datav1alpha1.Mount{mount}},
+			},
+			wantUpdate: false,
+		},
+		{
+			name: "subpath_changed",
+			dataset: &datav1alpha1.Dataset{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "fluid"},
+				Spec:       datav1alpha1.DatasetSpec{Mounts: []datav1alpha1.Mount{subpathChanged}},
+				Status:     datav1alpha1.DatasetStatus{Mounts: []datav1alpha1.Mount{mount}},
+			},
+			wantUpdate: true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			j := JuiceFSEngine{}
-			if gotUfsToUpdate := j.ShouldUpdateUFS(); !reflect.DeepEqual(gotUfsToUpdate, tt.wantUfsToUpdate) {
-				t.Errorf("ShouldUpdateUFS() = %v, want %v", gotUfsToUpdate, tt.wantUfsToUpdate)
+			j := JuiceFSEngine{
+				name:      "test",
+				namespace: "fluid",
+				Client:    fake.NewFakeClientWithScheme(testScheme, tt.dataset),
+				Log:       fake.NullLogger(),
+			}
+			ufsToUpdate := j.ShouldUpdateUFS()
+			if gotUpdate := ufsToUpdate != nil && ufsToUpdate.ShouldUpdate(); gotUpdate != tt.wantUpdate {
+				t.Errorf("ShouldUpdateUFS() should update = %v, want %v", gotUpdate, tt.wantUpdate)
 			}
 		})
 	}
 }
 
 func TestJuiceFSEngine_UpdateOnUFSChange(t *testing.T) {
-	type fields struct{}
-	type args struct {
-		ufsToUpdate *utils.UFSToUpdate
+	dataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "fluid"},
+		Spec:       datav1alpha1.DatasetSpec{Mounts: []datav1alpha1.Mount{{Name: "test", MountPoint: "juicefs:///demo/subpath"}}},
+		Status:     datav1alpha1.DatasetStatus{Mounts: []datav1alpha1.Mount{{Name: "test", MountPoint: "juicefs:///demo"}}},
+	}
+	client := fake.NewFakeClientWithScheme(testScheme, dataset)
+	j := JuiceFSEngine{
+		name:      "test",
+		namespace: "fluid",
+		Client:    client,
+		Log:       fake.NullLogger(),
+	}
+
+	gotReady, err := j.UpdateOnUFSChange(nil)
+	if err != nil || gotReady {
+		t.Errorf("UpdateOnUFSChange(nil) = %v, %v, want not ready", gotReady, err)
 	}
+
+	patches := ApplyPrivateMethod(reflect.TypeOf(&j), "processUpdatingUFS", func(_ *JuiceFSEngine, _ *utils.UFSToUpdate) (bool, error) {
+		return true, nil
+	})
+	defer patches.Reset()
+
+	gotReady, err = j.UpdateOnUFSChange(j.ShouldUpdateUFS())
+	if err != nil || !gotReady {
+		t.Errorf("UpdateOnUFSChange() = %v, %v, want ready", gotReady, err)
+	}
+	updatedDataset, err := utils.GetDataset(client, "test", "fluid")
+	if err != nil {
+		t.Fatalf("failed to get dataset: %v", err)
+	}
+	if updatedDataset.Status.Phase != datav1alpha1.UpdatingDatasetPhase {
+		t.Errorf("dataset phase = %v, want %v", updatedDataset.Status.Phase, datav1alpha1.UpdatingDatasetPhase)
+	}
+}
+
+func TestJuiceFSEngine_rollFusePods(t *testing.T) {
 	tests := []struct {
-		name      string
-		fields    fields
-		args      args
-		wantReady bool
-		wantErr   bool
+		name         string
+		strategy     datav1alpha1.FuseMountUpdateStrategy
+		wantFusePods int
 	}{
 		{
-			name:      "test",
-			fields:    fields{},
-			args:      args{},
-			wantReady: true,
-			wantErr:   false,
+			name:         "on_delete",
+			strategy:     datav1alpha1.OnDeleteMountUpdateStrategy,
+			wantFusePods: 1,
+		},
+		{
+			name:         "recreate",
+			strategy:     datav1alpha1.RecreateMountUpdateStrategy,
+			wantFusePods: 0,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			j := JuiceFSEngine{}
-			gotReady, err := j.UpdateOnUFSChange(tt.args.ufsToUpdate)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("UpdateOnUFSChange() error = %v, wantErr %v", err, tt.wantErr)
-				return
+			fuseLabels := map[string]string{"role": "juicefs-fuse", "release": "test"}
+			objs := []runtime.Object{
+				&appsv1.DaemonSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-fuse", Namespace: "fluid"},
+					Spec: appsv1.DaemonSetSpec{
+						Selector: &metav1.LabelSelector{MatchLabels: fuseLabels},
+						Template: corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: fuseLabels}},
+					},
+				},
+				&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-fuse-abcde", Namespace: "fluid", Labels: fuseLabels}},
+				&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-worker-0", Namespace: "fluid", Labels: map[string]string{"role": "juicefs-worker"}}},
+				&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "fluid"}},
+			}
+			client := fake.NewFakeClientWithScheme(testScheme, objs...)
+			j := &JuiceFSEngine{
+				name:      "test",
+				namespace: "fluid",
+				Client:    client,
+				Log:       fake.NullLogger(),
+			}
+
+			if err := j.rollFusePods(tt.strategy); err != nil {
+				t.Fatalf("rollFusePods() error = %v", err)
+			}
+
+			fuses, err := kubeclient.GetDaemonset(client, "test-fuse", "fluid")
+			if err != nil {
+				t.Fatalf("failed to get fuse: %v", err)
+			}
+			if generation := fuses.Spec.Template.Labels[common.LabelRuntimeFuseGeneration]; generation != "1" {
+				t.Errorf("fuse generation = %q, want 1", generation)
+			}
+
+			podList := &corev1.PodList{}
+			if err = client.List(context.TODO(), podList); err != nil {
+				t.Fatalf("failed to list pods: %v", err)
+			}
+			fusePods := 0
+			for _, pod := range podList.Items {
+				if pod.Labels["role"] == "juicefs-fuse" {
+					fusePods++
+				}
+			}
+			if fusePods != tt.wantFusePods {
+				t.Errorf("fuse pods = %d, want %d", fusePods, tt.wantFusePods)
 			}
-			if gotReady != tt.wantReady {
-				t.Errorf("UpdateOnUFSChange() gotReady = %v, want %v", gotReady, tt.wantReady)
+			if len(podList.Items)-fusePods != 1 {
+				t.Errorf("the worker pod is not expected to be deleted")
 			}
 		})
 	}
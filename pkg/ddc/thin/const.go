@@ -23,3 +23,34 @@ const (
 	MetadataSyncNotDoneMsg               = "[Calculating]"
 	CheckMetadataSyncDoneTimeoutMillisec = 500
 )
+
+// the environment variables passed to the data operation containers declared in ThinRuntimeProfile
+const (
+	EnvDatasetName      = "FLUID_DATASET_NAME"
+	EnvDatasetNamespace = "FLUID_DATASET_NAMESPACE"
+
+	// colon separated paths to load, which are absolute paths in the container
+	EnvDataLoadPaths = "FLUID_DATALOAD_PATHS"
+	// colon separated replicas of the paths to load
+	EnvDataLoadPathReplicas = "FLUID_DATALOAD_PATH_REPLICAS"
+	EnvDataLoadMetadata     = "FLUID_DATALOAD_METADATA"
+	EnvDataLoadOptions      = "FLUID_DATALOAD_OPTIONS"
+	// the ConfigMap where the loader reports the progress of each target path under the key `progress`,
+	// the path in the progress is the target path of DataLoad instead of the path in the container
+	EnvDataLoadProgressConfigMap = "FLUID_DATALOAD_PROGRESS_CONFIGMAP"
+
+	// the source and destination of data migrate, it's an absolute path in the container for a dataset,
+	// or the uri of the external storage
+	EnvDataMigrateFrom    = "FLUID_DATAMIGRATE_FROM"
+	EnvDataMigrateTo      = "FLUID_DATAMIGRATE_TO"
+	EnvDataMigrateOptions = "FLUID_DATAMIGRATE_OPTIONS"
+	// the migrate mode, Copy, Verify or Incremental. The migrator reports the result in its termination message
+	// in key=value lines, e.g. copied=10, see datamigrate.ParseReport
+	EnvDataMigrateMode = "FLUID_DATAMIGRATE_MODE"
+	// the unix timestamp of the start of the last successful migration in Incremental mode, which is unset
+	// before the first one
+	EnvDataMigrateSince = "FLUID_DATAMIGRATE_SINCE"
+
+	// the datasets are mounted into the data operation container under the directory
+	dataOperationMountRoot = "/fluid"
+)
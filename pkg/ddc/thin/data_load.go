@@ -0,0 +1,152 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thin
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
+	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/docker"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/transformer"
+)
+
+// generateDataLoadValueFile builds a DataLoadValue from the DataLoad and the dataLoad template declared in
+// ThinRuntimeProfile, and marshals it to a temporary yaml file used by fluid dataloader helm chart.
+func (t *ThinEngine) generateDataLoadValueFile(ctx cruntime.ReconcileRequestContext, object client.Object) (valueFileName string, err error) {
+	dataload, ok := object.(*datav1alpha1.DataLoad)
+	if !ok {
+		err = fmt.Errorf("object %v is not a DataLoad", object)
+		return "", err
+	}
+
+	template, err := t.getDataOperationTemplate(dataoperation.DataLoadType)
+	if err != nil {
+		return "", err
+	}
+
+	targetDataset, err := utils.GetDataset(t.Client, dataload.Spec.Dataset.Name, dataload.Spec.Dataset.Namespace)
+	if err != nil {
+		return "", err
+	}
+
+	dataLoadValue, err := t.genDataLoadValue(template, targetDataset, dataload)
+	if err != nil {
+		return "", err
+	}
+
+	return writeValueFile(dataLoadValue, fmt.Sprintf("%s-%s-loader-values.yaml", dataload.Namespace, dataload.Name))
+}
+
+func (t *ThinEngine) genDataLoadValue(template *datav1alpha1.ThinDataOperationSpec, targetDataset *datav1alpha1.Dataset, dataload *datav1alpha1.DataLoad) (*DataLoadValue, error) {
+	image, operator := genDataOperator(template)
+
+	imagePullSecrets := docker.GetImagePullSecretsFromEnv(common.EnvImagePullSecretsKey)
+	if len(t.runtimeProfile.Spec.ImagePullSecrets) > 0 {
+		imagePullSecrets = t.runtimeProfile.Spec.ImagePullSecrets
+	}
+
+	dataloadInfo := cdataload.DataLoadInfo{
+		BackoffLimit:     3,
+		TargetDataset:    dataload.Spec.Dataset.Name,
+		LoadMetadata:     dataload.Spec.LoadMetadata,
+		Image:            image,
+		Labels:           dataload.Spec.PodMetadata.Labels,
+		Annotations:      dataflow.InjectAffinityAnnotation(dataload.Annotations, dataload.Spec.PodMetadata.Annotations),
+		ImagePullSecrets: imagePullSecrets,
+		Policy:           string(dataload.Spec.Policy),
+		Schedule:         dataload.Spec.Schedule,
+		Resources:        template.Resources,
+		Affinity:         dataload.Spec.Affinity,
+		NodeSelector:     dataload.Spec.NodeSelector,
+		Tolerations:      dataload.Spec.Tolerations,
+		SchedulerName:    dataload.Spec.SchedulerName,
+	}
+
+	// the resources of DataLoad override the ones declared in profile
+	if len(dataload.Spec.Resources.Limits) > 0 || len(dataload.Spec.Resources.Requests) > 0 {
+		dataloadInfo.Resources = dataload.Spec.Resources
+	}
+
+	// inject the node affinity by previous operation pod.
+	var err error
+	dataloadInfo.Affinity, err = dataflow.InjectAffinityByRunAfterOp(t.Client, dataload.Spec.RunAfter, dataload.Namespace, dataloadInfo.Affinity)
+	if err != nil {
+		return nil, err
+	}
+
+	// the dataset is mounted into the container, and the paths to load are passed as absolute paths in the container
+	mountPath := path.Join(dataOperationMountRoot, "data")
+	operator.mountDataset(targetDataset.Name, "dataset", mountPath)
+
+	targetPaths := []cdataload.TargetPath{}
+	paths := []string{}
+	replicas := []string{}
+	for _, target := range cdataload.GetTargetsToLoad(dataload) {
+		targetPath := strings.TrimSpace(target.Path)
+		targetPaths = append(targetPaths, cdataload.TargetPath{
+			Path:        targetPath,
+			Replicas:    target.Replicas,
+			FluidNative: utils.IsTargetPathUnderFluidNativeMounts(targetPath, *targetDataset),
+		})
+		paths = append(paths, path.Join(mountPath, targetPath))
+		replica := target.Replicas
+		if replica < 1 {
+			replica = 1
+		}
+		replicas = append(replicas, strconv.Itoa(int(replica)))
+	}
+	// load the whole dataset if no target is specified
+	if len(paths) == 0 {
+		paths = append(paths, mountPath)
+		replicas = append(replicas, "1")
+	}
+	dataloadInfo.TargetPaths = targetPaths
+
+	// the envs generated by fluid are appended after the ones declared in profile, so that they take precedence
+	operator.Envs = append(operator.Envs, template.Env...)
+	operator.Envs = append(operator.Envs,
+		corev1.EnvVar{Name: EnvDatasetName, Value: targetDataset.Name},
+		corev1.EnvVar{Name: EnvDatasetNamespace, Value: targetDataset.Namespace},
+		corev1.EnvVar{Name: EnvDataLoadPaths, Value: strings.Join(paths, ":")},
+		corev1.EnvVar{Name: EnvDataLoadPathReplicas, Value: strings.Join(replicas, ":")},
+		corev1.EnvVar{Name: EnvDataLoadMetadata, Value: strconv.FormatBool(dataload.Spec.LoadMetadata)},
+		corev1.EnvVar{Name: EnvDataLoadOptions, Value: genOptionArgs(dataload.Spec.Options)},
+		corev1.EnvVar{Name: EnvDataLoadProgressConfigMap, Value: utils.GetDataLoadProgressConfigMapName(utils.GetDataLoadReleaseName(dataload.Name))},
+	)
+
+	return &DataLoadValue{
+		DataLoadValue: cdataload.DataLoadValue{
+			Name:           dataload.Name,
+			OwnerDatasetId: utils.GetDatasetId(targetDataset.Namespace, targetDataset.Name, string(targetDataset.UID)),
+			DataLoadInfo:   dataloadInfo,
+			Owner:          transformer.GenerateOwnerReferenceFromObject(dataload),
+		},
+		Operator: operator,
+	}, nil
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thin
+
+import (
+	"os"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func getEnvValue(envs []corev1.EnvVar, name string) string {
+	value := ""
+	for _, env := range envs {
+		if env.Name == name {
+			value = env.Value
+		}
+	}
+	return value
+}
+
+func TestThinEngine_generateDataLoadValueFile(t *testing.T) {
+	dataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "demo-dataset",
+			Namespace: "default",
+		},
+	}
+
+	dataLoad := &datav1alpha1.DataLoad{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "demo-dataload",
+			Namespace: "default",
+		},
+		Spec: datav1alpha1.DataLoadSpec{
+			Dataset: datav1alpha1.TargetDataset{
+				Name:      "demo-dataset",
+				Namespace: "default",
+			},
+			Target: []datav1alpha1.TargetPath{
+				{Path: "/a", Replicas: 2},
+				{Path: "/b"},
+			},
+			Options: map[string]string{"threads": "8", "verbose": ""},
+		},
+	}
+
+	profile := &datav1alpha1.ThinRuntimeProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo-profile"},
+		Spec: datav1alpha1.ThinRuntimeProfileSpec{
+			DataLoad: &datav1alpha1.ThinDataOperationSpec{
+				Image:    "demo-loader",
+				ImageTag: "v1",
+				Command:  []string{"/loader.sh"},
+				Env: []corev1.EnvVar{
+					{Name: "CUSTOM", Value: "custom"},
+					{Name: EnvDataLoadPaths, Value: "overridden"},
+				},
+			},
+		},
+	}
+
+	type args struct {
+		engine *ThinEngine
+		ctx    cruntime.ReconcileRequestContext
+		object client.Object
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "TestNotOfTypeDataLoad",
+			args: args{
+				engine: &ThinEngine{runtimeProfile: profile},
+				object: &datav1alpha1.Dataset{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "TestNoRuntimeProfile",
+			args: args{
+				engine: &ThinEngine{Client: fake.NewFakeClientWithScheme(testScheme, dataset)},
+				object: dataLoad,
+			},
+			wantErr: true,
+		},
+		{
+			name: "TestNoDataLoadTemplate",
+			args: args{
+				engine: &ThinEngine{
+					Client:         fake.NewFakeClientWithScheme(testScheme, dataset),
+					runtimeProfile: &datav1alpha1.ThinRuntimeProfile{},
+				},
+				object: dataLoad,
+			},
+			wantErr: true,
+		},
+		{
+			name: "TestGenerateDataLoadValueFile",
+			args: args{
+				engine: &ThinEngine{
+					Client:         fake.NewFakeClientWithScheme(testScheme, dataset),
+					runtimeProfile: profile,
+				},
+				object: dataLoad,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valueFileName, err := tt.args.engine.generateDataLoadValueFile(tt.args.ctx, tt.args.object)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ThinEngine.generateDataLoadValueFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			data, err := os.ReadFile(valueFileName)
+			if err != nil {
+				t.Fatalf("failed to read value file: %v", err)
+			}
+			var value DataLoadValue
+			if err = yaml.Unmarshal(data, &value); err != nil {
+				t.Fatalf("failed to unmarshal value file: %v", err)
+			}
+
+			if value.DataLoadInfo.Image != "demo-loader:v1" {
+				t.Errorf("expect image demo-loader:v1, got %s", value.DataLoadInfo.Image)
+			}
+			if len(value.Operator.VolumeMounts) != 1 || value.Operator.VolumeMounts[0].MountPath != "/fluid/data" {
+				t.Errorf("expect dataset mounted at /fluid/data, got %v", value.Operator.VolumeMounts)
+			}
+			if len(value.Operator.Volumes) != 1 || value.Operator.Volumes[0].PersistentVolumeClaim.ClaimName != "demo-dataset" {
+				t.Errorf("expect the pvc of dataset to be mounted, got %v", value.Operator.Volumes)
+			}
+			if got := getEnvValue(value.Operator.Envs, EnvDataLoadPaths); got != "/fluid/data/a:/fluid/data/b" {
+				t.Errorf("expect env %s to be /fluid/data/a:/fluid/data/b, got %s", EnvDataLoadPaths, got)
+			}
+			if got := getEnvValue(value.Operator.Envs, EnvDataLoadPathReplicas); got != "2:1" {
+				t.Errorf("expect env %s to be 2:1, got %s", EnvDataLoadPathReplicas, got)
+			}
+			if got := getEnvValue(value.Operator.Envs, EnvDataLoadOptions); got != "--threads=8 --verbose" {
+				t.Errorf("expect env %s to be --threads=8 --verbose, got %s", EnvDataLoadOptions, got)
+			}
+			if got := getEnvValue(value.Operator.Envs, EnvDataLoadProgressConfigMap); got != "demo-dataload-loader-progress" {
+				t.Errorf("expect env %s to be demo-dataload-loader-progress, got %s", EnvDataLoadProgressConfigMap, got)
+			}
+			if got := getEnvValue(value.Operator.Envs, "CUSTOM"); got != "custom" {
+				t.Errorf("expect env CUSTOM declared in profile, got %s", got)
+			}
+		})
+	}
+}
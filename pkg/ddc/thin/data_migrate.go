@@ -0,0 +1,198 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thin
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
+	cdatamigrate "github.com/fluid-cloudnative/fluid/pkg/datamigrate"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/docker"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/transformer"
+)
+
+// generateDataMigrateValueFile builds a DataMigrateValue from the DataMigrate and the dataMigrate template declared in
+// ThinRuntimeProfile, and marshals it to a temporary yaml file used by fluid datamigrate helm chart.
+func (t *ThinEngine) generateDataMigrateValueFile(ctx cruntime.ReconcileRequestContext, object client.Object) (valueFileName string, err error) {
+	dataMigrate, ok := object.(*datav1alpha1.DataMigrate)
+	if !ok {
+		return "", fmt.Errorf("object %v is not a DataMigrate", object)
+	}
+
+	template, err := t.getDataOperationTemplate(dataoperation.DataMigrateType)
+	if err != nil {
+		return "", err
+	}
+
+	if dataMigrate.Spec.Parallelism > 1 {
+		return "", fmt.Errorf("parallel DataMigrate is not supported by ThinRuntime, parallelism: %d", dataMigrate.Spec.Parallelism)
+	}
+
+	targetDataset, err := utils.GetTargetDatasetOfMigrate(t.Client, dataMigrate)
+	if err != nil {
+		return "", err
+	}
+
+	dataMigrateValue, err := t.genDataMigrateValue(template, targetDataset, dataMigrate)
+	if err != nil {
+		return "", err
+	}
+
+	return writeValueFile(dataMigrateValue, fmt.Sprintf("%s-%s-migrate-values.yaml", dataMigrate.Namespace, dataMigrate.Name))
+}
+
+func (t *ThinEngine) genDataMigrateValue(template *datav1alpha1.ThinDataOperationSpec, targetDataset *datav1alpha1.Dataset, dataMigrate *datav1alpha1.DataMigrate) (*DataMigrateValue, error) {
+	image, operator := genDataOperator(template)
+	// the image of DataMigrate overrides the one declared in profile
+	if len(dataMigrate.Spec.Image) > 0 {
+		image = dataMigrate.Spec.Image
+		if len(dataMigrate.Spec.ImageTag) > 0 {
+			image = fmt.Sprintf("%s:%s", dataMigrate.Spec.Image, dataMigrate.Spec.ImageTag)
+		}
+	}
+
+	imagePullSecrets := docker.GetImagePullSecretsFromEnv(common.EnvImagePullSecretsKey)
+	if len(t.runtimeProfile.Spec.ImagePullSecrets) > 0 {
+		imagePullSecrets = t.runtimeProfile.Spec.ImagePullSecrets
+	}
+
+	dataMigrateInfo := cdatamigrate.DataMigrateInfo{
+		BackoffLimit:     3,
+		TargetDataset:    targetDataset.Name,
+		Image:            image,
+		Labels:           dataMigrate.Spec.PodMetadata.Labels,
+		Annotations:      dataflow.InjectAffinityAnnotation(dataMigrate.Annotations, dataMigrate.Spec.PodMetadata.Annotations),
+		ImagePullSecrets: imagePullSecrets,
+		Policy:           string(dataMigrate.Spec.Policy),
+		Schedule:         dataMigrate.Spec.Schedule,
+		Resources:        template.Resources,
+		Affinity:         dataMigrate.Spec.Affinity,
+		NodeSelector:     dataMigrate.Spec.NodeSelector,
+		Tolerations:      dataMigrate.Spec.Tolerations,
+		SchedulerName:    dataMigrate.Spec.SchedulerName,
+		Parallelism:      1,
+	}
+
+	// the resources of DataMigrate override the ones declared in profile
+	if len(dataMigrate.Spec.Resources.Limits) > 0 || len(dataMigrate.Spec.Resources.Requests) > 0 {
+		dataMigrateInfo.Resources = dataMigrate.Spec.Resources
+	}
+
+	// inject the node affinity by previous operation pod.
+	var err error
+	dataMigrateInfo.Affinity, err = dataflow.InjectAffinityByRunAfterOp(t.Client, dataMigrate.Spec.RunAfter, dataMigrate.Namespace, dataMigrateInfo.Affinity)
+	if err != nil {
+		return nil, err
+	}
+
+	migrateFrom, fromEnvs, err := genDataToMigrate(dataMigrate.Spec.From, "from", dataMigrate.Namespace, &operator)
+	if err != nil {
+		return nil, err
+	}
+	migrateTo, toEnvs, err := genDataToMigrate(dataMigrate.Spec.To, "to", dataMigrate.Namespace, &operator)
+	if err != nil {
+		return nil, err
+	}
+	dataMigrateInfo.MigrateFrom = migrateFrom
+	dataMigrateInfo.MigrateTo = migrateTo
+
+	// the envs generated by fluid are appended after the ones declared in profile, so that they take precedence
+	operator.Envs = append(operator.Envs, template.Env...)
+	operator.Envs = append(operator.Envs, fromEnvs...)
+	operator.Envs = append(operator.Envs, toEnvs...)
+	operator.Envs = append(operator.Envs,
+		corev1.EnvVar{Name: EnvDatasetName, Value: targetDataset.Name},
+		corev1.EnvVar{Name: EnvDatasetNamespace, Value: targetDataset.Namespace},
+		corev1.EnvVar{Name: EnvDataMigrateFrom, Value: migrateFrom},
+		corev1.EnvVar{Name: EnvDataMigrateTo, Value: migrateTo},
+		corev1.EnvVar{Name: EnvDataMigrateOptions, Value: genOptionArgs(dataMigrate.Spec.Options)},
+		corev1.EnvVar{Name: EnvDataMigrateMode, Value: string(dataMigrate.Spec.Mode)},
+	)
+	if dataMigrate.Spec.Mode == datav1alpha1.IncrementalMigrateMode {
+		operator.Envs = append(operator.Envs, corev1.EnvVar{
+			Name: EnvDataMigrateSince,
+			ValueFrom: &corev1.EnvVarSource{
+				ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: utils.GetDataMigrateStateConfigMapName(utils.GetDataMigrateReleaseName(dataMigrate.Name))},
+					Key:                  cdatamigrate.StateSinceKey,
+					Optional:             ptr.To(true),
+				},
+			},
+		})
+	}
+
+	return &DataMigrateValue{
+		DataMigrateValue: cdatamigrate.DataMigrateValue{
+			Name:            dataMigrate.Name,
+			OwnerDatasetId:  utils.GetDatasetId(targetDataset.Namespace, targetDataset.Name, string(targetDataset.UID)),
+			Owner:           transformer.GenerateOwnerReferenceFromObject(dataMigrate),
+			DataMigrateInfo: dataMigrateInfo,
+		},
+		Operator: operator,
+	}, nil
+}
+
+// genDataToMigrate returns the location of the data to migrate. A dataset is mounted into the container
+// and its location is an absolute path in the container, while an external storage is located by its uri
+// and its encrypt options are passed through the envs.
+func genDataToMigrate(data datav1alpha1.DataToMigrate, side string, namespace string, operator *DataOperator) (location string, envs []corev1.EnvVar, err error) {
+	if data.DataSet != nil {
+		if data.DataSet.Namespace != namespace {
+			return "", nil, fmt.Errorf("dataset %s/%s to migrate is not in the namespace of DataMigrate %s", data.DataSet.Namespace, data.DataSet.Name, namespace)
+		}
+		mountPath := path.Join(dataOperationMountRoot, side)
+		operator.mountDataset(data.DataSet.Name, side+"-dataset", mountPath)
+
+		location = path.Join(mountPath, data.DataSet.Path)
+		if strings.HasSuffix(data.DataSet.Path, "/") {
+			location = location + "/"
+		}
+		return location, nil, nil
+	}
+
+	if data.ExternalStorage != nil {
+		for _, encryptOption := range data.ExternalStorage.EncryptOptions {
+			envName := utils.ConvertDashToUnderscore(encryptOption.Name)
+			if err = utils.CheckValidateEnvName(envName); err != nil {
+				return "", nil, err
+			}
+			envs = append(envs, corev1.EnvVar{
+				Name: envName,
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: encryptOption.ValueFrom.SecretKeyRef.Name},
+						Key:                  encryptOption.ValueFrom.SecretKeyRef.Key,
+					},
+				},
+			})
+		}
+		return data.ExternalStorage.URI, envs, nil
+	}
+
+	return "", nil, fmt.Errorf("either dataset or externalStorage should be set to migrate %s", side)
+}
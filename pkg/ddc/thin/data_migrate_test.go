@@ -0,0 +1,167 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thin
+
+import (
+	"os"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestThinEngine_generateDataMigrateValueFile(t *testing.T) {
+	dataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "demo-dataset",
+			Namespace: "default",
+		},
+	}
+
+	profile := &datav1alpha1.ThinRuntimeProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo-profile"},
+		Spec: datav1alpha1.ThinRuntimeProfileSpec{
+			DataMigrate: &datav1alpha1.ThinDataOperationSpec{
+				Image:   "demo-migrate",
+				Command: []string{"/migrate.sh"},
+			},
+		},
+	}
+
+	newDataMigrate := func(from, to datav1alpha1.DataToMigrate) *datav1alpha1.DataMigrate {
+		return &datav1alpha1.DataMigrate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "demo-datamigrate",
+				Namespace: "default",
+			},
+			Spec: datav1alpha1.DataMigrateSpec{From: from, To: to},
+		}
+	}
+
+	datasetToMigrate := datav1alpha1.DataToMigrate{
+		DataSet: &datav1alpha1.DatasetToMigrate{Name: "demo-dataset", Namespace: "default", Path: "/dir/"},
+	}
+	externalToMigrate := datav1alpha1.DataToMigrate{
+		ExternalStorage: &datav1alpha1.ExternalStorage{
+			URI: "s3://bucket/path",
+			EncryptOptions: []datav1alpha1.EncryptOption{{
+				Name: "access-key",
+				ValueFrom: datav1alpha1.EncryptOptionSource{
+					SecretKeyRef: datav1alpha1.SecretKeySelector{Name: "s3-secret", Key: "ak"},
+				},
+			}},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		engine        *ThinEngine
+		dataMigrate   *datav1alpha1.DataMigrate
+		wantErr       bool
+		wantFrom      string
+		wantTo        string
+		wantSecretEnv string
+	}{
+		{
+			name:        "TestNoDataMigrateTemplate",
+			engine:      &ThinEngine{runtimeProfile: &datav1alpha1.ThinRuntimeProfile{}},
+			dataMigrate: newDataMigrate(datasetToMigrate, externalToMigrate),
+			wantErr:     true,
+		},
+		{
+			name:          "TestMigrateFromDataset",
+			engine:        &ThinEngine{runtimeProfile: profile},
+			dataMigrate:   newDataMigrate(datasetToMigrate, externalToMigrate),
+			wantFrom:      "/fluid/from/dir/",
+			wantTo:        "s3://bucket/path",
+			wantSecretEnv: "access_key",
+		},
+		{
+			name:          "TestMigrateToDataset",
+			engine:        &ThinEngine{runtimeProfile: profile},
+			dataMigrate:   newDataMigrate(externalToMigrate, datasetToMigrate),
+			wantFrom:      "s3://bucket/path",
+			wantTo:        "/fluid/to/dir/",
+			wantSecretEnv: "access_key",
+		},
+		{
+			name:   "TestIncrementalMigrate",
+			engine: &ThinEngine{runtimeProfile: profile},
+			dataMigrate: func() *datav1alpha1.DataMigrate {
+				dataMigrate := newDataMigrate(externalToMigrate, datasetToMigrate)
+				dataMigrate.Spec.Mode = datav1alpha1.IncrementalMigrateMode
+				return dataMigrate
+			}(),
+			wantFrom:      "s3://bucket/path",
+			wantTo:        "/fluid/to/dir/",
+			wantSecretEnv: "access_key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.engine.Client = fake.NewFakeClientWithScheme(testScheme, dataset)
+			valueFileName, err := tt.engine.generateDataMigrateValueFile(cruntime.ReconcileRequestContext{}, tt.dataMigrate)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ThinEngine.generateDataMigrateValueFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			data, err := os.ReadFile(valueFileName)
+			if err != nil {
+				t.Fatalf("failed to read value file: %v", err)
+			}
+			var value DataMigrateValue
+			if err = yaml.Unmarshal(data, &value); err != nil {
+				t.Fatalf("failed to unmarshal value file: %v", err)
+			}
+
+			if got := getEnvValue(value.Operator.Envs, EnvDataMigrateFrom); got != tt.wantFrom {
+				t.Errorf("expect env %s to be %s, got %s", EnvDataMigrateFrom, tt.wantFrom, got)
+			}
+			if got := getEnvValue(value.Operator.Envs, EnvDataMigrateTo); got != tt.wantTo {
+				t.Errorf("expect env %s to be %s, got %s", EnvDataMigrateTo, tt.wantTo, got)
+			}
+			if got := getEnvValue(value.Operator.Envs, EnvDataMigrateMode); got != string(tt.dataMigrate.Spec.Mode) {
+				t.Errorf("expect env %s to be %s, got %s", EnvDataMigrateMode, tt.dataMigrate.Spec.Mode, got)
+			}
+			found, foundSince := false, false
+			for _, env := range value.Operator.Envs {
+				if env.Name == tt.wantSecretEnv && env.ValueFrom != nil && env.ValueFrom.SecretKeyRef.Name == "s3-secret" {
+					found = true
+				}
+				if env.Name == EnvDataMigrateSince && env.ValueFrom != nil && env.ValueFrom.ConfigMapKeyRef.Name == "demo-datamigrate-migrate-state" {
+					foundSince = true
+				}
+			}
+			if wantSince := tt.dataMigrate.Spec.Mode == datav1alpha1.IncrementalMigrateMode; foundSince != wantSince {
+				t.Errorf("expect env %s from the state configmap to be set: %v, got %v", EnvDataMigrateSince, wantSince, value.Operator.Envs)
+			}
+			if !found {
+				t.Errorf("expect env %s from secret, got %v", tt.wantSecretEnv, value.Operator.Envs)
+			}
+			if len(value.Operator.Volumes) != 1 || value.Operator.Volumes[0].PersistentVolumeClaim.ClaimName != "demo-dataset" {
+				t.Errorf("expect the pvc of dataset to be mounted, got %v", value.Operator.Volumes)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thin
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+)
+
+// getDataOperationTemplate returns the data operation template declared in the ThinRuntimeProfile,
+// it returns an error if the profile doesn't support the data operation.
+func (t *ThinEngine) getDataOperationTemplate(operationType dataoperation.OperationType) (template *datav1alpha1.ThinDataOperationSpec, err error) {
+	if t.runtimeProfile == nil {
+		return nil, fmt.Errorf("ThinRuntime %s/%s has no ThinRuntimeProfile, %s is not supported", t.namespace, t.name, operationType)
+	}
+
+	switch operationType {
+	case dataoperation.DataLoadType:
+		template = t.runtimeProfile.Spec.DataLoad
+	case dataoperation.DataMigrateType:
+		template = t.runtimeProfile.Spec.DataMigrate
+	}
+
+	if template == nil {
+		return nil, fmt.Errorf("ThinRuntimeProfile %s does not declare the template of %s, %s is not supported", t.runtimeProfile.Name, operationType, operationType)
+	}
+	return template, nil
+}
+
+// genDataOperator generates the image and the container of the data operation job from the template.
+func genDataOperator(template *datav1alpha1.ThinDataOperationSpec) (image string, operator DataOperator) {
+	image = template.Image
+	if len(template.ImageTag) > 0 {
+		image = fmt.Sprintf("%s:%s", template.Image, template.ImageTag)
+	}
+
+	operator = DataOperator{
+		ImagePullPolicy: template.ImagePullPolicy,
+		Command:         template.Command,
+		Args:            template.Args,
+	}
+	return
+}
+
+// mountDataset mounts the pvc of the dataset into the data operation container at the mount path.
+func (o *DataOperator) mountDataset(datasetName string, volumeName string, mountPath string) {
+	o.Volumes = append(o.Volumes, corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: datasetName,
+			},
+		},
+	})
+	o.VolumeMounts = append(o.VolumeMounts, corev1.VolumeMount{
+		Name:      volumeName,
+		MountPath: mountPath,
+	})
+}
+
+// genOptionArgs converts the options to command line arguments like `--key=value`, sorted by the keys.
+func genOptionArgs(options map[string]string) string {
+	keys := make([]string, 0, len(options))
+	for k := range options {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	args := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if options[k] != "" {
+			args = append(args, fmt.Sprintf("--%s=%s", k, options[k]))
+		} else {
+			args = append(args, fmt.Sprintf("--%s", k))
+		}
+	}
+	return strings.Join(args, " ")
+}
+
+// writeValueFile marshals the value to a temporary yaml file used by the data operation helm chart.
+func writeValueFile(value interface{}, pattern string) (valueFileName string, err error) {
+	data, err := yaml.Marshal(value)
+	if err != nil {
+		return
+	}
+
+	valueFile, err := os.CreateTemp(os.TempDir(), pattern)
+	if err != nil {
+		return
+	}
+	err = os.WriteFile(valueFile.Name(), data, 0o400)
+	if err != nil {
+		return
+	}
+	return valueFile.Name(), nil
+}
@@ -32,36 +32,40 @@ import (
 )
 
 func (t *ThinEngine) setupMasterInternal() (err error) {
-	var (
-		chartName = utils.GetChartsDirectory() + "/" + common.ThinChart
-	)
-
-	runtime, err := t.getRuntime()
+	valueFileName, chartName, err := t.GenerateRuntimeValueFile()
 	if err != nil {
 		return
 	}
 
-	profile, err := t.getThinRuntimeProfile()
-	if err != nil && !errors.IsNotFound(err) {
+	found, err := helm.CheckRelease(t.name, t.namespace)
+	if err != nil {
 		return
 	}
 
-	valueFileName, err := t.generateThinValueFile(runtime, profile)
-	if err != nil {
+	if found {
+		t.Log.Info("The release is already installed", "name", t.name, "namespace", t.namespace)
 		return
 	}
 
-	found, err := helm.CheckRelease(t.name, t.namespace)
+	return helm.InstallRelease(t.name, t.namespace, valueFileName, chartName)
+}
+
+// GenerateRuntimeValueFile transforms the runtime and its profile into the value file of the runtime chart
+func (t *ThinEngine) GenerateRuntimeValueFile() (valueFileName string, chartName string, err error) {
+	chartName = utils.GetChartsDirectory() + "/" + common.ThinChart
+
+	runtime, err := t.getRuntime()
 	if err != nil {
 		return
 	}
 
-	if found {
-		t.Log.Info("The release is already installed", "name", t.name, "namespace", t.namespace)
+	profile, err := t.getThinRuntimeProfile()
+	if err != nil && !errors.IsNotFound(err) {
 		return
 	}
 
-	return helm.InstallRelease(t.name, t.namespace, valueFileName, chartName)
+	valueFileName, err = t.generateThinValueFile(runtime, profile)
+	return
 }
 
 func (t *ThinEngine) generateThinValueFile(runtime *datav1alpha1.ThinRuntime, profile *datav1alpha1.ThinRuntimeProfile) (valueFileName string, err error) {
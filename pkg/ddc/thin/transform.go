@@ -96,10 +96,13 @@ func (t *ThinEngine) transformWorkers(runtime *datav1alpha1.ThinRuntime, profile
 	if len(runtime.Spec.Worker.Ports) != 0 {
 		value.Worker.Ports = append(value.Worker.Ports, runtime.Spec.Worker.Ports...)
 	}
-	// 4. nodeSelector
+	// 4. nodeSelector and scheduling
 	if len(runtime.Spec.Worker.NodeSelector) != 0 {
 		value.Worker.NodeSelector = runtime.Spec.Worker.NodeSelector
 	}
+	if scheduling := utils.TransformSchedulingSpec(runtime.Spec.Worker.SchedulingSpec); scheduling != nil {
+		value.Worker.Scheduling = scheduling
+	}
 
 	// 5. cachedir
 	if len(runtime.Spec.TieredStore.Levels) > 0 {
@@ -169,10 +172,11 @@ func (t *ThinEngine) parseFromProfile(profile *datav1alpha1.ThinRuntimeProfile,
 	if len(profile.Spec.Worker.Env) != 0 {
 		value.Worker.Envs = profile.Spec.Worker.Env
 	}
-	// 5. nodeSelector
+	// 5. nodeSelector and scheduling
 	if len(profile.Spec.Worker.NodeSelector) != 0 {
 		value.Worker.NodeSelector = profile.Spec.Worker.NodeSelector
 	}
+	value.Worker.Scheduling = utils.TransformSchedulingSpec(profile.Spec.Worker.SchedulingSpec)
 	// 6. ports
 	if len(profile.Spec.Worker.Ports) != 0 {
 		value.Worker.Ports = profile.Spec.Worker.Ports
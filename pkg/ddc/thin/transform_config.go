@@ -52,18 +52,22 @@ func (t *ThinEngine) transformFuseConfig(runtime *datav1alpha1.ThinRuntime, data
 	}
 
 	mounts := []datav1alpha1.Mount{}
-	// todo: support passing flexVolume info
 	pvAttributes := map[string]*corev1.CSIPersistentVolumeSource{}
+	pvSources := map[string]*PersistentVolumeSource{}
 	pvMountOptions := map[string][]string{}
 	for _, m := range dataset.Spec.Mounts {
 		if strings.HasPrefix(m.MountPoint, common.VolumeScheme.String()) {
 			pvcName := strings.TrimPrefix(m.MountPoint, common.VolumeScheme.String())
-			csiInfo, mountOptions, err := t.extractVolumeInfo(pvcName)
+			csiInfo, volumeSource, mountOptions, err := t.extractVolumeInfo(pvcName)
 			if err != nil {
 				return errors.Wrapf(err, "failed to extract volume info from PersistentVolumeClaim \"%s\"", pvcName)
 			}
 
-			pvAttributes[pvcName] = csiInfo
+			if csiInfo != nil {
+				pvAttributes[pvcName] = csiInfo
+			} else {
+				pvSources[pvcName] = volumeSource
+			}
 			pvMountOptions[pvcName] = mountOptions
 		}
 
@@ -81,6 +85,7 @@ func (t *ThinEngine) transformFuseConfig(runtime *datav1alpha1.ThinRuntime, data
 	config.RuntimeOptions = runtime.Spec.Fuse.Options
 	config.TargetPath = t.getTargetPath()
 	config.PersistentVolumeAttrs = pvAttributes
+	config.PersistentVolumeSources = pvSources
 	config.PersistentVolumeMountOptions = pvMountOptions
 	config.AccessModes = dataset.Spec.AccessModes
 
@@ -151,32 +156,123 @@ func (t *ThinEngine) extractMountOptions(m datav1alpha1.Mount, dataset *datav1al
 	return options, nil
 }
 
-func (t *ThinEngine) extractVolumeInfo(pvcName string) (csiInfo *corev1.CSIPersistentVolumeSource, mountOptions []string, err error) {
-	pvc, err := kubeclient.GetPersistentVolumeClaim(t.Client, pvcName, t.namespace)
+// extractVolumeInfo returns the volume source and the mount options of the PersistentVolume bound to the pvc. The CSI
+// source is returned as csiInfo, and the NFS, hostPath, local and flexVolume sources are returned as volumeSource.
+func (t *ThinEngine) extractVolumeInfo(pvcName string) (csiInfo *corev1.CSIPersistentVolumeSource, volumeSource *PersistentVolumeSource, mountOptions []string, err error) {
+	pv, err := t.getBoundPersistentVolume(pvcName)
 	if err != nil {
 		return
 	}
 
-	if len(pvc.Spec.VolumeName) == 0 || pvc.Status.Phase != corev1.ClaimBound {
-		err = fmt.Errorf("persistent volume claim %s not bounded yet", pvcName)
+	switch {
+	case pv.Spec.CSI != nil:
+		csiInfo = pv.Spec.CSI
+	case pv.Spec.NFS != nil:
+		volumeSource = &PersistentVolumeSource{NFS: pv.Spec.NFS}
+	case pv.Spec.HostPath != nil:
+		volumeSource = &PersistentVolumeSource{HostPath: pv.Spec.HostPath}
+	case pv.Spec.Local != nil:
+		volumeSource = &PersistentVolumeSource{Local: pv.Spec.Local}
+	case pv.Spec.FlexVolume != nil:
+		volumeSource = &PersistentVolumeSource{FlexVolume: pv.Spec.FlexVolume}
+	default:
+		err = unsupportedVolumeSourceError(pv)
 		return
 	}
 
-	pv, err := kubeclient.GetPersistentVolume(t.Client, pvc.Spec.VolumeName)
+	mountOptions, err = t.extractVolumeMountOptions(pv)
 	if err != nil {
 		return
 	}
 
-	if pv.Spec.CSI != nil {
-		csiInfo = pv.Spec.CSI
+	return
+}
+
+// transformFuseNodeAffinityForLocalVolumes restricts the fuse pods to the nodes of the hostPath and local
+// PersistentVolumes mounted by pvc://, otherwise the fuse pods on the other nodes serve the data of their own nodes.
+// The PersistentVolume without node affinity is only allowed if the fuse pods are pinned by the node selector.
+func (t *ThinEngine) transformFuseNodeAffinityForLocalVolumes(dataset *datav1alpha1.Dataset, value *ThinValue) error {
+	for _, m := range dataset.Spec.Mounts {
+		if !strings.HasPrefix(m.MountPoint, common.VolumeScheme.String()) {
+			continue
+		}
+		pvcName := strings.TrimPrefix(m.MountPoint, common.VolumeScheme.String())
+		pv, err := t.getBoundPersistentVolume(pvcName)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get the persistent volume of PersistentVolumeClaim \"%s\"", pvcName)
+		}
+		if pv.Spec.HostPath == nil && pv.Spec.Local == nil {
+			continue
+		}
+
+		if pv.Spec.NodeAffinity == nil || pv.Spec.NodeAffinity.Required == nil || len(pv.Spec.NodeAffinity.Required.NodeSelectorTerms) == 0 {
+			if !t.isFusePinned(value) {
+				return fmt.Errorf("persistent volume %s of PersistentVolumeClaim \"%s\" is local to a node but has no node affinity, "+
+					"the nodeSelector of fuse must be set to pin the fuse pods to the node", pv.Name, pvcName)
+			}
+			continue
+		}
+
+		if value.Fuse.Scheduling == nil {
+			value.Fuse.Scheduling = &common.Scheduling{}
+		}
+		value.Fuse.Scheduling.Affinity = mergeRequiredNodeAffinity(value.Fuse.Scheduling.Affinity, pv.Spec.NodeAffinity.Required)
 	}
 
-	mountOptions, err = t.extractVolumeMountOptions(pv)
+	return nil
+}
+
+// isFusePinned checks if the fuse pods are pinned by the node selector besides the fuse label set by Fluid
+func (t *ThinEngine) isFusePinned(value *ThinValue) bool {
+	fuseLabel := utils.GetFuseLabelName(t.namespace, t.name, t.runtimeInfo.GetOwnerDatasetUID())
+	for key := range value.Fuse.NodeSelector {
+		if key != fuseLabel {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeRequiredNodeAffinity requires the nodes to match both the required node affinity and the node selector. The
+// node selector terms are ORed, so the merged terms are the combinations of the terms of both.
+func mergeRequiredNodeAffinity(affinity *corev1.Affinity, nodeSelector *corev1.NodeSelector) *corev1.Affinity {
+	if affinity == nil {
+		affinity = &corev1.Affinity{}
+	}
+	if affinity.NodeAffinity == nil {
+		affinity.NodeAffinity = &corev1.NodeAffinity{}
+	}
+
+	required := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if required == nil || len(required.NodeSelectorTerms) == 0 {
+		affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = nodeSelector.DeepCopy()
+		return affinity
+	}
+
+	terms := make([]corev1.NodeSelectorTerm, 0, len(required.NodeSelectorTerms)*len(nodeSelector.NodeSelectorTerms))
+	for _, term := range required.NodeSelectorTerms {
+		for _, toMerge := range nodeSelector.NodeSelectorTerms {
+			merged := term.DeepCopy()
+			merged.MatchExpressions = append(merged.MatchExpressions, toMerge.DeepCopy().MatchExpressions...)
+			merged.MatchFields = append(merged.MatchFields, toMerge.DeepCopy().MatchFields...)
+			terms = append(terms, *merged)
+		}
+	}
+	required.NodeSelectorTerms = terms
+	return affinity
+}
+
+func (t *ThinEngine) getBoundPersistentVolume(pvcName string) (*corev1.PersistentVolume, error) {
+	pvc, err := kubeclient.GetPersistentVolumeClaim(t.Client, pvcName, t.namespace)
 	if err != nil {
-		return
+		return nil, err
 	}
 
-	return
+	if len(pvc.Spec.VolumeName) == 0 || pvc.Status.Phase != corev1.ClaimBound {
+		return nil, fmt.Errorf("persistent volume claim %s not bounded yet", pvcName)
+	}
+
+	return kubeclient.GetPersistentVolume(t.Client, pvc.Spec.VolumeName)
 }
 
 func (t *ThinEngine) extractVolumeMountOptions(pv *corev1.PersistentVolume) (mountOptions []string, err error) {
@@ -192,3 +288,7 @@ func (t *ThinEngine) extractVolumeMountOptions(pv *corev1.PersistentVolume) (mou
 
 	return
 }
+
+func unsupportedVolumeSourceError(pv *corev1.PersistentVolume) error {
+	return fmt.Errorf("persistent volume %s has unsupported volume source, only csi, nfs, hostPath, local and flexVolume are supported", pv.Name)
+}
@@ -20,9 +20,14 @@ import (
 	"reflect"
 	"testing"
 
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 func TestThinEngine_extractVolumeInfo(t *testing.T) {
@@ -61,7 +66,34 @@ func TestThinEngine_extractVolumeInfo(t *testing.T) {
 		},
 	}
 
-	client := fake.NewFakeClientWithScheme(testScheme, pvc, pv)
+	newPVCAndPV := func(name string, source corev1.PersistentVolumeSource) (*corev1.PersistentVolumeClaim, *corev1.PersistentVolume) {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: name + "-pvc", Namespace: "fluid"},
+			Spec:       corev1.PersistentVolumeClaimSpec{VolumeName: name + "-pv"},
+			Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound},
+		}, &corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: name + "-pv"},
+			Spec:       corev1.PersistentVolumeSpec{PersistentVolumeSource: source},
+		}
+	}
+	nfsSource := &corev1.NFSVolumeSource{Server: "nfs.example.com", Path: "/exports/data"}
+	nfsPVC, nfsPV := newPVCAndPV("nfs", corev1.PersistentVolumeSource{NFS: nfsSource})
+	nfsPV.Annotations = map[string]string{corev1.MountOptionAnnotation: "nfsvers=4.1,noresvport"}
+	hostPathSource := &corev1.HostPathVolumeSource{Path: "/mnt/data"}
+	hostPathPVC, hostPathPV := newPVCAndPV("hostpath", corev1.PersistentVolumeSource{HostPath: hostPathSource})
+	localSource := &corev1.LocalVolumeSource{Path: "/mnt/disks/ssd1"}
+	localPVC, localPV := newPVCAndPV("local", corev1.PersistentVolumeSource{Local: localSource})
+	flexSource := &corev1.FlexPersistentVolumeSource{
+		Driver:    "example.com/flex",
+		SecretRef: &corev1.SecretReference{Name: "flex-secret", Namespace: "fluid"},
+		Options:   map[string]string{"volumeId": "vol-1"},
+	}
+	flexPVC, flexPV := newPVCAndPV("flex", corev1.PersistentVolumeSource{FlexVolume: flexSource})
+	rbdPVC, rbdPV := newPVCAndPV("rbd", corev1.PersistentVolumeSource{RBD: &corev1.RBDPersistentVolumeSource{RBDImage: "foo"}})
+	unboundPVC := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "unbound-pvc", Namespace: "fluid"}}
+
+	client := fake.NewFakeClientWithScheme(testScheme, pvc, pv, nfsPVC, nfsPV, hostPathPVC, hostPathPV,
+		localPVC, localPV, flexPVC, flexPV, rbdPVC, rbdPV, unboundPVC)
 
 	engine := ThinEngine{
 		name:      "thin-test",
@@ -74,6 +106,7 @@ func TestThinEngine_extractVolumeInfo(t *testing.T) {
 		name             string
 		pvcName          string
 		wantCsiInfo      *corev1.CSIPersistentVolumeSource
+		wantVolumeSource *PersistentVolumeSource
 		wantMountOptions []string
 		wantErr          bool
 	}{
@@ -94,10 +127,41 @@ func TestThinEngine_extractVolumeInfo(t *testing.T) {
 			wantMountOptions: []string{"rw", "noexec"},
 			wantErr:          false,
 		},
+		{
+			name:             "testExtractNFSVolumeInfo",
+			pvcName:          "nfs-pvc",
+			wantVolumeSource: &PersistentVolumeSource{NFS: nfsSource},
+			wantMountOptions: []string{"nfsvers=4.1", "noresvport"},
+		},
+		{
+			name:             "testExtractHostPathVolumeInfo",
+			pvcName:          "hostpath-pvc",
+			wantVolumeSource: &PersistentVolumeSource{HostPath: hostPathSource},
+		},
+		{
+			name:             "testExtractLocalVolumeInfo",
+			pvcName:          "local-pvc",
+			wantVolumeSource: &PersistentVolumeSource{Local: localSource},
+		},
+		{
+			name:             "testExtractFlexVolumeInfo",
+			pvcName:          "flex-pvc",
+			wantVolumeSource: &PersistentVolumeSource{FlexVolume: flexSource},
+		},
+		{
+			name:    "testExtractUnsupportedVolumeInfo",
+			pvcName: "rbd-pvc",
+			wantErr: true,
+		},
+		{
+			name:    "testExtractUnboundVolumeInfo",
+			pvcName: "unbound-pvc",
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotCsiInfo, gotMountOptions, err := engine.extractVolumeInfo(tt.pvcName)
+			gotCsiInfo, gotVolumeSource, gotMountOptions, err := engine.extractVolumeInfo(tt.pvcName)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ThinEngine.extractVolumeInfo() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -105,6 +169,9 @@ func TestThinEngine_extractVolumeInfo(t *testing.T) {
 			if !reflect.DeepEqual(gotCsiInfo, tt.wantCsiInfo) {
 				t.Errorf("ThinEngine.extractVolumeInfo() gotCsiInfo = %v, want %v", gotCsiInfo, tt.wantCsiInfo)
 			}
+			if !reflect.DeepEqual(gotVolumeSource, tt.wantVolumeSource) {
+				t.Errorf("ThinEngine.extractVolumeInfo() gotVolumeSource = %v, want %v", gotVolumeSource, tt.wantVolumeSource)
+			}
 			if !reflect.DeepEqual(gotMountOptions, tt.wantMountOptions) {
 				t.Errorf("ThinEngine.extractVolumeInfo() gotMountOptions = %v, want %v", gotMountOptions, tt.wantMountOptions)
 			}
@@ -160,3 +227,122 @@ func TestThinEngine_extractVolumeMountOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestThinEngine_transformFuseNodeAffinityForLocalVolumes(t *testing.T) {
+	nodeSelector := &corev1.NodeSelector{
+		NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+			MatchExpressions: []corev1.NodeSelectorRequirement{{
+				Key:      "kubernetes.io/hostname",
+				Operator: corev1.NodeSelectorOpIn,
+				Values:   []string{"node-1"},
+			}},
+		}},
+	}
+	newPVCAndPV := func(name string, source corev1.PersistentVolumeSource, nodeAffinity *corev1.VolumeNodeAffinity) []runtime.Object {
+		return []runtime.Object{
+			&corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "fluid"},
+				Spec:       corev1.PersistentVolumeClaimSpec{VolumeName: name + "-pv"},
+				Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound},
+			},
+			&corev1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: name + "-pv"},
+				Spec:       corev1.PersistentVolumeSpec{PersistentVolumeSource: source, NodeAffinity: nodeAffinity},
+			},
+		}
+	}
+	var objs []runtime.Object
+	objs = append(objs, newPVCAndPV("local", corev1.PersistentVolumeSource{Local: &corev1.LocalVolumeSource{Path: "/mnt/disks/ssd1"}},
+		&corev1.VolumeNodeAffinity{Required: nodeSelector})...)
+	objs = append(objs, newPVCAndPV("hostpath", corev1.PersistentVolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/mnt/data"}}, nil)...)
+	objs = append(objs, newPVCAndPV("nfs", corev1.PersistentVolumeSource{NFS: &corev1.NFSVolumeSource{Server: "nfs.example.com", Path: "/"}}, nil)...)
+
+	runtimeInfo, err := base.BuildRuntimeInfo("thin-test", "fluid", common.ThinRuntime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	engine := ThinEngine{
+		name:        "thin-test",
+		namespace:   "fluid",
+		Client:      fake.NewFakeClientWithScheme(testScheme, objs...),
+		Log:         fake.NullLogger(),
+		runtimeInfo: runtimeInfo,
+	}
+	fuseLabel := utils.GetFuseLabelName("fluid", "thin-test", runtimeInfo.GetOwnerDatasetUID())
+	zoneAffinity := &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+			NodeSelectorTerms: []corev1.NodeSelectorTerm{
+				{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"a"}}}},
+				{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"b"}}}},
+			},
+		},
+	}}
+
+	tests := []struct {
+		name         string
+		pvcName      string
+		nodeSelector map[string]string
+		scheduling   *common.Scheduling
+		wantTerms    int
+		wantErr      bool
+	}{
+		{
+			name:      "local_volume_with_node_affinity",
+			pvcName:   "local",
+			wantTerms: 1,
+		},
+		{
+			name:       "local_volume_merged_with_fuse_affinity",
+			pvcName:    "local",
+			scheduling: &common.Scheduling{Affinity: zoneAffinity},
+			wantTerms:  2,
+		},
+		{
+			name:    "host_path_volume_without_node_affinity",
+			pvcName: "hostpath",
+			wantErr: true,
+		},
+		{
+			name:         "host_path_volume_with_pinned_fuse",
+			pvcName:      "hostpath",
+			nodeSelector: map[string]string{"kubernetes.io/hostname": "node-1"},
+		},
+		{
+			name:    "nfs_volume",
+			pvcName: "nfs",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value := &ThinValue{Fuse: Fuse{NodeSelector: map[string]string{fuseLabel: "true"}}}
+			if tt.scheduling != nil {
+				value.Fuse.Scheduling = &common.Scheduling{Affinity: tt.scheduling.Affinity.DeepCopy()}
+			}
+			for k, v := range tt.nodeSelector {
+				value.Fuse.NodeSelector[k] = v
+			}
+			dataset := &datav1alpha1.Dataset{Spec: datav1alpha1.DatasetSpec{Mounts: []datav1alpha1.Mount{{MountPoint: "pvc://" + tt.pvcName}}}}
+
+			err := engine.transformFuseNodeAffinityForLocalVolumes(dataset, value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("transformFuseNodeAffinityForLocalVolumes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var terms []corev1.NodeSelectorTerm
+			if value.Fuse.Scheduling != nil && value.Fuse.Scheduling.Affinity != nil {
+				terms = value.Fuse.Scheduling.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+			}
+			if len(terms) != tt.wantTerms {
+				t.Fatalf("transformFuseNodeAffinityForLocalVolumes() terms = %v, want %d terms", terms, tt.wantTerms)
+			}
+			for _, term := range terms {
+				if !reflect.DeepEqual(term.MatchExpressions[len(term.MatchExpressions)-1], nodeSelector.NodeSelectorTerms[0].MatchExpressions[0]) {
+					t.Errorf("transformFuseNodeAffinityForLocalVolumes() term = %v, want to require the node of the volume", term)
+				}
+			}
+		})
+	}
+}
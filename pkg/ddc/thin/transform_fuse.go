@@ -60,12 +60,15 @@ func (t *ThinEngine) transformFuse(runtime *datav1alpha1.ThinRuntime, profile *d
 		t.Log.Error(err, "failed to transform volumes for fuse")
 	}
 
-	// 5. nodeSelector
+	// 5. nodeSelector and scheduling
 	value.Fuse.NodeSelector = map[string]string{}
 	if len(runtime.Spec.Fuse.NodeSelector) > 0 {
 		value.Fuse.NodeSelector = runtime.Spec.Fuse.NodeSelector
 	}
 	value.Fuse.NodeSelector[utils.GetFuseLabelName(runtime.Namespace, runtime.Name, t.runtimeInfo.GetOwnerDatasetUID())] = "true"
+	if scheduling := utils.TransformSchedulingSpec(runtime.Spec.Fuse.SchedulingSpec); scheduling != nil {
+		value.Fuse.Scheduling = scheduling
+	}
 
 	// 6. ports
 	if len(runtime.Spec.Fuse.Ports) != 0 {
@@ -126,6 +129,10 @@ func (t *ThinEngine) transformFuse(runtime *datav1alpha1.ThinRuntime, profile *d
 	if err != nil {
 		return err
 	}
+	err = t.transformFuseNodeAffinityForLocalVolumes(dataset, value)
+	if err != nil {
+		return err
+	}
 
 	// 15. critical
 	// set critical fuse pod to avoid eviction
@@ -338,10 +345,11 @@ func (t *ThinEngine) parseFromProfileFuse(profile *datav1alpha1.ThinRuntimeProfi
 		t.Log.Error(err, "failed to transform volumes from profile for worker")
 	}
 
-	// 5. nodeSelector
+	// 5. nodeSelector and scheduling
 	if len(profile.Spec.Fuse.NodeSelector) != 0 {
 		value.Fuse.NodeSelector = profile.Spec.Fuse.NodeSelector
 	}
+	value.Fuse.Scheduling = utils.TransformSchedulingSpec(profile.Spec.Fuse.SchedulingSpec)
 	// 6. ports
 	if len(profile.Spec.Fuse.Ports) != 0 {
 		value.Fuse.Ports = profile.Spec.Fuse.Ports
@@ -50,18 +50,25 @@ func (t *ThinEngine) transfromSecretsForPersistentVolumeClaimMounts(dataset *dat
 				return err
 			}
 
-			// Currently only handle NodePublishSecret and ignore other secret refs.
-			if pv.Spec.CSI == nil {
-				return fmt.Errorf("persistent volume %s has unsupported volume source. only CSI is supported", pv.Name)
+			// Currently only handle NodePublishSecret of CSI and SecretRef of flexVolume, and ignore other secret refs.
+			var secretRef *corev1.SecretReference
+			switch {
+			case pv.Spec.CSI != nil:
+				secretRef = pv.Spec.CSI.NodePublishSecretRef
+			case pv.Spec.FlexVolume != nil:
+				secretRef = pv.Spec.FlexVolume.SecretRef
+			case pv.Spec.NFS != nil, pv.Spec.HostPath != nil, pv.Spec.Local != nil:
+			default:
+				return unsupportedVolumeSourceError(pv)
 			}
 
-			if pv.Spec.CSI.NodePublishSecretRef != nil {
-				secretName := pv.Spec.CSI.NodePublishSecretRef.Name
+			if secretRef != nil {
+				secretName := secretRef.Name
 				if len(secretName) == 0 {
 					continue
 				}
 
-				secretNamespace := pv.Spec.CSI.NodePublishSecretRef.Namespace
+				secretNamespace := secretRef.Namespace
 				if len(secretNamespace) == 0 {
 					secretNamespace = corev1.NamespaceDefault
 				}
@@ -177,4 +177,61 @@ func TestThinEngine_transfromSecretsForPersistentVolumeClaimMounts(t *testing.T)
 		}
 	})
 
+	thinValue = &ThinValue{
+		Fuse: Fuse{},
+	}
+
+	flexPV := pv.DeepCopy()
+	flexPV.Spec.PersistentVolumeSource = corev1.PersistentVolumeSource{
+		FlexVolume: &corev1.FlexPersistentVolumeSource{
+			Driver: "example.com/flex",
+			SecretRef: &corev1.SecretReference{
+				Name:      "my-secret",
+				Namespace: "node-publish-secrets",
+			},
+		},
+	}
+	engine.Client = fake.NewFakeClientWithScheme(testScheme, pvc, flexPV, mySecret)
+
+	t.Run("testing transformSecretsForpersistentVolumeClaimMounts with flexVolume secret", func(t *testing.T) {
+		if err := engine.transfromSecretsForPersistentVolumeClaimMounts(dataset, datav1alpha1.MountNodePublishSecretIfExists, thinValue); err != nil {
+			t.Fatalf("expect no error, but got error %v", err)
+		}
+
+		if len(thinValue.Fuse.Volumes) != 1 || thinValue.Fuse.Volumes[0].Secret.SecretName != "my-secret" {
+			t.Fatalf("expect appended secret volume of flexVolume to fuse, but got %v", thinValue.Fuse.Volumes)
+		}
+	})
+
+	thinValue = &ThinValue{
+		Fuse: Fuse{},
+	}
+
+	nfsPV := pv.DeepCopy()
+	nfsPV.Spec.PersistentVolumeSource = corev1.PersistentVolumeSource{
+		NFS: &corev1.NFSVolumeSource{Server: "nfs.example.com", Path: "/exports/data"},
+	}
+	engine.Client = fake.NewFakeClientWithScheme(testScheme, pvc, nfsPV)
+
+	t.Run("testing transformSecretsForpersistentVolumeClaimMounts with nfs volume", func(t *testing.T) {
+		if err := engine.transfromSecretsForPersistentVolumeClaimMounts(dataset, datav1alpha1.MountNodePublishSecretIfExists, thinValue); err != nil {
+			t.Fatalf("expect no error, but got error %v", err)
+		}
+
+		if len(thinValue.Fuse.Volumes) != 0 {
+			t.Fatalf("expect no modification to volumes of fuse, but got %v", thinValue.Fuse.Volumes)
+		}
+	})
+
+	rbdPV := pv.DeepCopy()
+	rbdPV.Spec.PersistentVolumeSource = corev1.PersistentVolumeSource{
+		RBD: &corev1.RBDPersistentVolumeSource{RBDImage: "foo"},
+	}
+	engine.Client = fake.NewFakeClientWithScheme(testScheme, pvc, rbdPV)
+
+	t.Run("testing transformSecretsForpersistentVolumeClaimMounts with unsupported volume", func(t *testing.T) {
+		if err := engine.transfromSecretsForPersistentVolumeClaimMounts(dataset, datav1alpha1.MountNodePublishSecretIfExists, thinValue); err == nil {
+			t.Fatalf("expect error for unsupported volume source, but got nil")
+		}
+	})
 }
@@ -233,3 +233,55 @@ func TestThinEngine_parseWorkerImage(t1 *testing.T) {
 		})
 	}
 }
+
+func TestThinEngine_transformWorkersScheduling(t *testing.T) {
+	profile := &datav1alpha1.ThinRuntimeProfile{
+		Spec: datav1alpha1.ThinRuntimeProfileSpec{
+			Worker: datav1alpha1.ThinCompTemplateSpec{
+				SchedulingSpec: datav1alpha1.SchedulingSpec{
+					PriorityClassName: "profile",
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name    string
+		runtime *datav1alpha1.ThinRuntime
+		want    *common.Scheduling
+	}{
+		{
+			name:    "scheduling_of_profile",
+			runtime: &datav1alpha1.ThinRuntime{},
+			want:    &common.Scheduling{PriorityClassName: "profile"},
+		},
+		{
+			name: "scheduling_of_runtime",
+			runtime: &datav1alpha1.ThinRuntime{
+				Spec: datav1alpha1.ThinRuntimeSpec{
+					Worker: datav1alpha1.ThinCompTemplateSpec{
+						SchedulingSpec: datav1alpha1.SchedulingSpec{
+							Tolerations:   []corev1.Toleration{{Key: "gpu", Operator: corev1.TolerationOpExists}},
+							SchedulerName: "volcano",
+						},
+					},
+				},
+			},
+			want: &common.Scheduling{
+				Tolerations:   []corev1.Toleration{{Key: "gpu", Operator: corev1.TolerationOpExists}},
+				SchedulerName: "volcano",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := &ThinEngine{Log: fake.NullLogger()}
+			value := &ThinValue{}
+			if err := engine.transformWorkers(tt.runtime, profile, value); err != nil {
+				t.Fatalf("transformWorkers() error = %v", err)
+			}
+			if !reflect.DeepEqual(value.Worker.Scheduling, tt.want) {
+				t.Errorf("transformWorkers() scheduling = %v, want %v", value.Worker.Scheduling, tt.want)
+			}
+		})
+	}
+}
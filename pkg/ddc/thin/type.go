@@ -19,6 +19,8 @@ package thin
 import (
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
+	cdatamigrate "github.com/fluid-cloudnative/fluid/pkg/datamigrate"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -46,6 +48,7 @@ type Worker struct {
 	ImagePullPolicy  string                        `json:"imagePullPolicy,omitempty"`
 	Resources        common.Resources              `json:"resources,omitempty"`
 	NodeSelector     map[string]string             `json:"nodeSelector,omitempty"`
+	Scheduling       *common.Scheduling            `json:"scheduling,omitempty"`
 	HostNetwork      bool                          `json:"hostNetwork,omitempty"`
 	Envs             []corev1.EnvVar               `json:"envs,omitempty"`
 	Ports            []corev1.ContainerPort        `json:"ports,omitempty"`
@@ -71,6 +74,7 @@ type Fuse struct {
 	HostPID          bool                          `json:"hostPID,omitempty"`
 	TargetPath       string                        `json:"targetPath,omitempty"`
 	NodeSelector     map[string]string             `json:"nodeSelector,omitempty"`
+	Scheduling       *common.Scheduling            `json:"scheduling,omitempty"`
 	Envs             []corev1.EnvVar               `json:"envs,omitempty"`
 	Command          []string                      `json:"command,omitempty"`
 	Args             []string                      `json:"args,omitempty"`
@@ -90,6 +94,37 @@ type Config struct {
 	TargetPath                   string                                       `json:"targetPath,omitempty"`
 	RuntimeOptions               map[string]string                            `json:"runtimeOptions,omitempty"`
 	PersistentVolumeAttrs        map[string]*corev1.CSIPersistentVolumeSource `json:"persistentVolumeAttrs,omitempty"`
+	PersistentVolumeSources      map[string]*PersistentVolumeSource           `json:"persistentVolumeSources,omitempty"`
 	PersistentVolumeMountOptions map[string][]string                          `json:"persistentVolumeMountOptions,omitempty"`
 	AccessModes                  []corev1.PersistentVolumeAccessMode          `json:"accessModes,omitempty"`
 }
+
+// PersistentVolumeSource is the in-tree or flexVolume source of the PersistentVolume bound to a pvc:// mount point,
+// exactly one of its members is set. The CSI sources are passed by PersistentVolumeAttrs instead.
+type PersistentVolumeSource struct {
+	NFS        *corev1.NFSVolumeSource            `json:"nfs,omitempty"`
+	HostPath   *corev1.HostPathVolumeSource       `json:"hostPath,omitempty"`
+	Local      *corev1.LocalVolumeSource          `json:"local,omitempty"`
+	FlexVolume *corev1.FlexPersistentVolumeSource `json:"flexVolume,omitempty"`
+}
+
+// DataOperator is the container of the data operation job, it's generated from
+// the data operation template declared in ThinRuntimeProfile.
+type DataOperator struct {
+	ImagePullPolicy string               `json:"imagePullPolicy,omitempty"`
+	Command         []string             `json:"command,omitempty"`
+	Args            []string             `json:"args,omitempty"`
+	Envs            []corev1.EnvVar      `json:"envs,omitempty"`
+	Volumes         []corev1.Volume      `json:"volumes,omitempty"`
+	VolumeMounts    []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+}
+
+type DataLoadValue struct {
+	cdataload.DataLoadValue `json:",inline"`
+	Operator                DataOperator `json:"operator"`
+}
+
+type DataMigrateValue struct {
+	cdatamigrate.DataMigrateValue `json:",inline"`
+	Operator                      DataOperator `json:"operator"`
+}
@@ -17,10 +17,10 @@ limitations under the License.
 package thin
 
 import (
-	"fmt"
-
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -28,6 +28,7 @@ type validateFn func(ctx cruntime.ReconcileRequestContext) error
 
 var checks []validateFn = []validateFn{
 	validateDuplicateDatasetMounts,
+	validateAutoscaling,
 }
 
 func validateDuplicateDatasetMounts(ctx cruntime.ReconcileRequestContext) error {
@@ -35,35 +36,19 @@ func validateDuplicateDatasetMounts(ctx cruntime.ReconcileRequestContext) error
 		return nil
 	}
 
-	if len(ctx.Dataset.Spec.Mounts) == 0 {
-		return nil
-	}
-
-	fieldErrorList := field.ErrorList{}
-
-	existedMountNames := map[string]int{}
-	existedMountPath := map[string]int{}
-	for idx, mount := range ctx.Dataset.Spec.Mounts {
-		path := mount.Path
-		if len(path) == 0 {
-			path = fmt.Sprintf("/%s", mount.Name)
-		}
-
-		if _, exists := existedMountNames[mount.Name]; exists {
-			fieldErrorList = append(fieldErrorList, field.Duplicate(field.NewPath("Dataset").Child("spec", "mounts").Index(idx).Child("name"), mount.Name))
-			continue // Skip to next iteration because collided mount names imply collided mount paths.
-		}
-
-		if _, exists := existedMountPath[path]; exists {
-			fieldErrorList = append(fieldErrorList, field.Duplicate(field.NewPath("Dataset").Child("spec", "mounts").Index(idx).Child("path"), path))
-			continue
-		}
+	return validation.ValidateDuplicateDatasetMounts(ctx.Dataset.Spec.Mounts, field.NewPath("Dataset").Child("spec", "mounts")).ToAggregate()
+}
 
-		existedMountNames[mount.Name] = idx
-		existedMountPath[path] = idx
+// validateAutoscaling rejects the autoscaling policy shared by RuntimeManagement, because the workers of
+// ThinRuntime are not scaled automatically.
+func validateAutoscaling(ctx cruntime.ReconcileRequestContext) error {
+	runtime, ok := ctx.Runtime.(*datav1alpha1.ThinRuntime)
+	if !ok || runtime.Spec.RuntimeManagement.Autoscaling == nil {
+		return nil
 	}
 
-	return fieldErrorList.ToAggregate()
+	return field.Forbidden(field.NewPath("ThinRuntime").Child("spec", "management", "autoscaling"),
+		"autoscaling is not supported by ThinRuntime")
 }
 
 func (t *ThinEngine) Validate(ctx cruntime.ReconcileRequestContext) (err error) {
@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package thin
+
+import (
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestThinEngine_Validate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		mounts  []datav1alpha1.Mount
+		wantErr bool
+	}{
+		{
+			name: "schemeless nfs mount point",
+			mounts: []datav1alpha1.Mount{
+				{Name: "nfs", MountPoint: "192.168.0.1:/nfs/data"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "duplicate mount names",
+			mounts: []datav1alpha1.Mount{
+				{Name: "nfs", MountPoint: "192.168.0.1:/nfs/data"},
+				{Name: "nfs", MountPoint: "192.168.0.1:/nfs/data2", Path: "/nfs2"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate mount paths",
+			mounts: []datav1alpha1.Mount{
+				{Name: "nfs", MountPoint: "192.168.0.1:/nfs/data"},
+				{Name: "glusterfs", MountPoint: "192.168.0.2:/gv0", Path: "/nfs"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		dataset := &datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "fluid", UID: "uid-hbase"},
+			Spec: datav1alpha1.DatasetSpec{
+				Mounts:        tc.mounts,
+				PlacementMode: datav1alpha1.ExclusiveMode,
+			},
+		}
+
+		runtimeInfo, err := base.BuildRuntimeInfo("hbase", "fluid", common.ThinRuntime)
+		if err != nil {
+			t.Fatalf("testcase %s: failed to build runtime info: %v", tc.name, err)
+		}
+		runtimeInfo.SetOwnerDatasetUID(dataset.UID)
+		runtimeInfo.SetupWithDataset(dataset)
+
+		engine := &ThinEngine{
+			name:        "hbase",
+			namespace:   "fluid",
+			runtimeInfo: runtimeInfo,
+			Log:         fake.NullLogger(),
+		}
+
+		err = engine.Validate(cruntime.ReconcileRequestContext{Dataset: dataset})
+		if gotErr := err != nil; gotErr != tc.wantErr {
+			t.Errorf("testcase %s: expect error %v, got %v", tc.name, tc.wantErr, err)
+		}
+	}
+}
+
+func TestValidateAutoscaling(t *testing.T) {
+	runtime := &datav1alpha1.ThinRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "fluid"},
+	}
+	if err := validateAutoscaling(cruntime.ReconcileRequestContext{Runtime: runtime}); err != nil {
+		t.Errorf("expect no error without autoscaling policy, got %v", err)
+	}
+
+	runtime.Spec.RuntimeManagement.Autoscaling = &datav1alpha1.AutoscalingPolicy{MaxReplicas: 3}
+	if err := validateAutoscaling(cruntime.ReconcileRequestContext{Runtime: runtime}); err == nil {
+		t.Errorf("expect error when autoscaling is set on ThinRuntime")
+	}
+}
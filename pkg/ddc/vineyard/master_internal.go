@@ -27,16 +27,7 @@ import (
 
 // setup the cache master
 func (e *VineyardEngine) setupMasterInternal() (err error) {
-	var (
-		chartName = utils.GetChartsDirectory() + "/" + common.VineyardChart
-	)
-
-	runtime, err := e.getRuntime()
-	if err != nil {
-		return
-	}
-
-	valuefileName, err := e.generateVineyardValueFile(runtime)
+	valuefileName, chartName, err := e.GenerateRuntimeValueFile()
 	if err != nil {
 		return
 	}
@@ -54,6 +45,19 @@ func (e *VineyardEngine) setupMasterInternal() (err error) {
 	return helm.InstallRelease(e.name, e.namespace, valuefileName, chartName)
 }
 
+// GenerateRuntimeValueFile transforms the runtime into the value file of the runtime chart
+func (e *VineyardEngine) GenerateRuntimeValueFile() (valueFileName string, chartName string, err error) {
+	chartName = utils.GetChartsDirectory() + "/" + common.VineyardChart
+
+	runtime, err := e.getRuntime()
+	if err != nil {
+		return
+	}
+
+	valueFileName, err = e.generateVineyardValueFile(runtime)
+	return
+}
+
 // generate vineyard struct
 func (e *VineyardEngine) generateVineyardValueFile(runtime *datav1alpha1.VineyardRuntime) (valueFileName string, err error) {
 
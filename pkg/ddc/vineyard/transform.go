@@ -107,6 +107,7 @@ func (e *VineyardEngine) transformMasters(runtime *datav1alpha1.VineyardRuntime,
 	if len(nodeSelector) != 0 {
 		value.Master.NodeSelector = nodeSelector
 	}
+	value.Master.Scheduling = utils.TransformSchedulingSpec(runtime.Spec.Master.SchedulingSpec)
 
 	e.transformResourcesForMaster(runtime, value)
 
@@ -148,6 +149,7 @@ func (e *VineyardEngine) transformWorkers(runtime *datav1alpha1.VineyardRuntime,
 	} else {
 		value.Worker.NodeSelector = map[string]string{}
 	}
+	value.Worker.Scheduling = utils.TransformSchedulingSpec(runtime.Spec.Worker.SchedulingSpec)
 
 	if err := e.transformResourcesForWorker(runtime, value); err != nil {
 		return err
@@ -184,6 +186,7 @@ func (e *VineyardEngine) transformFuse(runtime *datav1alpha1.VineyardRuntime, va
 	value.Fuse.CleanPolicy = runtime.Spec.Fuse.CleanPolicy
 
 	value.Fuse.NodeSelector = e.transformFuseNodeSelector(runtime)
+	value.Fuse.Scheduling = utils.TransformSchedulingSpec(runtime.Spec.Fuse.SchedulingSpec)
 	value.Fuse.HostPID = common.HostPIDEnabled(runtime.Annotations)
 
 	value.Fuse.TargetPath = e.getMountPoint()
@@ -57,6 +57,7 @@ type Master struct {
 	ImagePullPolicy  string               `json:"imagePullPolicy,omitempty"`
 	HostNetwork      bool                 `json:"hostNetwork,omitempty"`
 	NodeSelector     map[string]string    `json:"nodeSelector,omitempty"`
+	Scheduling       *common.Scheduling   `json:"scheduling,omitempty"`
 	Ports            map[string]int       `json:"ports,omitempty"`
 	Env              map[string]string    `json:"env,omitempty"`
 	Options          map[string]string    `json:"options,omitempty"`
@@ -74,6 +75,7 @@ type Worker struct {
 	ImagePullPolicy string               `json:"imagePullPolicy,omitempty"`
 	HostNetwork     bool                 `json:"hostNetwork,omitempty"`
 	NodeSelector    map[string]string    `json:"nodeSelector,omitempty"`
+	Scheduling      *common.Scheduling   `json:"scheduling,omitempty"`
 	Ports           map[string]int       `json:"ports,omitempty"`
 	Env             map[string]string    `json:"env,omitempty"`
 	Options         map[string]string    `json:"options,omitempty"`
@@ -92,6 +94,7 @@ type Fuse struct {
 	CleanPolicy     datav1alpha1.FuseCleanPolicy `json:"cleanPolicy,omitempty"`
 	TargetPath      string                       `json:"targetPath,omitempty"`
 	NodeSelector    map[string]string            `json:"nodeSelector,omitempty"`
+	Scheduling      *common.Scheduling           `json:"scheduling,omitempty"`
 	Resources       common.Resources             `json:"resources,omitempty"`
 	HostPID         bool                         `json:"hostPID,omitempty"`
 	Options         map[string]string            `json:"options,omitempty"`
@@ -0,0 +1,118 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+var (
+	dataLoadLoadedBytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dataload_loaded_bytes",
+		Help: "Size of data loaded by a specific dataload under a target path",
+	}, []string{"dataload", "path"})
+
+	dataLoadTotalBytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dataload_total_bytes",
+		Help: "Total size of data to load by a specific dataload under a target path",
+	}, []string{"dataload", "path"})
+
+	dataLoadLoadedFiles = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dataload_loaded_files",
+		Help: "Num of files loaded by a specific dataload under a target path",
+	}, []string{"dataload", "path"})
+
+	dataLoadTotalFiles = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dataload_total_files",
+		Help: "Total num of files to load by a specific dataload under a target path",
+	}, []string{"dataload", "path"})
+
+	dataLoadETASeconds = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dataload_eta_seconds",
+		Help: "Estimated remaining seconds for a specific dataload to finish a target path",
+	}, []string{"dataload", "path"})
+)
+
+var dataLoadMetricsMap sync.Map // race condition protection for dataLoadMetricsMap's concurrent writes
+
+type dataLoadMetrics struct {
+	dataLoadKey string
+
+	mu    sync.Mutex
+	paths map[string]bool
+}
+
+func GetOrCreateDataLoadMetrics(namespace, name string) *dataLoadMetrics {
+	key := labelKeyFunc(namespace, name)
+	m := &dataLoadMetrics{
+		dataLoadKey: key,
+		paths:       map[string]bool{},
+	}
+
+	ret, _ := dataLoadMetricsMap.LoadOrStore(key, m)
+
+	return ret.(*dataLoadMetrics)
+}
+
+func (m *dataLoadMetrics) labels(path string) prometheus.Labels {
+	return prometheus.Labels{"dataload": m.dataLoadKey, "path": path}
+}
+
+// SetProgress sets the progress of target paths reported by the dataload job
+func (m *dataLoadMetrics) SetProgress(progress []datav1alpha1.TargetPathProgress) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, p := range progress {
+		labels := m.labels(p.Path)
+		dataLoadLoadedBytes.With(labels).Set(float64(p.LoadedBytes))
+		dataLoadTotalBytes.With(labels).Set(float64(p.TotalBytes))
+		dataLoadLoadedFiles.With(labels).Set(float64(p.LoadedFiles))
+		dataLoadTotalFiles.With(labels).Set(float64(p.TotalFiles))
+		if eta, err := time.ParseDuration(p.ETA); err == nil {
+			dataLoadETASeconds.With(labels).Set(eta.Seconds())
+		}
+		m.paths[p.Path] = true
+	}
+}
+
+func (m *dataLoadMetrics) Forget() {
+	m.mu.Lock()
+	for path := range m.paths {
+		labels := m.labels(path)
+		dataLoadLoadedBytes.Delete(labels)
+		dataLoadTotalBytes.Delete(labels)
+		dataLoadLoadedFiles.Delete(labels)
+		dataLoadTotalFiles.Delete(labels)
+		dataLoadETASeconds.Delete(labels)
+	}
+	m.paths = map[string]bool{}
+	m.mu.Unlock()
+
+	dataLoadMetricsMap.Delete(m.dataLoadKey)
+}
+
+func init() {
+	metrics.Registry.MustRegister(dataLoadLoadedBytes, dataLoadTotalBytes, dataLoadLoadedFiles, dataLoadTotalFiles, dataLoadETASeconds)
+	dataLoadMetricsMap = sync.Map{}
+}
@@ -33,6 +33,21 @@ var (
 		Name: "dataset_ufs_total_size",
 		Help: "Total size of files in dataset",
 	}, []string{"dataset"})
+
+	datasetMetadataSyncDuration = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dataset_metadata_sync_duration_seconds",
+		Help: "Duration in seconds of the last metadata sync of a specific dataset",
+	}, []string{"dataset"})
+
+	datasetMetadataLastSyncTime = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dataset_metadata_last_sync_timestamp_seconds",
+		Help: "Unix timestamp of the last successful metadata sync of a specific dataset",
+	}, []string{"dataset"})
+
+	datasetMetadataSyncFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "dataset_metadata_sync_failures_total",
+		Help: "Total num of failed metadata syncs of a specific dataset",
+	}, []string{"dataset"})
 )
 
 var datasetMetricsMap sync.Map // race condition protection for datasetMetricsMap's concurrent writes
@@ -62,14 +77,30 @@ func (m *datasetMetrics) SetUFSFileNum(num float64) {
 	datasetUFSFileNum.With(m.labels).Set(num)
 }
 
+func (m *datasetMetrics) SetMetadataSyncDuration(seconds float64) {
+	datasetMetadataSyncDuration.With(m.labels).Set(seconds)
+}
+
+func (m *datasetMetrics) SetMetadataLastSyncTime(timestamp float64) {
+	datasetMetadataLastSyncTime.With(m.labels).Set(timestamp)
+}
+
+func (m *datasetMetrics) MetadataSyncFailureInc() {
+	datasetMetadataSyncFailures.With(m.labels).Inc()
+}
+
 func (m *datasetMetrics) Forget() {
 	datasetUFSTotalSize.Delete(m.labels)
 	datasetUFSFileNum.Delete(m.labels)
+	datasetMetadataSyncDuration.Delete(m.labels)
+	datasetMetadataLastSyncTime.Delete(m.labels)
+	datasetMetadataSyncFailures.Delete(m.labels)
 
 	datasetMetricsMap.Delete(m.datasetKey)
 }
 
 func init() {
-	metrics.Registry.MustRegister(datasetUFSFileNum, datasetUFSTotalSize)
+	metrics.Registry.MustRegister(datasetUFSFileNum, datasetUFSTotalSize,
+		datasetMetadataSyncDuration, datasetMetadataLastSyncTime, datasetMetadataSyncFailures)
 	datasetMetricsMap = sync.Map{}
 }
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	fuseRecoveryResultSucceed = "succeed"
+	fuseRecoveryResultFailed  = "failed"
+)
+
+var (
+	fuseRecoveryTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "fuse_recovery_total",
+		Help: "Total num of broken FUSE mount points recovered by the csi plugin",
+	}, []string{"dataset", "result"})
+
+	fuseRestartTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "fuse_restart_total",
+		Help: "Total num of FUSE pods restarted by the csi plugin due to disconnected mount points",
+	}, []string{"dataset", "result"})
+)
+
+// FuseRecoveryInc increases the num of recovered mount points of the dataset, labeled by the result
+func FuseRecoveryInc(namespacedDatasetName string, succeed bool) {
+	fuseRecoveryTotal.WithLabelValues(namespacedDatasetName, fuseRecoveryResult(succeed)).Inc()
+}
+
+// FuseRestartInc increases the num of restarted FUSE pods of the dataset, labeled by the result
+func FuseRestartInc(namespacedDatasetName string, succeed bool) {
+	fuseRestartTotal.WithLabelValues(namespacedDatasetName, fuseRecoveryResult(succeed)).Inc()
+}
+
+func fuseRecoveryResult(succeed bool) string {
+	if succeed {
+		return fuseRecoveryResultSucceed
+	}
+	return fuseRecoveryResultFailed
+}
+
+func init() {
+	metrics.Registry.MustRegister(fuseRecoveryTotal, fuseRestartTotal)
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ufschange
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Manager runs the sources of ufs changes and passes their events to the handler, e.g. the one re-triggering the
+// OnEvent DataLoads of the changed datasets.
+type Manager struct {
+	handler Handler
+	log     logr.Logger
+	sources []Source
+}
+
+var _ manager.LeaderElectionRunnable = &Manager{}
+
+// NewManager creates the manager of the given sources.
+func NewManager(handler Handler, log logr.Logger, sources ...Source) *Manager {
+	return &Manager{
+		handler: handler,
+		log:     log,
+		sources: sources,
+	}
+}
+
+// Start starts all the sources and blocks until the context is done or any source fails.
+func (m *Manager) Start(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errCh := make(chan error, len(m.sources))
+	for _, source := range m.sources {
+		m.log.Info("Starting ufs change source", "source", source.Name())
+		go func(source Source) {
+			if err := source.Start(ctx, m.handler); err != nil {
+				errCh <- fmt.Errorf("ufs change source %s failed: %v", source.Name(), err)
+			}
+		}(source)
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-errCh:
+		return err
+	}
+}
+
+// NeedLeaderElection returns false so that the sources receiving notifications are served by all the replicas,
+// the handler is expected to update the data operations with optimistic concurrency.
+func (m *Manager) NeedLeaderElection() bool {
+	return false
+}
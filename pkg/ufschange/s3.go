@@ -0,0 +1,249 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ufschange
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+const (
+	// S3WebhookPath is the path of the webhook endpoint receiving the S3 event notifications
+	S3WebhookPath = "/ufs-events/s3"
+
+	s3WebhookSourceName = "S3Webhook"
+
+	// the event notifications are small, limit the request body to avoid abuse
+	maxS3NotificationSize = 10 << 20
+)
+
+// s3Schemes are the schemes of the mount points served by the S3 compatible object storages
+var s3Schemes = []string{"s3", "s3a", "s3n"}
+
+// s3Notification is the event notification sent by S3 compatible object storages, e.g. MinIO bucket notifications
+// to webhook targets. Only the fields used to locate the changed objects are decoded.
+type s3Notification struct {
+	Records []s3Record `json:"Records"`
+}
+
+type s3Record struct {
+	EventName string `json:"eventName"`
+	S3        struct {
+		Bucket struct {
+			Name string `json:"name"`
+		} `json:"bucket"`
+		Object struct {
+			// Key is url encoded, e.g. dir%2Fdata.csv
+			Key string `json:"key"`
+		} `json:"object"`
+	} `json:"s3"`
+}
+
+// S3WebhookSource serves a webhook endpoint receiving the event notifications of S3 compatible object storages, and
+// maps the changed objects to the paths of the datasets mounting the buckets. The notifications must carry the shared
+// token in the Authorization header, e.g. the auth_token of MinIO webhook targets, and are limited to the datasets of
+// the namespace or a single dataset by the query parameters, e.g. /ufs-events/s3?namespace=default&dataset=demo
+type S3WebhookSource struct {
+	addr   string
+	token  string
+	client client.Client
+	log    logr.Logger
+}
+
+var _ Source = &S3WebhookSource{}
+
+// NewS3WebhookSource creates the source serving the webhook endpoint on the given address, the notifications
+// are authenticated by the shared token.
+func NewS3WebhookSource(addr string, token string, client client.Client, log logr.Logger) *S3WebhookSource {
+	return &S3WebhookSource{
+		addr:   addr,
+		token:  token,
+		client: client,
+		log:    log,
+	}
+}
+
+func (s *S3WebhookSource) Name() string {
+	return s3WebhookSourceName
+}
+
+func (s *S3WebhookSource) Start(ctx context.Context, handler Handler) error {
+	if len(s.token) == 0 {
+		return errors.New("the token of the S3 webhook is not set")
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(S3WebhookPath, func(w http.ResponseWriter, r *http.Request) {
+		s.serveHTTP(w, r, handler)
+	})
+
+	server := &http.Server{
+		Addr:              s.addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			s.log.Error(err, "Failed to shutdown S3 webhook server")
+		}
+	}()
+
+	s.log.Info("Starting S3 webhook server", "address", s.addr, "path", S3WebhookPath)
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func (s *S3WebhookSource) serveHTTP(w http.ResponseWriter, r *http.Request, handler Handler) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST is allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if !s.authorized(r) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	namespace := r.URL.Query().Get("namespace")
+	if len(namespace) == 0 {
+		http.Error(w, "the namespace of the datasets is required", http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxS3NotificationSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	notification := &s3Notification{}
+	if len(body) > 0 {
+		if err = json.Unmarshal(body, notification); err != nil {
+			http.Error(w, fmt.Sprintf("failed to parse the event notification: %v", err), http.StatusBadRequest)
+			return
+		}
+	}
+
+	events, err := s.getEvents(r.Context(), namespace, r.URL.Query().Get("dataset"), notification)
+	if err != nil {
+		s.log.Error(err, "Failed to get the changed datasets of the event notification")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	for _, event := range events {
+		if err = handler(r.Context(), event); err != nil {
+			s.log.Error(err, "Failed to handle the ufs change event", "dataset", event.Dataset)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// authorized checks the token in the Authorization header, which is either a bearer token or the bare token
+// sent by MinIO webhook targets.
+func (s *S3WebhookSource) authorized(r *http.Request) bool {
+	token := strings.TrimSpace(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+	return len(token) > 0 && subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) == 1
+}
+
+// getEvents maps the changed objects of the notification to the changed paths of the datasets.
+func (s *S3WebhookSource) getEvents(ctx context.Context, namespace, name string, notification *s3Notification) ([]Event, error) {
+	// the test events sent when configuring notifications carry no records
+	if len(notification.Records) == 0 {
+		return nil, nil
+	}
+
+	var datasets []datav1alpha1.Dataset
+	if len(name) > 0 {
+		dataset := &datav1alpha1.Dataset{}
+		if err := s.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, dataset); err != nil {
+			return nil, utils.IgnoreNotFound(err)
+		}
+		datasets = append(datasets, *dataset)
+	} else {
+		datasetList := &datav1alpha1.DatasetList{}
+		if err := s.client.List(ctx, datasetList, client.InNamespace(namespace)); err != nil {
+			return nil, err
+		}
+		datasets = datasetList.Items
+	}
+
+	var events []Event
+	for _, dataset := range datasets {
+		var changedPaths []string
+		for _, record := range notification.Records {
+			key, err := url.QueryUnescape(record.S3.Object.Key)
+			if err != nil {
+				s.log.Info("Skip the event record with malformed object key", "key", record.S3.Object.Key)
+				continue
+			}
+			changedPaths = append(changedPaths, getDatasetPaths(dataset.Spec.Mounts, record.S3.Bucket.Name, key)...)
+		}
+
+		if len(changedPaths) > 0 {
+			events = append(events, Event{
+				Dataset: types.NamespacedName{Namespace: dataset.Namespace, Name: dataset.Name},
+				Paths:   changedPaths,
+			})
+		}
+	}
+	return events, nil
+}
+
+// getDatasetPaths returns the paths in the dataset of the object in the bucket, e.g. the object data/a.csv of
+// bucket demo is /spark/a.csv in the dataset mounting s3://demo/data on /spark.
+func getDatasetPaths(mounts []datav1alpha1.Mount, bucket, key string) (paths []string) {
+	for _, mount := range mounts {
+		u, err := url.Parse(mount.MountPoint)
+		if err != nil || !utils.ContainsString(s3Schemes, u.Scheme) || u.Host != bucket {
+			continue
+		}
+
+		prefix := strings.Trim(u.Path, "/")
+		if len(prefix) > 0 && key != prefix && !strings.HasPrefix(key, prefix+"/") {
+			continue
+		}
+
+		mountPath := utils.UFSPathBuilder{}.GenUFSPathInUnifiedNamespace(mount)
+		paths = append(paths, path.Join(mountPath, strings.TrimPrefix(key, prefix)))
+	}
+	return
+}
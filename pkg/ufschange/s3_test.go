@@ -0,0 +1,226 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ufschange
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+// the notification sent by MinIO to webhook targets when an object is put
+const minioNotification = `{
+  "EventName": "s3:ObjectCreated:Put",
+  "Key": "demo/data/train%2Fa.csv",
+  "Records": [
+    {
+      "eventVersion": "2.0",
+      "eventSource": "minio:s3",
+      "eventName": "s3:ObjectCreated:Put",
+      "s3": {
+        "bucket": {"name": "demo"},
+        "object": {"key": "data%2Ftrain%2Fa.csv", "size": 1024}
+      }
+    }
+  ]
+}`
+
+func TestGetDatasetPaths(t *testing.T) {
+	mounts := []datav1alpha1.Mount{
+		{Name: "train", MountPoint: "s3://demo/data/train"},
+		{Name: "all", MountPoint: "s3a://demo", Path: "/all"},
+		{Name: "other", MountPoint: "s3://other/data"},
+		{Name: "oss", MountPoint: "oss://demo/data"},
+	}
+
+	testcases := []struct {
+		name     string
+		bucket   string
+		key      string
+		expected []string
+	}{
+		{
+			name:     "object under the mount points",
+			bucket:   "demo",
+			key:      "data/train/a.csv",
+			expected: []string{"/train/a.csv", "/all/data/train/a.csv"},
+		},
+		{
+			name:     "object with the same prefix",
+			bucket:   "demo",
+			key:      "data/training/a.csv",
+			expected: []string{"/all/data/training/a.csv"},
+		},
+		{
+			name:     "bucket not mounted",
+			bucket:   "unknown",
+			key:      "data/a.csv",
+			expected: nil,
+		},
+	}
+
+	for _, testcase := range testcases {
+		if got := getDatasetPaths(mounts, testcase.bucket, testcase.key); !reflect.DeepEqual(got, testcase.expected) {
+			t.Errorf("testcase %s: expect %v, got %v", testcase.name, testcase.expected, got)
+		}
+	}
+}
+
+func TestS3WebhookServeHTTP(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(testScheme)
+
+	datasets := []runtime.Object{
+		&datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: "train", Namespace: "default"},
+			Spec: datav1alpha1.DatasetSpec{
+				Mounts: []datav1alpha1.Mount{{Name: "train", MountPoint: "s3://demo/data"}},
+			},
+		},
+		&datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"},
+			Spec: datav1alpha1.DatasetSpec{
+				Mounts: []datav1alpha1.Mount{{Name: "other", MountPoint: "s3://other"}},
+			},
+		},
+	}
+	source := NewS3WebhookSource(":0", "secret", fake.NewFakeClientWithScheme(testScheme, datasets...), fake.NullLogger())
+
+	testcases := []struct {
+		name           string
+		method         string
+		token          string
+		query          string
+		body           string
+		expectedStatus int
+		expectedEvents []Event
+	}{
+		{
+			name:           "object put",
+			method:         http.MethodPost,
+			token:          "secret",
+			query:          "?namespace=default",
+			body:           minioNotification,
+			expectedStatus: http.StatusOK,
+			expectedEvents: []Event{
+				{Dataset: types.NamespacedName{Namespace: "default", Name: "train"}, Paths: []string{"/train/train/a.csv"}},
+			},
+		},
+		{
+			name:           "bearer token",
+			method:         http.MethodPost,
+			token:          "Bearer secret",
+			query:          "?namespace=default&dataset=train",
+			body:           minioNotification,
+			expectedStatus: http.StatusOK,
+			expectedEvents: []Event{
+				{Dataset: types.NamespacedName{Namespace: "default", Name: "train"}, Paths: []string{"/train/train/a.csv"}},
+			},
+		},
+		{
+			name:           "limited to another dataset",
+			method:         http.MethodPost,
+			token:          "secret",
+			query:          "?namespace=default&dataset=other",
+			body:           minioNotification,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "datasets in another namespace",
+			method:         http.MethodPost,
+			token:          "secret",
+			query:          "?namespace=other",
+			body:           minioNotification,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "namespace not set",
+			method:         http.MethodPost,
+			token:          "secret",
+			body:           minioNotification,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "token not set",
+			method:         http.MethodPost,
+			query:          "?namespace=default",
+			body:           minioNotification,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "wrong token",
+			method:         http.MethodPost,
+			token:          "Bearer wrong",
+			query:          "?namespace=default",
+			body:           minioNotification,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "test event without records",
+			method:         http.MethodPost,
+			token:          "secret",
+			query:          "?namespace=default",
+			body:           `{}`,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "malformed notification",
+			method:         http.MethodPost,
+			token:          "secret",
+			query:          "?namespace=default",
+			body:           `{`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "method not allowed",
+			method:         http.MethodGet,
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, testcase := range testcases {
+		var events []Event
+		handler := func(ctx context.Context, event Event) error {
+			events = append(events, event)
+			return nil
+		}
+
+		request := httptest.NewRequest(testcase.method, S3WebhookPath+testcase.query, strings.NewReader(testcase.body))
+		if len(testcase.token) > 0 {
+			request.Header.Set("Authorization", testcase.token)
+		}
+		recorder := httptest.NewRecorder()
+		source.serveHTTP(recorder, request, handler)
+
+		if recorder.Code != testcase.expectedStatus {
+			t.Errorf("testcase %s: expect status %d, got %d", testcase.name, testcase.expectedStatus, recorder.Code)
+		}
+		if !reflect.DeepEqual(events, testcase.expectedEvents) {
+			t.Errorf("testcase %s: expect events %v, got %v", testcase.name, testcase.expectedEvents, events)
+		}
+	}
+}
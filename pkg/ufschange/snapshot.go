@@ -0,0 +1,73 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ufschange
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// Snapshot records the state of the under file system of a dataset, two snapshots taken by polling are compared
+// to find out the changed paths.
+type Snapshot struct {
+	// Checksums are the checksums of the recursive listings of the watched paths
+	Checksums map[string]string `json:"checksums,omitempty"`
+}
+
+// Checksum returns the checksum of the listing of a path.
+func Checksum(listing string) string {
+	sum := sha256.Sum256([]byte(listing))
+	return hex.EncodeToString(sum[:])
+}
+
+// ParseSnapshot parses the snapshot in json format, it returns nil if the data is empty.
+func ParseSnapshot(data string) (*Snapshot, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	snapshot := &Snapshot{}
+	if err := json.Unmarshal([]byte(data), snapshot); err != nil {
+		return nil, fmt.Errorf("failed to parse the ufs snapshot: %v", err)
+	}
+	return snapshot, nil
+}
+
+// String returns the snapshot in json format.
+func (s *Snapshot) String() string {
+	data, _ := json.Marshal(s)
+	return string(data)
+}
+
+// Diff returns the watched paths changed since the previous snapshot. The paths whose listings are checksummed
+// in both snapshots are compared one by one, and the other paths are taken as the baseline of the next diff.
+func (s *Snapshot) Diff(previous *Snapshot, paths []string) (changed []string) {
+	if previous == nil {
+		return nil
+	}
+
+	for _, p := range paths {
+		checksum, found := s.Checksums[p]
+		previousChecksum, previousFound := previous.Checksums[p]
+		if found && previousFound && checksum != previousChecksum {
+			changed = append(changed, p)
+		}
+	}
+	return
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ufschange
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSnapshotDiff(t *testing.T) {
+	paths := []string{"/a", "/b"}
+	testcases := []struct {
+		name     string
+		previous *Snapshot
+		current  *Snapshot
+		expected []string
+	}{
+		{
+			name:     "no previous snapshot",
+			previous: nil,
+			current:  &Snapshot{Checksums: map[string]string{"/a": Checksum("a")}},
+			expected: nil,
+		},
+		{
+			name:     "checksum of a path changed",
+			previous: &Snapshot{Checksums: map[string]string{"/a": Checksum("a"), "/b": Checksum("b")}},
+			current:  &Snapshot{Checksums: map[string]string{"/a": Checksum("a"), "/b": Checksum("b2")}},
+			expected: []string{"/b"},
+		},
+		{
+			name:     "path newly watched",
+			previous: &Snapshot{Checksums: map[string]string{"/a": Checksum("a")}},
+			current:  &Snapshot{Checksums: map[string]string{"/a": Checksum("a"), "/b": Checksum("b")}},
+			expected: nil,
+		},
+	}
+
+	for _, testcase := range testcases {
+		if got := testcase.current.Diff(testcase.previous, paths); !reflect.DeepEqual(got, testcase.expected) {
+			t.Errorf("testcase %s: expect %v, got %v", testcase.name, testcase.expected, got)
+		}
+	}
+}
+
+func TestParseSnapshot(t *testing.T) {
+	snapshot := &Snapshot{Checksums: map[string]string{"/a": Checksum("a")}}
+	parsed, err := ParseSnapshot(snapshot.String())
+	if err != nil || !reflect.DeepEqual(parsed, snapshot) {
+		t.Errorf("expect %v, got %v with error %v", snapshot, parsed, err)
+	}
+
+	if parsed, err = ParseSnapshot(""); parsed != nil || err != nil {
+		t.Errorf("expect nil snapshot for empty data, got %v with error %v", parsed, err)
+	}
+
+	if _, err = ParseSnapshot("{"); err == nil {
+		t.Errorf("expect error for malformed data")
+	}
+}
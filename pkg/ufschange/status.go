@@ -0,0 +1,115 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ufschange
+
+import (
+	"encoding/json"
+	"path"
+	"sort"
+	"strings"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+const (
+	// ChangedPathsInfoKey is the key of the status infos recording the watched paths processed by the current run of
+	// the data operation in json format, it's absent in the first run which processes all the paths.
+	ChangedPathsInfoKey = "changedPaths"
+
+	// PendingChangedPathsInfoKey is the key of the status infos recording the changed watched paths in json format,
+	// which are processed by the next run of the data operation.
+	PendingChangedPathsInfoKey = "pendingChangedPaths"
+
+	// SnapshotInfoKey is the key of the status infos recording the last snapshot of the under file system, which is
+	// compared with the next one to detect the changed paths.
+	SnapshotInfoKey = "ufsSnapshot"
+)
+
+// MatchChangedPaths returns the watched paths affected by the changed paths. A watched path is affected if a changed
+// path is the watched path itself, a file or directory under it, or one of its parent directories.
+func MatchChangedPaths(watchedPaths []string, changedPaths []string) (matched []string) {
+	for _, watchedPath := range watchedPaths {
+		for _, changedPath := range changedPaths {
+			if isSubPath(watchedPath, changedPath) || isSubPath(changedPath, watchedPath) {
+				matched = append(matched, watchedPath)
+				break
+			}
+		}
+	}
+	return
+}
+
+// isSubPath checks if the path p is the parent path itself or under it.
+func isSubPath(parent, p string) bool {
+	parent, p = path.Clean("/"+parent), path.Clean("/"+p)
+	return parent == "/" || p == parent || strings.HasPrefix(p, parent+"/")
+}
+
+// GetPathsInfo returns the paths stored in json format in the status infos under the given key.
+func GetPathsInfo(infos map[string]string, key string) []string {
+	var paths []string
+	if data, found := infos[key]; found && len(data) > 0 {
+		// the infos are only written by fluid, ignore the malformed ones
+		_ = json.Unmarshal([]byte(data), &paths)
+	}
+	return paths
+}
+
+// SetPathsInfo stores the paths in json format in the status infos under the given key, the key is removed if the
+// paths are empty.
+func SetPathsInfo(infos map[string]string, key string, paths []string) {
+	if len(paths) == 0 {
+		delete(infos, key)
+		return
+	}
+
+	data, _ := json.Marshal(paths)
+	infos[key] = string(data)
+}
+
+// AddPendingChangedPaths merges the changed paths into the pending ones of the data operation status, it returns
+// true if any new path is added.
+func AddPendingChangedPaths(status *datav1alpha1.OperationStatus, changedPaths []string) bool {
+	if status.Infos == nil {
+		status.Infos = map[string]string{}
+	}
+
+	pending := GetPathsInfo(status.Infos, PendingChangedPathsInfoKey)
+	added := false
+	for _, changedPath := range changedPaths {
+		if !containsPath(pending, changedPath) {
+			pending = append(pending, changedPath)
+			added = true
+		}
+	}
+	if !added {
+		return false
+	}
+
+	sort.Strings(pending)
+	SetPathsInfo(status.Infos, PendingChangedPathsInfoKey, pending)
+	return true
+}
+
+func containsPath(paths []string, p string) bool {
+	for _, item := range paths {
+		if item == p {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ufschange
+
+import (
+	"reflect"
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+func TestMatchChangedPaths(t *testing.T) {
+	testcases := []struct {
+		name         string
+		watchedPaths []string
+		changedPaths []string
+		expected     []string
+	}{
+		{
+			name:         "file under the watched path",
+			watchedPaths: []string{"/a", "/b"},
+			changedPaths: []string{"/a/data.csv"},
+			expected:     []string{"/a"},
+		},
+		{
+			name:         "parent of the watched path",
+			watchedPaths: []string{"/a/b", "/c"},
+			changedPaths: []string{"/a"},
+			expected:     []string{"/a/b"},
+		},
+		{
+			name:         "path with the same prefix",
+			watchedPaths: []string{"/a"},
+			changedPaths: []string{"/ab/data.csv"},
+			expected:     nil,
+		},
+		{
+			name:         "root path",
+			watchedPaths: []string{"/"},
+			changedPaths: []string{"/ab/data.csv"},
+			expected:     []string{"/"},
+		},
+	}
+
+	for _, testcase := range testcases {
+		if got := MatchChangedPaths(testcase.watchedPaths, testcase.changedPaths); !reflect.DeepEqual(got, testcase.expected) {
+			t.Errorf("testcase %s: expect %v, got %v", testcase.name, testcase.expected, got)
+		}
+	}
+}
+
+func TestAddPendingChangedPaths(t *testing.T) {
+	status := &datav1alpha1.OperationStatus{}
+	if !AddPendingChangedPaths(status, []string{"/b"}) {
+		t.Errorf("expect the path to be added")
+	}
+	if !AddPendingChangedPaths(status, []string{"/a", "/b"}) {
+		t.Errorf("expect the new path to be added")
+	}
+	if AddPendingChangedPaths(status, []string{"/a"}) {
+		t.Errorf("expect the existing path not to be added")
+	}
+
+	if got := GetPathsInfo(status.Infos, PendingChangedPathsInfoKey); !reflect.DeepEqual(got, []string{"/a", "/b"}) {
+		t.Errorf("expect pending changed paths [/a /b], got %v", got)
+	}
+
+	SetPathsInfo(status.Infos, PendingChangedPathsInfoKey, nil)
+	if _, found := status.Infos[PendingChangedPathsInfoKey]; found {
+		t.Errorf("expect the pending changed paths to be removed")
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ufschange
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Event describes the changes of the under file system of a dataset.
+type Event struct {
+	// Dataset is the namespace and name of the changed dataset
+	Dataset types.NamespacedName
+
+	// Paths are the changed paths in the dataset, e.g. /spark/data.csv
+	Paths []string
+}
+
+// Handler handles the events emitted by the sources.
+type Handler func(ctx context.Context, event Event) error
+
+// Source is a pluggable source of the changes of the under file systems, e.g. the event notifications of object
+// storages. It runs until the context is done and passes the events to the handler.
+//
+// Polling snapshots of the under file systems is not a Source because it relies on the engines of the runtimes,
+// it's done by the reconciliation of the OnEvent data operations instead.
+type Source interface {
+	// Name returns the name of the source
+	Name() string
+
+	// Start starts emitting events to the handler until the context is done
+	Start(ctx context.Context, handler Handler) error
+}
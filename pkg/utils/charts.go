@@ -44,3 +44,8 @@ func GetChartsDirectory() string {
 	}
 	return chartFolder
 }
+
+// SetChartsDirectory overrides the directory of the charts, e.g. the charts in the source tree when rendering offline
+func SetChartsDirectory(dir string) {
+	chartFolder = dir
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CronSchedule is a parsed schedule in the standard cron format with five fields:
+// minute, hour, day of month, month and day of week.
+type CronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	// domRestricted and dowRestricted indicate the day of month and the day of week are not "*",
+	// a day matches if either of them matches when both are restricted.
+	domRestricted, dowRestricted bool
+}
+
+type cronBounds struct {
+	min, max uint
+}
+
+var (
+	cronMinuteBounds = cronBounds{0, 59}
+	cronHourBounds   = cronBounds{0, 23}
+	cronDomBounds    = cronBounds{1, 31}
+	cronMonthBounds  = cronBounds{1, 12}
+	cronDowBounds    = cronBounds{0, 6}
+
+	cronDescriptors = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+)
+
+// ParseCronSchedule parses the schedule in the standard cron format, see https://en.wikipedia.org/wiki/Cron.
+// Each field supports "*", single values, ranges like "1-5", lists like "1,3,5" and steps like "*/10".
+func ParseCronSchedule(spec string) (schedule *CronSchedule, err error) {
+	spec = strings.TrimSpace(spec)
+	if descriptor, found := cronDescriptors[spec]; found {
+		spec = descriptor
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields in cron schedule %q, found %d", spec, len(fields))
+	}
+
+	schedule = &CronSchedule{
+		domRestricted: fields[2] != "*",
+		dowRestricted: fields[4] != "*",
+	}
+	for i, field := range []struct {
+		bits   *uint64
+		bounds cronBounds
+	}{
+		{&schedule.minute, cronMinuteBounds},
+		{&schedule.hour, cronHourBounds},
+		{&schedule.dom, cronDomBounds},
+		{&schedule.month, cronMonthBounds},
+		{&schedule.dow, cronDowBounds},
+	} {
+		*field.bits, err = parseCronField(fields[i], field.bounds)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron schedule %q: %v", spec, err)
+		}
+	}
+	return schedule, nil
+}
+
+// Next returns the next time matching the schedule after the given time, or the zero time if there is
+// no matching time in the following five years.
+func (s *CronSchedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	deadline := t.AddDate(5, 0, 0)
+
+	for t.Before(deadline) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (s *CronSchedule) matchDay(t time.Time) bool {
+	domMatched := s.dom&(1<<uint(t.Day())) != 0
+	dowMatched := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domRestricted && s.dowRestricted {
+		return domMatched || dowMatched
+	}
+	return domMatched && dowMatched
+}
+
+// parseCronField parses a field of the cron schedule into a bitset of the matched values
+func parseCronField(field string, bounds cronBounds) (bits uint64, err error) {
+	for _, expr := range strings.Split(field, ",") {
+		rangeExpr, step := expr, uint(1)
+		if i := strings.Index(expr, "/"); i >= 0 {
+			rangeExpr = expr[:i]
+			parsed, err := strconv.ParseUint(expr[i+1:], 10, 0)
+			if err != nil || parsed == 0 {
+				return 0, fmt.Errorf("invalid step in %q", expr)
+			}
+			step = uint(parsed)
+		}
+
+		start, end := bounds.min, bounds.max
+		switch {
+		case rangeExpr == "*":
+		case strings.Contains(rangeExpr, "-"):
+			parts := strings.SplitN(rangeExpr, "-", 2)
+			if start, err = parseCronValue(parts[0], bounds); err != nil {
+				return 0, err
+			}
+			if end, err = parseCronValue(parts[1], bounds); err != nil {
+				return 0, err
+			}
+			if start > end {
+				return 0, fmt.Errorf("invalid range %q", rangeExpr)
+			}
+		default:
+			if start, err = parseCronValue(rangeExpr, bounds); err != nil {
+				return 0, err
+			}
+			// a single value with step like "5/10" means from the value to the max
+			if step == 1 {
+				end = start
+			}
+		}
+
+		for value := start; value <= end; value += step {
+			bits |= 1 << value
+		}
+	}
+	return bits, nil
+}
+
+func parseCronValue(value string, bounds cronBounds) (uint, error) {
+	parsed, err := strconv.ParseUint(value, 10, 0)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+	// 7 is also Sunday in the day of week
+	if bounds == cronDowBounds && parsed == 7 {
+		parsed = 0
+	}
+	if uint(parsed) < bounds.min || uint(parsed) > bounds.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", parsed, bounds.min, bounds.max)
+	}
+	return uint(parsed), nil
+}
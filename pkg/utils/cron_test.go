@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCronScheduleNext(t *testing.T) {
+	from := time.Date(2026, time.January, 30, 10, 17, 30, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		schedule string
+		want     time.Time
+	}{
+		{
+			name:     "every_minute",
+			schedule: "* * * * *",
+			want:     time.Date(2026, time.January, 30, 10, 18, 0, 0, time.UTC),
+		},
+		{
+			name:     "every_ten_minutes",
+			schedule: "*/10 * * * *",
+			want:     time.Date(2026, time.January, 30, 10, 20, 0, 0, time.UTC),
+		},
+		{
+			name:     "hourly",
+			schedule: "@hourly",
+			want:     time.Date(2026, time.January, 30, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "daily_at_two",
+			schedule: "0 2 * * *",
+			want:     time.Date(2026, time.January, 31, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "first_day_of_month",
+			schedule: "30 1 1 * *",
+			want:     time.Date(2026, time.February, 1, 1, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "weekdays_list",
+			schedule: "0 9 * * 1,3",
+			want:     time.Date(2026, time.February, 2, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "day_of_month_or_day_of_week",
+			schedule: "0 0 15 * 6",
+			want:     time.Date(2026, time.January, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "range_with_step",
+			schedule: "0 12-18/3 * * *",
+			want:     time.Date(2026, time.January, 30, 12, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseCronSchedule(tt.schedule)
+			if err != nil {
+				t.Fatalf("ParseCronSchedule() error = %v", err)
+			}
+			if got := schedule.Next(from); !got.Equal(tt.want) {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCronScheduleInvalid(t *testing.T) {
+	for _, schedule := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "*/0 * * * *", "5-1 * * * *", "a * * * *"} {
+		if _, err := ParseCronSchedule(schedule); err == nil {
+			t.Errorf("ParseCronSchedule(%q) expects an error", schedule)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetDataFree gets the DataFree given its name and namespace
+func GetDataFree(client client.Client, name, namespace string) (*datav1alpha1.DataFree, error) {
+	key := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	var dataFree datav1alpha1.DataFree
+	if err := client.Get(context.TODO(), key, &dataFree); err != nil {
+		return nil, err
+	}
+	return &dataFree, nil
+}
+
+// GetDataFreeReleaseName returns DataFree helm release's name given the DataFree's name
+func GetDataFreeReleaseName(name string) string {
+	return fmt.Sprintf("%s-free", name)
+}
+
+// GetDataFreeJobName returns DataFree job's name given the DataFree helm release's name
+func GetDataFreeJobName(releaseName string) string {
+	return fmt.Sprintf("%s-job", releaseName)
+}
@@ -47,3 +47,9 @@ func GetDataLoadReleaseName(name string) string {
 func GetDataLoadJobName(releaseName string) string {
 	return fmt.Sprintf("%s-job", releaseName)
 }
+
+// GetDataLoadProgressConfigMapName returns the name of the ConfigMap where DataLoad job reports its progress
+// given the DataLoad helm release's name
+func GetDataLoadProgressConfigMapName(releaseName string) string {
+	return fmt.Sprintf("%s-progress", releaseName)
+}
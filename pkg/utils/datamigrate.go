@@ -52,6 +52,12 @@ func GetDataMigrateJobName(releaseName string) string {
 	return fmt.Sprintf("%s-migrate", releaseName)
 }
 
+// GetDataMigrateStateConfigMapName returns the name of the ConfigMap where the state of the incremental DataMigrate
+// is kept given the DataMigrate helm release's name
+func GetDataMigrateStateConfigMapName(releaseName string) string {
+	return fmt.Sprintf("%s-state", releaseName)
+}
+
 func GetTargetDatasetNamespacedNameOfMigrate(client client.Client, dataMigrate *datav1alpha1.DataMigrate) (namespacedName types.NamespacedName, err error) {
 	if (dataMigrate.Spec.To.DataSet == nil || dataMigrate.Spec.To.DataSet.Name == "") && (dataMigrate.Spec.From.DataSet == nil || dataMigrate.Spec.From.DataSet.Name == "") {
 		err = fmt.Errorf("invalid spec: either %v or %v must be set", field.NewPath("spec").Child("to").Child("dataset"), field.NewPath("spec").Child("from").Child("dataset"))
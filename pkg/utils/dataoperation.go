@@ -60,6 +60,8 @@ func GetOperationStatus(obj client.Object) (*datav1alpha1.OperationStatus, error
 		return dataBackup.Status.DeepCopy(), nil
 	} else if dataProcess, ok := obj.(*datav1alpha1.DataProcess); ok {
 		return dataProcess.Status.DeepCopy(), nil
+	} else if dataFree, ok := obj.(*datav1alpha1.DataFree); ok {
+		return dataFree.Status.DeepCopy(), nil
 	}
 
 	return nil, fmt.Errorf("obj is not of any data operation type")
@@ -95,6 +97,12 @@ func GetPrecedingOperationStatus(client client.Client, opRef *datav1alpha1.Objec
 			return nil, err
 		}
 		return &object.Status, nil
+	case string(dataoperation.DataFreeType):
+		object, err := GetDataFree(client, opRef.Name, opRefNamespace)
+		if err != nil {
+			return nil, err
+		}
+		return &object.Status, nil
 	default:
 		// TODO: Support non-builtin Kind
 		return nil, fmt.Errorf("kind %v is currently not supported for runAfter", opRef.Kind)
@@ -104,6 +104,10 @@ func getDesiredNodesWithScheduleInfo(runtimeInfo base.RuntimeInfoInterface, clie
 
 	var nodeNames []string
 	for _, pod := range workerPods {
+		// the draining workers are about to be removed, stop scheduling new pods to their nodes
+		if _, draining := pod.Annotations[common.AnnotationWorkerDraining]; draining {
+			continue
+		}
 		if pod.Spec.NodeName != "" {
 			nodeNames = append(nodeNames, pod.Spec.NodeName)
 		}
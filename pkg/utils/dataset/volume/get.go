@@ -69,3 +69,18 @@ func GetPVCByVolumeId(client client.Reader, volumeId string) (*corev1.Persistent
 
 	return pvc, nil
 }
+
+// GetFusePVCOfDataset returns the fuse PVC of the dataset, which is named after the dataset and created when the
+// runtime is ready.
+func GetFusePVCOfDataset(client client.Reader, name, namespace string) (*corev1.PersistentVolumeClaim, error) {
+	pvc, err := kubeclient.GetPersistentVolumeClaim(client, name, namespace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the fuse pvc of dataset %s/%s", namespace, name)
+	}
+
+	if !kubeclient.CheckIfPVCIsDataset(pvc) {
+		return nil, errors.Errorf("pvc %s/%s is not the fuse pvc of a dataset", namespace, name)
+	}
+
+	return pvc, nil
+}
@@ -77,6 +77,40 @@ var _ = Describe("Get helpers related tests", Label("pkg.utils.dataset.volume.ge
 		})
 	})
 
+	Context("Test GetFusePVCOfDataset()", func() {
+		When("the dataset has a fluid pvc", func() {
+			BeforeEach(func() {
+				resources = append(resources,
+					&v1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "ns", Labels: map[string]string{common.LabelAnnotationStorageCapacityPrefix + "ns-name": ""}}},
+				)
+			})
+			It("should return the pvc", func() {
+				got, err := GetFusePVCOfDataset(clientObj, "name", "ns")
+				Expect(err).To(BeNil())
+				Expect(got.Name).To(Equal("name"))
+			})
+		})
+
+		When("the pvc is not a fluid pvc", func() {
+			BeforeEach(func() {
+				resources = append(resources,
+					&v1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "ns"}},
+				)
+			})
+			It("should return error", func() {
+				_, err := GetFusePVCOfDataset(clientObj, "name", "ns")
+				Expect(err).ToNot(BeNil())
+			})
+		})
+
+		When("the pvc does not exist", func() {
+			It("should return error", func() {
+				_, err := GetFusePVCOfDataset(clientObj, "name", "ns")
+				Expect(err).ToNot(BeNil())
+			})
+		})
+	})
+
 	Context("Test GetNamespacedNameByVolumeId()", func() {
 		When("pv has claimRef and pvc is managed by fluid", func() {
 			BeforeEach(func() {
@@ -0,0 +1,254 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	chartFileName  = "Chart.yaml"
+	valuesFileName = "values.yaml"
+	templatesDir   = "templates"
+	subChartsDir   = "charts"
+
+	libraryChartType = "library"
+)
+
+// ChartMetadata is the metadata of a chart defined in Chart.yaml, it's exposed to templates as .Chart
+type ChartMetadata struct {
+	Name         string             `json:"name,omitempty"`
+	Home         string             `json:"home,omitempty"`
+	Version      string             `json:"version,omitempty"`
+	Description  string             `json:"description,omitempty"`
+	APIVersion   string             `json:"apiVersion,omitempty"`
+	AppVersion   string             `json:"appVersion,omitempty"`
+	Type         string             `json:"type,omitempty"`
+	Dependencies []*ChartDependency `json:"dependencies,omitempty"`
+}
+
+// ChartDependency is a dependency declared in Chart.yaml
+type ChartDependency struct {
+	Name       string `json:"name"`
+	Version    string `json:"version,omitempty"`
+	Repository string `json:"repository,omitempty"`
+	Condition  string `json:"condition,omitempty"`
+}
+
+// chartFile is a file of the chart, the name is relative to the chart root
+type chartFile struct {
+	Name string `json:"name"`
+	Data []byte `json:"data"`
+}
+
+// chart is a chart loaded from a directory, including its sub charts under the charts/ directory
+type chart struct {
+	Metadata     *ChartMetadata
+	Values       map[string]interface{}
+	Templates    []*chartFile
+	Dependencies []*chart
+}
+
+func (c *chart) Name() string {
+	return c.Metadata.Name
+}
+
+// loadChart loads the chart from the chart directory. Packaged charts are not supported.
+func loadChart(chartPath string) (*chart, error) {
+	info, err := os.Stat(chartPath)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("chart %s is not a directory, packaged charts are not supported", chartPath)
+	}
+
+	c := &chart{Metadata: &ChartMetadata{}, Values: map[string]interface{}{}}
+	data, err := os.ReadFile(filepath.Join(chartPath, chartFileName))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s of chart %s", chartFileName, chartPath)
+	}
+	if err = yaml.Unmarshal(data, c.Metadata); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s of chart %s", chartFileName, chartPath)
+	}
+	if len(c.Metadata.Name) == 0 {
+		return nil, fmt.Errorf("chart name is missing in %s of chart %s", chartFileName, chartPath)
+	}
+
+	data, err = os.ReadFile(filepath.Join(chartPath, valuesFileName))
+	if err == nil {
+		if c.Values, err = parseValues(data); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %s of chart %s", valuesFileName, chartPath)
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	templatesPath := filepath.Join(chartPath, templatesDir)
+	err = filepath.WalkDir(templatesPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && path == templatesPath {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(chartPath, path)
+		if err != nil {
+			return err
+		}
+		c.Templates = append(c.Templates, &chartFile{Name: filepath.ToSlash(relPath), Data: data})
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load templates of chart %s", chartPath)
+	}
+
+	entries, err := os.ReadDir(filepath.Join(chartPath, subChartsDir))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	for _, entry := range entries {
+		subChartPath := filepath.Join(chartPath, subChartsDir, entry.Name())
+		// follow the symlinks of the sub charts
+		if info, err := os.Stat(subChartPath); err != nil || !info.IsDir() {
+			continue
+		}
+		subChart, err := loadChart(subChartPath)
+		if err != nil {
+			return nil, err
+		}
+		c.Dependencies = append(c.Dependencies, subChart)
+	}
+
+	return c, nil
+}
+
+// readValuesFile reads the values from the yaml file
+func readValuesFile(valueFile string) (map[string]interface{}, error) {
+	data, err := os.ReadFile(valueFile)
+	if err != nil {
+		return nil, err
+	}
+	values, err := parseValues(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse values file %s", valueFile)
+	}
+	return values, nil
+}
+
+func parseValues(data []byte) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	if values == nil {
+		values = map[string]interface{}{}
+	}
+	return values, nil
+}
+
+// coalesceValues merges the values with the default values of the chart and its sub charts,
+// the given values take precedence over the default ones and a null value removes the default one.
+func coalesceValues(c *chart, values map[string]interface{}) map[string]interface{} {
+	result := runtime.DeepCopyJSON(values)
+	coalesceTables(result, c.Values)
+
+	for _, subChart := range c.Dependencies {
+		if !dependencyEnabled(c, subChart, result) {
+			continue
+		}
+		subValues, _ := result[subChart.Name()].(map[string]interface{})
+		if subValues == nil {
+			subValues = map[string]interface{}{}
+		}
+		// the global values of the parent chart override the ones of the sub chart
+		if globals, ok := result["global"].(map[string]interface{}); ok {
+			subGlobals, _ := subValues["global"].(map[string]interface{})
+			if subGlobals == nil {
+				subGlobals = map[string]interface{}{}
+			}
+			subValues["global"] = coalesceTables(runtime.DeepCopyJSON(globals), subGlobals)
+		}
+		result[subChart.Name()] = coalesceValues(subChart, subValues)
+	}
+
+	return result
+}
+
+// coalesceTables merges src into dst, the values in dst take precedence
+func coalesceTables(dst, src map[string]interface{}) map[string]interface{} {
+	for key, srcValue := range src {
+		dstValue, found := dst[key]
+		if !found {
+			dst[key] = runtime.DeepCopyJSONValue(srcValue)
+			continue
+		}
+		if dstValue == nil {
+			delete(dst, key)
+			continue
+		}
+		dstTable, dstIsTable := dstValue.(map[string]interface{})
+		srcTable, srcIsTable := srcValue.(map[string]interface{})
+		if dstIsTable && srcIsTable {
+			coalesceTables(dstTable, srcTable)
+		}
+	}
+	return dst
+}
+
+// dependencyEnabled checks the condition of the sub chart declared in Chart.yaml of the parent chart
+func dependencyEnabled(c *chart, subChart *chart, values map[string]interface{}) bool {
+	for _, dependency := range c.Metadata.Dependencies {
+		if dependency.Name != subChart.Name() || len(dependency.Condition) == 0 {
+			continue
+		}
+		for _, condition := range strings.Split(dependency.Condition, ",") {
+			if enabled, ok := lookupValue(values, strings.TrimSpace(condition)).(bool); ok {
+				return enabled
+			}
+		}
+	}
+	return true
+}
+
+// lookupValue looks up the value by the dot separated path, e.g. "worker.enabled"
+func lookupValue(values map[string]interface{}, path string) interface{} {
+	var current interface{} = values
+	for _, key := range strings.Split(path, ".") {
+		table, ok := current.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		current = table[key]
+	}
+	return current
+}
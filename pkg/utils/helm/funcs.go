@@ -0,0 +1,555 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/Masterminds/semver/v3"
+	"sigs.k8s.io/yaml"
+)
+
+// maxIncludeRecursion is the max times a template can be included recursively
+const maxIncludeRecursion = 1000
+
+// funcMap returns the functions available in the chart templates, which are the helm builtin functions
+// and the subset of sprig functions used by the charts of fluid.
+func funcMap(t *template.Template) template.FuncMap {
+	includedNames := map[string]int{}
+
+	return template.FuncMap{
+		// helm builtin functions
+		"include": func(name string, data interface{}) (string, error) {
+			if includedNames[name] > maxIncludeRecursion {
+				return "", fmt.Errorf("rendering template has a nested reference name: %s", name)
+			}
+			includedNames[name]++
+			defer func() { includedNames[name]-- }()
+
+			var buf strings.Builder
+			err := t.ExecuteTemplate(&buf, name, data)
+			return buf.String(), err
+		},
+		"tpl": func(text string, data interface{}) (string, error) {
+			tpl, err := t.New(fmt.Sprintf("tpl-%d", len(t.Templates()))).Parse(text)
+			if err != nil {
+				return "", err
+			}
+			var buf strings.Builder
+			if err = tpl.Execute(&buf, data); err != nil {
+				return "", err
+			}
+			return strings.ReplaceAll(buf.String(), "<no value>", ""), nil
+		},
+		"required": func(warn string, value interface{}) (interface{}, error) {
+			if value == nil {
+				return value, fmt.Errorf("%s", warn)
+			}
+			if s, ok := value.(string); ok && len(s) == 0 {
+				return value, fmt.Errorf("%s", warn)
+			}
+			return value, nil
+		},
+		"fail": func(msg string) (string, error) {
+			return "", fmt.Errorf("%s", msg)
+		},
+		"toYaml":   toYaml,
+		"fromYaml": fromYaml,
+		"toJson":   toJSON,
+		"fromJson": fromJSON,
+		// lookup always returns an empty object as "helm template" does
+		"lookup": func(apiVersion, kind, namespace, name string) (map[string]interface{}, error) {
+			return map[string]interface{}{}, nil
+		},
+
+		// string functions
+		"quote":      quote,
+		"squote":     squote,
+		"indent":     indent,
+		"nindent":    func(spaces int, v string) string { return "\n" + indent(spaces, v) },
+		"trim":       strings.TrimSpace,
+		"trimAll":    func(cutset, s string) string { return strings.Trim(s, cutset) },
+		"trimSuffix": func(suffix, s string) string { return strings.TrimSuffix(s, suffix) },
+		"trimPrefix": func(prefix, s string) string { return strings.TrimPrefix(s, prefix) },
+		"lower":      strings.ToLower,
+		"upper":      strings.ToUpper,
+		"trunc":      trunc,
+		"replace":    func(old, new, s string) string { return strings.ReplaceAll(s, old, new) },
+		"repeat":     func(count int, s string) string { return strings.Repeat(s, count) },
+		"contains":   func(substr, s string) bool { return strings.Contains(s, substr) },
+		"hasPrefix":  func(prefix, s string) bool { return strings.HasPrefix(s, prefix) },
+		"hasSuffix":  func(suffix, s string) bool { return strings.HasSuffix(s, suffix) },
+		"nospace":    nospace,
+		"cat":        cat,
+		"join":       func(sep string, v interface{}) string { return strings.Join(toStrings(v), sep) },
+		"splitList":  func(sep, s string) []string { return strings.Split(s, sep) },
+		"split":      split,
+		"toString":   strval,
+		"toStrings":  toStrings,
+		"dir":        path.Dir,
+		"base":       path.Base,
+		"b64enc":     func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) },
+		"b64dec":     b64dec,
+		"sha256sum": func(s string) string {
+			sum := sha256.Sum256([]byte(s))
+			return hex.EncodeToString(sum[:])
+		},
+
+		// regular expressions
+		"regexMatch": func(regex, s string) (bool, error) { return regexp.MatchString(regex, s) },
+		"regexFind": func(regex, s string) (string, error) {
+			r, err := regexp.Compile(regex)
+			if err != nil {
+				return "", err
+			}
+			return r.FindString(s), nil
+		},
+		"regexSplit": func(regex, s string, n int) ([]string, error) {
+			r, err := regexp.Compile(regex)
+			if err != nil {
+				return nil, err
+			}
+			return r.Split(s, n), nil
+		},
+
+		// flow control and defaults
+		"default":  defaultValue,
+		"empty":    empty,
+		"coalesce": coalesce,
+		"ternary": func(trueValue, falseValue interface{}, condition bool) interface{} {
+			if condition {
+				return trueValue
+			}
+			return falseValue
+		},
+
+		// type conversion and math
+		"int":     func(v interface{}) int { return int(toInt64(v)) },
+		"int64":   toInt64,
+		"float64": toFloat64,
+		"atoi": func(s string) int {
+			i, _ := strconv.Atoi(s)
+			return i
+		},
+		"add": func(values ...interface{}) int64 {
+			var sum int64
+			for _, v := range values {
+				sum += toInt64(v)
+			}
+			return sum
+		},
+		"add1": func(v interface{}) int64 { return toInt64(v) + 1 },
+		"sub":  func(a, b interface{}) int64 { return toInt64(a) - toInt64(b) },
+		"mul": func(a interface{}, values ...interface{}) int64 {
+			product := toInt64(a)
+			for _, v := range values {
+				product *= toInt64(v)
+			}
+			return product
+		},
+		"div": func(a, b interface{}) int64 { return toInt64(a) / toInt64(b) },
+		"mod": func(a, b interface{}) int64 { return toInt64(a) % toInt64(b) },
+		"max": func(a interface{}, values ...interface{}) int64 {
+			result := toInt64(a)
+			for _, v := range values {
+				if i := toInt64(v); i > result {
+					result = i
+				}
+			}
+			return result
+		},
+		"min": func(a interface{}, values ...interface{}) int64 {
+			result := toInt64(a)
+			for _, v := range values {
+				if i := toInt64(v); i < result {
+					result = i
+				}
+			}
+			return result
+		},
+		"until": until,
+
+		// lists and dicts
+		"list":   func(v ...interface{}) []interface{} { return v },
+		"append": func(list interface{}, v interface{}) []interface{} { return append(toList(list), v) },
+		"concat": func(lists ...interface{}) []interface{} {
+			var result []interface{}
+			for _, list := range lists {
+				result = append(result, toList(list)...)
+			}
+			return result
+		},
+		"first": func(list interface{}) interface{} {
+			l := toList(list)
+			if len(l) == 0 {
+				return nil
+			}
+			return l[0]
+		},
+		"last": func(list interface{}) interface{} {
+			l := toList(list)
+			if len(l) == 0 {
+				return nil
+			}
+			return l[len(l)-1]
+		},
+		"has": func(needle interface{}, haystack interface{}) bool {
+			for _, item := range toList(haystack) {
+				if reflect.DeepEqual(item, needle) {
+					return true
+				}
+			}
+			return false
+		},
+		"uniq": func(list interface{}) []interface{} {
+			var result []interface{}
+			for _, item := range toList(list) {
+				duplicated := false
+				for _, existing := range result {
+					if reflect.DeepEqual(existing, item) {
+						duplicated = true
+						break
+					}
+				}
+				if !duplicated {
+					result = append(result, item)
+				}
+			}
+			return result
+		},
+		"sortAlpha": func(list interface{}) []string {
+			result := toStrings(list)
+			sort.Strings(result)
+			return result
+		},
+		"dict": dict,
+		"get": func(d map[string]interface{}, key string) interface{} {
+			if value, ok := d[key]; ok {
+				return value
+			}
+			return ""
+		},
+		"set": func(d map[string]interface{}, key string, value interface{}) map[string]interface{} {
+			d[key] = value
+			return d
+		},
+		"unset": func(d map[string]interface{}, key string) map[string]interface{} {
+			delete(d, key)
+			return d
+		},
+		"hasKey": func(d map[string]interface{}, key string) bool {
+			_, ok := d[key]
+			return ok
+		},
+		"keys": func(dicts ...map[string]interface{}) []string {
+			var keys []string
+			for _, d := range dicts {
+				for key := range d {
+					keys = append(keys, key)
+				}
+			}
+			return keys
+		},
+
+		// types and versions
+		"kindIs":        func(kind string, v interface{}) bool { return kindOf(v) == kind },
+		"kindOf":        kindOf,
+		"typeOf":        func(v interface{}) string { return fmt.Sprintf("%T", v) },
+		"semverCompare": semverCompare,
+	}
+}
+
+func toYaml(v interface{}) string {
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		// swallow the error as helm does
+		return ""
+	}
+	return strings.TrimSuffix(string(data), "\n")
+}
+
+func fromYaml(s string) map[string]interface{} {
+	m := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(s), &m); err != nil {
+		m["Error"] = err.Error()
+	}
+	return m
+}
+
+func toJSON(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+func fromJSON(s string) map[string]interface{} {
+	m := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(s), &m); err != nil {
+		m["Error"] = err.Error()
+	}
+	return m
+}
+
+func quote(values ...interface{}) string {
+	var quoted []string
+	for _, v := range values {
+		if v != nil {
+			quoted = append(quoted, fmt.Sprintf("%q", strval(v)))
+		}
+	}
+	return strings.Join(quoted, " ")
+}
+
+func squote(values ...interface{}) string {
+	var quoted []string
+	for _, v := range values {
+		if v != nil {
+			quoted = append(quoted, fmt.Sprintf("'%v'", v))
+		}
+	}
+	return strings.Join(quoted, " ")
+}
+
+func indent(spaces int, v string) string {
+	pad := strings.Repeat(" ", spaces)
+	return pad + strings.ReplaceAll(v, "\n", "\n"+pad)
+}
+
+func trunc(c int, s string) string {
+	if c < 0 && len(s)+c > 0 {
+		return s[len(s)+c:]
+	}
+	if c >= 0 && len(s) > c {
+		return s[:c]
+	}
+	return s
+}
+
+func nospace(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+func cat(values ...interface{}) string {
+	var items []string
+	for _, v := range values {
+		if v != nil {
+			items = append(items, strval(v))
+		}
+	}
+	return strings.Join(items, " ")
+}
+
+func split(sep, s string) map[string]string {
+	result := map[string]string{}
+	for i, item := range strings.Split(s, sep) {
+		result["_"+strconv.Itoa(i)] = item
+	}
+	return result
+}
+
+func b64dec(s string) string {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return err.Error()
+	}
+	return string(data)
+}
+
+func strval(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case error:
+		return v.Error()
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func toList(v interface{}) []interface{} {
+	if v == nil {
+		return nil
+	}
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		list := make([]interface{}, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			list[i] = value.Index(i).Interface()
+		}
+		return list
+	default:
+		return []interface{}{v}
+	}
+}
+
+func toStrings(v interface{}) []string {
+	var result []string
+	for _, item := range toList(v) {
+		if item != nil {
+			result = append(result, strval(item))
+		}
+	}
+	return result
+}
+
+func toInt64(v interface{}) int64 {
+	value := reflect.Indirect(reflect.ValueOf(v))
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(value.Uint())
+	case reflect.Float32, reflect.Float64:
+		return int64(value.Float())
+	case reflect.Bool:
+		if value.Bool() {
+			return 1
+		}
+		return 0
+	case reflect.String:
+		if i, err := strconv.ParseInt(value.String(), 0, 64); err == nil {
+			return i
+		}
+		if f, err := strconv.ParseFloat(value.String(), 64); err == nil {
+			return int64(f)
+		}
+	}
+	return 0
+}
+
+func toFloat64(v interface{}) float64 {
+	value := reflect.Indirect(reflect.ValueOf(v))
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(value.Uint())
+	case reflect.Float32, reflect.Float64:
+		return value.Float()
+	case reflect.String:
+		f, _ := strconv.ParseFloat(value.String(), 64)
+		return f
+	}
+	return 0
+}
+
+func until(count int) []int {
+	step := 1
+	if count < 0 {
+		step = -1
+	}
+	var result []int
+	for i := 0; i != count; i += step {
+		result = append(result, i)
+	}
+	return result
+}
+
+func dict(values ...interface{}) map[string]interface{} {
+	d := map[string]interface{}{}
+	for i := 0; i < len(values); i += 2 {
+		key := strval(values[i])
+		if i+1 >= len(values) {
+			d[key] = ""
+			break
+		}
+		d[key] = values[i+1]
+	}
+	return d
+}
+
+func empty(v interface{}) bool {
+	value := reflect.ValueOf(v)
+	if !value.IsValid() {
+		return true
+	}
+	switch value.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return value.Len() == 0
+	case reflect.Bool:
+		return !value.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return value.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return value.Float() == 0
+	case reflect.Struct:
+		return false
+	default:
+		return value.IsNil()
+	}
+}
+
+func defaultValue(d interface{}, given ...interface{}) interface{} {
+	if len(given) == 0 || empty(given[0]) {
+		return d
+	}
+	return given[0]
+}
+
+func coalesce(values ...interface{}) interface{} {
+	for _, v := range values {
+		if !empty(v) {
+			return v
+		}
+	}
+	return nil
+}
+
+func kindOf(v interface{}) string {
+	if v == nil {
+		return "invalid"
+	}
+	return reflect.ValueOf(v).Kind().String()
+}
+
+func semverCompare(constraint, version string) (bool, error) {
+	c, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return false, err
+	}
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return false, err
+	}
+	return c.Check(v), nil
+}
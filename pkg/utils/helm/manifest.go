@@ -0,0 +1,329 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	annotationHook             = "helm.sh/hook"
+	annotationHookWeight       = "helm.sh/hook-weight"
+	annotationHookDeletePolicy = "helm.sh/hook-delete-policy"
+	annotationResourcePolicy   = "helm.sh/resource-policy"
+
+	hookPreInstall  = "pre-install"
+	hookPostInstall = "post-install"
+	hookPreUpgrade  = "pre-upgrade"
+	hookPostUpgrade = "post-upgrade"
+	hookPreDelete   = "pre-delete"
+	hookPostDelete  = "post-delete"
+
+	hookDeletePolicyBeforeCreation = "before-hook-creation"
+	hookDeletePolicySucceeded      = "hook-succeeded"
+
+	resourcePolicyKeep = "keep"
+
+	sourceCommentPrefix = "# Source: "
+)
+
+var separatorRegex = regexp.MustCompile(`(?:^|\s*\n)---\s*`)
+
+// installOrder is the order in which the kinds of resources are installed, which is the same as helm
+var installOrder = []string{
+	"PriorityClass",
+	"Namespace",
+	"NetworkPolicy",
+	"ResourceQuota",
+	"LimitRange",
+	"PodSecurityPolicy",
+	"PodDisruptionBudget",
+	"ServiceAccount",
+	"Secret",
+	"SecretList",
+	"ConfigMap",
+	"StorageClass",
+	"PersistentVolume",
+	"PersistentVolumeClaim",
+	"CustomResourceDefinition",
+	"ClusterRole",
+	"ClusterRoleList",
+	"ClusterRoleBinding",
+	"ClusterRoleBindingList",
+	"Role",
+	"RoleList",
+	"RoleBinding",
+	"RoleBindingList",
+	"Service",
+	"DaemonSet",
+	"Pod",
+	"ReplicationController",
+	"ReplicaSet",
+	"Deployment",
+	"HorizontalPodAutoscaler",
+	"StatefulSet",
+	"Job",
+	"CronJob",
+	"IngressClass",
+	"Ingress",
+	"APIService",
+}
+
+// manifestHead is the head of a kubernetes manifest
+type manifestHead struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind,omitempty"`
+	Metadata   struct {
+		Name        string            `json:"name"`
+		Namespace   string            `json:"namespace,omitempty"`
+		Annotations map[string]string `json:"annotations,omitempty"`
+	} `json:"metadata,omitempty"`
+}
+
+// manifest is a kubernetes resource rendered from the template
+type manifest struct {
+	// Source is the name of the template which the manifest is rendered from
+	Source  string
+	Content string
+	Head    manifestHead
+}
+
+// key identifies the resource of the manifest regardless of its api version
+func (m manifest) key() string {
+	gv, _ := schema.ParseGroupVersion(m.Head.APIVersion)
+	return fmt.Sprintf("%s/%s/%s/%s", gv.Group, m.Head.Kind, m.Head.Metadata.Namespace, m.Head.Metadata.Name)
+}
+
+func (m manifest) toUnstructured() (*unstructured.Unstructured, error) {
+	obj := &unstructured.Unstructured{}
+	if err := yaml.Unmarshal([]byte(m.Content), &obj.Object); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse manifest %s from %s", m.key(), m.Source)
+	}
+	return obj, nil
+}
+
+func (m manifest) hookEvents() []string {
+	var events []string
+	for _, event := range strings.Split(m.Head.Metadata.Annotations[annotationHook], ",") {
+		if event = strings.TrimSpace(event); len(event) > 0 {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func (m manifest) hasHookEvent(event string) bool {
+	for _, e := range m.hookEvents() {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+func (m manifest) hookWeight() int {
+	weight, _ := strconv.Atoi(m.Head.Metadata.Annotations[annotationHookWeight])
+	return weight
+}
+
+func (m manifest) hasHookDeletePolicy(policy string) bool {
+	for _, p := range strings.Split(m.Head.Metadata.Annotations[annotationHookDeletePolicy], ",") {
+		if strings.TrimSpace(p) == policy {
+			return true
+		}
+	}
+	return false
+}
+
+func (m manifest) keepOnDelete() bool {
+	return m.Head.Metadata.Annotations[annotationResourcePolicy] == resourcePolicyKeep
+}
+
+// splitManifests splits the rendered templates into manifests and hooks, both of which are sorted in install order
+func splitManifests(rendered map[string]string) (manifests []manifest, hooks []manifest, err error) {
+	names := make([]string, 0, len(rendered))
+	for name := range rendered {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		docs, err := parseDocuments(name, rendered[name])
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, doc := range docs {
+			if len(doc.hookEvents()) > 0 {
+				hooks = append(hooks, doc)
+			} else {
+				manifests = append(manifests, doc)
+			}
+		}
+	}
+
+	sortByInstallOrder(manifests)
+	sortByInstallOrder(hooks)
+	sort.SliceStable(hooks, func(i, j int) bool {
+		return hooks[i].hookWeight() < hooks[j].hookWeight()
+	})
+	return manifests, hooks, nil
+}
+
+// parseDocuments parses the yaml documents of the content, the empty documents are ignored
+func parseDocuments(source, content string) ([]manifest, error) {
+	var manifests []manifest
+	for _, doc := range separatorRegex.Split(content, -1) {
+		doc = strings.TrimSpace(doc)
+		if len(doc) == 0 {
+			continue
+		}
+		m := manifest{Source: source, Content: doc}
+		if err := yaml.Unmarshal([]byte(doc), &m.Head); err != nil {
+			return nil, errors.Wrapf(err, "YAML parse error on %s", source)
+		}
+		// the document only contains comments
+		if len(m.Head.Kind) == 0 && len(m.Head.APIVersion) == 0 {
+			continue
+		}
+		if len(m.Head.Kind) == 0 || len(m.Head.Metadata.Name) == 0 {
+			return nil, fmt.Errorf("manifest in %s is missing kind or name", source)
+		}
+		manifests = append(manifests, m)
+	}
+	return manifests, nil
+}
+
+func sortByInstallOrder(manifests []manifest) {
+	order := make(map[string]int, len(installOrder))
+	for i, kind := range installOrder {
+		order[kind] = i
+	}
+	sort.SliceStable(manifests, func(i, j int) bool {
+		oi, iKnown := order[manifests[i].Head.Kind]
+		oj, jKnown := order[manifests[j].Head.Kind]
+		switch {
+		case iKnown && jKnown:
+			return oi < oj
+		case iKnown != jKnown:
+			// the unknown kinds are installed at last
+			return iKnown
+		default:
+			return manifests[i].Head.Kind < manifests[j].Head.Kind
+		}
+	})
+}
+
+// joinManifests builds the manifest of the release in the same format as helm
+func joinManifests(manifests []manifest) string {
+	var b strings.Builder
+	for _, m := range manifests {
+		fmt.Fprintf(&b, "---\n%s%s\n%s\n", sourceCommentPrefix, m.Source, m.Content)
+	}
+	return b.String()
+}
+
+// parseReleaseManifest parses the manifest of the release built by helm or joinManifests
+func parseReleaseManifest(content string) ([]manifest, error) {
+	var manifests []manifest
+	for _, doc := range separatorRegex.Split(content, -1) {
+		source := ""
+		if strings.HasPrefix(doc, sourceCommentPrefix) {
+			lines := strings.SplitN(doc, "\n", 2)
+			source = strings.TrimPrefix(lines[0], sourceCommentPrefix)
+			doc = ""
+			if len(lines) > 1 {
+				doc = lines[1]
+			}
+		}
+		docs, err := parseDocuments(source, doc)
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, docs...)
+	}
+	return manifests, nil
+}
+
+// diffManifests returns the unified diff between the deployed manifest and the rendered manifest for each resource,
+// an empty string means that nothing changes.
+func diffManifests(deployed, rendered string) (string, error) {
+	deployedManifests, err := parseReleaseManifest(deployed)
+	if err != nil {
+		return "", err
+	}
+	renderedManifests, err := parseReleaseManifest(rendered)
+	if err != nil {
+		return "", err
+	}
+
+	deployedContents := map[string]string{}
+	renderedContents := map[string]string{}
+	keys := []string{}
+	for _, m := range deployedManifests {
+		if len(m.hookEvents()) > 0 {
+			continue
+		}
+		deployedContents[m.key()] = m.Content
+		keys = append(keys, m.key())
+	}
+	for _, m := range renderedManifests {
+		if len(m.hookEvents()) > 0 {
+			continue
+		}
+		if _, found := deployedContents[m.key()]; !found {
+			keys = append(keys, m.key())
+		}
+		renderedContents[m.key()] = m.Content
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, key := range keys {
+		if deployedContents[key] == renderedContents[key] {
+			continue
+		}
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        splitLines(deployedContents[key]),
+			B:        splitLines(renderedContents[key]),
+			FromFile: key + " (deployed)",
+			ToFile:   key + " (rendered)",
+			Context:  3,
+		})
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(diff)
+	}
+	return b.String(), nil
+}
+
+func splitLines(content string) []string {
+	if len(content) == 0 {
+		return nil
+	}
+	return difflib.SplitLines(strings.TrimSuffix(content, "\n"))
+}
@@ -0,0 +1,447 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/discovery"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+const (
+	// EngineDDCHelm manages the releases by shelling out to the ddc-helm binary, which is the default engine
+	EngineDDCHelm = "ddc-helm"
+	// EngineNative renders the charts in process and applies the manifests with server-side apply
+	EngineNative = "native"
+
+	// fieldManager is the field manager of the resources applied by the native engine
+	fieldManager = "fluid-helm"
+	// envHelmDriver is the storage driver of the releases shared with ddc-helm
+	envHelmDriver = "HELM_DRIVER"
+
+	annotationReleaseName      = "meta.helm.sh/release-name"
+	annotationReleaseNamespace = "meta.helm.sh/release-namespace"
+	labelManagedBy             = "app.kubernetes.io/managed-by"
+)
+
+// useNativeEngine checks if the releases are managed by the native engine
+func useNativeEngine() bool {
+	return os.Getenv(common.EnvHelmEngine) == EngineNative
+}
+
+var (
+	nativeEngineOnce     sync.Once
+	nativeEngineInstance *nativeEngine
+	nativeEngineErr      error
+)
+
+// getNativeEngine returns the native engine, which is built lazily from the kube config of the controller
+func getNativeEngine() (*nativeEngine, error) {
+	nativeEngineOnce.Do(func() {
+		cfg, err := ctrl.GetConfig()
+		if err != nil {
+			nativeEngineErr = errors.Wrap(err, "failed to get kube config for native helm engine")
+			return
+		}
+		// the releases are read without cache, because they are stored in configmaps or secrets of all namespaces
+		c, err := client.New(cfg, client.Options{})
+		if err != nil {
+			nativeEngineErr = errors.Wrap(err, "failed to create client for native helm engine")
+			return
+		}
+		discoveryClient, err := discovery.NewDiscoveryClientForConfig(cfg)
+		if err != nil {
+			nativeEngineErr = errors.Wrap(err, "failed to create discovery client for native helm engine")
+			return
+		}
+		caps, err := discoverCapabilities(discoveryClient)
+		if err != nil {
+			nativeEngineErr = err
+			return
+		}
+		nativeEngineInstance = newNativeEngine(c, caps)
+	})
+	return nativeEngineInstance, nativeEngineErr
+}
+
+// nativeEngine renders the charts in process and applies the manifests to the cluster with server-side apply,
+// it stores the releases in the same way as helm, so that ddc-helm is still able to manage them.
+type nativeEngine struct {
+	client       client.Client
+	capabilities *Capabilities
+	storage      *releaseStorage
+}
+
+func newNativeEngine(c client.Client, caps *Capabilities) *nativeEngine {
+	driver := os.Getenv(envHelmDriver)
+	if driver != driverConfigMap {
+		// secret is the default driver of helm
+		driver = driverSecret
+	}
+	return &nativeEngine{
+		client:       c,
+		capabilities: caps,
+		storage:      &releaseStorage{client: c, driver: driver},
+	}
+}
+
+// install installs the chart as a new release
+func (e *nativeEngine) install(name, namespace, valueFile, chartName string) error {
+	releases, err := e.storage.list(name, namespace)
+	if err != nil {
+		return err
+	}
+	if len(releases) > 0 {
+		return fmt.Errorf("cannot re-use a name that is still in use: release %s in namespace %s", name, namespace)
+	}
+
+	options := releaseOptions{Name: name, Namespace: namespace, Revision: 1, IsInstall: true}
+	c, values, manifests, hooks, err := renderManifests(chartName, valueFile, options, e.capabilities)
+	if err != nil {
+		return err
+	}
+
+	rls := newRelease(name, namespace, 1, c, values, manifests, hooks)
+	rls.Info.Status = statusPendingInstall
+	rls.Info.Description = "Initial install underway"
+	if err = e.storage.create(rls); err != nil {
+		return err
+	}
+
+	if err = e.deploy(rls, manifests, hooks, hookPreInstall, hookPostInstall); err != nil {
+		err = e.markFailed(rls, err)
+		if rollbackErr := e.uninstall(name, namespace); rollbackErr != nil {
+			log.Error(rollbackErr, "failed to rollback installed release after install failure", "name", name, "namespace", namespace)
+		}
+		return err
+	}
+
+	rls.Info.Status = statusDeployed
+	rls.Info.Description = "Install complete"
+	return e.storage.update(rls)
+}
+
+// upgrade upgrades the deployed release with the chart, the resources which are no longer rendered are deleted
+func (e *nativeEngine) upgrade(name, namespace, valueFile, chartName string) error {
+	releases, err := e.storage.list(name, namespace)
+	if err != nil {
+		return err
+	}
+	current := lastDeployedRelease(releases)
+	if current == nil {
+		return fmt.Errorf("release %s in namespace %s has no deployed releases", name, namespace)
+	}
+	revision := releases[len(releases)-1].Version + 1
+
+	options := releaseOptions{Name: name, Namespace: namespace, Revision: revision, IsUpgrade: true}
+	c, values, manifests, hooks, err := renderManifests(chartName, valueFile, options, e.capabilities)
+	if err != nil {
+		return err
+	}
+
+	rls := newRelease(name, namespace, revision, c, values, manifests, hooks)
+	rls.Info.FirstDeployed = current.Info.FirstDeployed
+	rls.Info.Status = statusPendingUpgrade
+	rls.Info.Description = "Preparing upgrade"
+	if err = e.storage.create(rls); err != nil {
+		return err
+	}
+
+	if err = e.deploy(rls, manifests, hooks, hookPreUpgrade, hookPostUpgrade); err != nil {
+		return e.markFailed(rls, err)
+	}
+
+	// delete the resources which are removed from the chart
+	currentManifests, err := parseReleaseManifest(current.Manifest)
+	if err != nil {
+		return e.markFailed(rls, err)
+	}
+	rendered := map[string]bool{}
+	for _, m := range manifests {
+		rendered[m.key()] = true
+	}
+	var removed []manifest
+	for _, m := range currentManifests {
+		if !rendered[m.key()] {
+			removed = append(removed, m)
+		}
+	}
+	if err = e.deleteManifests(rls.Namespace, removed); err != nil {
+		return e.markFailed(rls, err)
+	}
+
+	current.Info.Status = statusSuperseded
+	current.Info.Description = "Superseded"
+	if err = e.storage.update(current); err != nil {
+		return err
+	}
+	rls.Info.Status = statusDeployed
+	rls.Info.Description = "Upgrade complete"
+	return e.storage.update(rls)
+}
+
+// diff returns the difference between the deployed release and the chart rendered with the values
+func (e *nativeEngine) diff(name, namespace, valueFile, chartName string) (string, error) {
+	releases, err := e.storage.list(name, namespace)
+	if err != nil {
+		return "", err
+	}
+	current := lastDeployedRelease(releases)
+	if current == nil {
+		return "", fmt.Errorf("release %s in namespace %s has no deployed releases", name, namespace)
+	}
+
+	options := releaseOptions{Name: name, Namespace: namespace, Revision: releases[len(releases)-1].Version + 1, IsUpgrade: true}
+	_, _, manifests, _, err := renderManifests(chartName, valueFile, options, e.capabilities)
+	if err != nil {
+		return "", err
+	}
+	return diffManifests(current.Manifest, joinManifests(manifests))
+}
+
+// check checks if the release is deployed, the release which fails to install is deleted
+func (e *nativeEngine) check(name, namespace string) (bool, error) {
+	releases, err := e.storage.list(name, namespace)
+	if err != nil || len(releases) == 0 {
+		return false, err
+	}
+	for _, rls := range releases {
+		if rls.Info.Status == statusDeployed || rls.Info.Status == statusSuperseded {
+			return true, nil
+		}
+	}
+
+	log.Info("Release is not deployed, delete it", "name", name, "namespace", namespace, "status", releases[len(releases)-1].Info.Status)
+	if err = e.uninstall(name, namespace); err != nil {
+		return false, errors.Wrapf(err, "failed to rollback failed release (namespace: %s, name: %s)", namespace, name)
+	}
+	return false, nil
+}
+
+// uninstall deletes the resources and the revisions of the release
+func (e *nativeEngine) uninstall(name, namespace string) error {
+	releases, err := e.storage.list(name, namespace)
+	if err != nil {
+		return err
+	}
+	if len(releases) == 0 {
+		return fmt.Errorf("release %s in namespace %s not found", name, namespace)
+	}
+	latest := releases[len(releases)-1]
+	latest.Info.Status = statusUninstalling
+	latest.Info.Description = "Deletion in progress"
+	if err = e.storage.update(latest); err != nil {
+		return err
+	}
+
+	hooks := releaseHooks(latest)
+	if err = e.runHooks(latest, hooks, hookPreDelete); err != nil {
+		return err
+	}
+	manifests, err := parseReleaseManifest(latest.Manifest)
+	if err != nil {
+		return err
+	}
+	// delete the resources in the reverse order of installing
+	for i, j := 0, len(manifests)-1; i < j; i, j = i+1, j-1 {
+		manifests[i], manifests[j] = manifests[j], manifests[i]
+	}
+	if err = e.deleteManifests(namespace, manifests); err != nil {
+		return err
+	}
+	if err = e.runHooks(latest, hooks, hookPostDelete); err != nil {
+		return err
+	}
+
+	for _, rls := range releases {
+		if err = e.storage.delete(rls); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// list returns the latest revisions of the releases in the namespace
+func (e *nativeEngine) list(namespace string) ([]*release, error) {
+	releases, err := e.storage.list("", namespace)
+	if err != nil {
+		return nil, err
+	}
+	latest := []*release{}
+	for _, rls := range releases {
+		if len(latest) > 0 && latest[len(latest)-1].Name == rls.Name {
+			latest[len(latest)-1] = rls
+			continue
+		}
+		latest = append(latest, rls)
+	}
+	return latest, nil
+}
+
+func (e *nativeEngine) deploy(rls *release, manifests, hooks []manifest, preHook, postHook string) error {
+	if err := e.runHooks(rls, hooks, preHook); err != nil {
+		return err
+	}
+	for _, m := range manifests {
+		if err := e.apply(rls, m); err != nil {
+			return err
+		}
+	}
+	return e.runHooks(rls, hooks, postHook)
+}
+
+func (e *nativeEngine) markFailed(rls *release, cause error) error {
+	rls.Info.Status = statusFailed
+	rls.Info.Description = cause.Error()
+	if err := e.storage.update(rls); err != nil {
+		log.Error(err, "failed to mark release as failed", "name", rls.Name, "namespace", rls.Namespace)
+	}
+	return cause
+}
+
+// runHooks applies the hooks of the event, it doesn't wait for the hook resources to complete
+func (e *nativeEngine) runHooks(rls *release, hooks []manifest, event string) error {
+	for _, hook := range hooks {
+		if !hook.hasHookEvent(event) {
+			continue
+		}
+		if hook.hasHookDeletePolicy(hookDeletePolicyBeforeCreation) {
+			if err := e.deleteManifests(rls.Namespace, []manifest{hook}); err != nil {
+				return err
+			}
+		}
+		if err := e.apply(rls, hook); err != nil {
+			return errors.Wrapf(err, "failed to run %s hook", event)
+		}
+		if hook.hasHookDeletePolicy(hookDeletePolicySucceeded) {
+			if err := e.deleteManifests(rls.Namespace, []manifest{hook}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// apply applies the manifest with server-side apply, the resource is adopted by the release as helm does
+func (e *nativeEngine) apply(rls *release, m manifest) error {
+	obj, err := m.toUnstructured()
+	if err != nil {
+		return err
+	}
+	if err = e.setNamespace(obj, rls.Namespace); err != nil {
+		return err
+	}
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[annotationReleaseName] = rls.Name
+	annotations[annotationReleaseNamespace] = rls.Namespace
+	obj.SetAnnotations(annotations)
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[labelManagedBy] = "Helm"
+	obj.SetLabels(labels)
+
+	err = e.client.Patch(context.TODO(), obj, client.Apply, client.FieldOwner(fieldManager), client.ForceOwnership)
+	if err != nil {
+		return errors.Wrapf(err, "failed to apply %s from %s", m.key(), m.Source)
+	}
+	return nil
+}
+
+func (e *nativeEngine) deleteManifests(namespace string, manifests []manifest) error {
+	for _, m := range manifests {
+		if m.keepOnDelete() {
+			log.Info("Skip deleting resource with keep policy", "resource", m.key())
+			continue
+		}
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(m.Head.APIVersion)
+		obj.SetKind(m.Head.Kind)
+		obj.SetName(m.Head.Metadata.Name)
+		obj.SetNamespace(m.Head.Metadata.Namespace)
+		if err := e.setNamespace(obj, namespace); err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return err
+		}
+		err := e.client.Delete(context.TODO(), obj, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		if err != nil && utils.IgnoreNotFound(err) != nil && !meta.IsNoMatchError(err) {
+			return errors.Wrapf(err, "failed to delete %s", m.key())
+		}
+	}
+	return nil
+}
+
+func (e *nativeEngine) setNamespace(obj *unstructured.Unstructured, namespace string) error {
+	namespaced, err := e.client.IsObjectNamespaced(obj)
+	if err != nil {
+		return err
+	}
+	if !namespaced {
+		obj.SetNamespace("")
+	} else if len(obj.GetNamespace()) == 0 {
+		obj.SetNamespace(namespace)
+	}
+	return nil
+}
+
+// lastDeployedRelease returns the deployed revision of the releases sorted by version
+func lastDeployedRelease(releases []*release) *release {
+	for i := len(releases) - 1; i >= 0; i-- {
+		if releases[i].Info.Status == statusDeployed {
+			return releases[i]
+		}
+	}
+	return nil
+}
+
+func releaseHooks(rls *release) []manifest {
+	var hooks []manifest
+	for _, hook := range rls.Hooks {
+		docs, err := parseDocuments(hook.Path, hook.Manifest)
+		if err != nil {
+			log.Error(err, "failed to parse hook of release", "name", rls.Name, "hook", hook.Name)
+			continue
+		}
+		hooks = append(hooks, docs...)
+	}
+	return hooks
+}
+
+// releaseUpdated formats the time when the release is deployed as "helm list" does
+func releaseUpdated(rls *release) string {
+	return rls.Info.LastDeployed.Format(time.DateTime + ".999999999 -0700 MST")
+}
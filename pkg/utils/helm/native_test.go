@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// newTestNativeEngine returns the native engine with a fake client, which applies the manifests by create or update
+// because the fake client doesn't support server-side apply.
+func newTestNativeEngine(t *testing.T, driver string) *nativeEngine {
+	t.Setenv(envHelmDriver, driver)
+
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{corev1.SchemeGroupVersion, appsv1.SchemeGroupVersion})
+	for _, gvk := range []schema.GroupVersionKind{
+		corev1.SchemeGroupVersion.WithKind("ConfigMap"),
+		corev1.SchemeGroupVersion.WithKind("Secret"),
+		appsv1.SchemeGroupVersion.WithKind("StatefulSet"),
+	} {
+		mapper.Add(gvk, meta.RESTScopeNamespace)
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRESTMapper(mapper).WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			if patch.Type() != types.ApplyPatchType {
+				return c.Patch(ctx, obj, patch, opts...)
+			}
+			existing := obj.DeepCopyObject().(client.Object)
+			if err := c.Get(ctx, client.ObjectKeyFromObject(obj), existing); err != nil {
+				if utils.IgnoreNotFound(err) != nil {
+					return err
+				}
+				return c.Create(ctx, obj)
+			}
+			obj.SetResourceVersion(existing.GetResourceVersion())
+			return c.Update(ctx, obj)
+		},
+	}).Build()
+
+	return newNativeEngine(c, defaultCapabilities())
+}
+
+func TestNativeEngine(t *testing.T) {
+	for _, driver := range []string{driverConfigMap, driverSecret} {
+		t.Run(driver, func(t *testing.T) {
+			e := newTestNativeEngine(t, driver)
+			chartPath := writeTestChart(t, nil)
+			name, namespace := "hbase", "default"
+
+			if err := e.install(name, namespace, writeTestValues(t, "image: fluid/demo:v1"), chartPath); err != nil {
+				t.Fatalf("install() error = %v", err)
+			}
+			if err := e.install(name, namespace, "", chartPath); err == nil {
+				t.Errorf("install() is expected to fail for the release in use")
+			}
+			if exist, err := e.check(name, namespace); err != nil || !exist {
+				t.Errorf("check() = %v, %v, want the release exists", exist, err)
+			}
+
+			sts := &appsv1.StatefulSet{}
+			if err := e.client.Get(context.TODO(), types.NamespacedName{Name: "hbase-demo-worker", Namespace: namespace}, sts); err != nil {
+				t.Fatalf("failed to get statefulset: %v", err)
+			}
+			if sts.Annotations[annotationReleaseName] != name || sts.Spec.Template.Spec.Containers[0].Image != "fluid/demo:v1" {
+				t.Errorf("statefulset = %v, want adopted by the release with image fluid/demo:v1", sts)
+			}
+			hook := &corev1.ConfigMap{}
+			if err := e.client.Get(context.TODO(), types.NamespacedName{Name: "hbase-demo-hook", Namespace: namespace}, hook); err != nil {
+				t.Errorf("failed to get the configmap created by the pre-install hook: %v", err)
+			}
+
+			// disable the worker and upgrade the release
+			valueFile := writeTestValues(t, "worker:\n  enabled: false")
+			diff, err := e.diff(name, namespace, valueFile, chartPath)
+			if err != nil || !strings.Contains(diff, "-kind: StatefulSet") {
+				t.Errorf("diff() = %s, %v, want the statefulset removed", diff, err)
+			}
+			if err = e.upgrade(name, namespace, valueFile, chartPath); err != nil {
+				t.Fatalf("upgrade() error = %v", err)
+			}
+			err = e.client.Get(context.TODO(), types.NamespacedName{Name: "hbase-demo-worker", Namespace: namespace}, sts)
+			if utils.IgnoreNotFound(err) != nil || err == nil {
+				t.Errorf("statefulset is expected to be deleted after upgrade, got error %v", err)
+			}
+			releases, err := e.storage.list(name, namespace)
+			if err != nil || len(releases) != 2 {
+				t.Fatalf("releases = %v, %v, want 2 revisions", releases, err)
+			}
+			if releases[0].Info.Status != statusSuperseded || releases[1].Info.Status != statusDeployed {
+				t.Errorf("release status = %s and %s, want superseded and deployed", releases[0].Info.Status, releases[1].Info.Status)
+			}
+			if diff, err = e.diff(name, namespace, valueFile, chartPath); err != nil || len(diff) != 0 {
+				t.Errorf("diff() = %s, %v, want no difference after upgrade", diff, err)
+			}
+			if list, err := e.list(namespace); err != nil || len(list) != 1 || list[0].Version != 2 {
+				t.Errorf("list() = %v, %v, want the revision 2 of the release", list, err)
+			}
+
+			if err = e.uninstall(name, namespace); err != nil {
+				t.Fatalf("uninstall() error = %v", err)
+			}
+			if exist, err := e.check(name, namespace); err != nil || exist {
+				t.Errorf("check() = %v, %v, want the release deleted", exist, err)
+			}
+			configMap := &corev1.ConfigMap{}
+			err = e.client.Get(context.TODO(), types.NamespacedName{Name: "hbase-demo-config", Namespace: namespace}, configMap)
+			if utils.IgnoreNotFound(err) != nil || err == nil {
+				t.Errorf("configmap is expected to be deleted after uninstall, got error %v", err)
+			}
+		})
+	}
+}
+
+func TestNativeEngineInstallFailure(t *testing.T) {
+	e := newTestNativeEngine(t, driverConfigMap)
+	// the kind is not registered in the rest mapper
+	chartPath := writeTestChart(t, map[string]string{"templates/service.yaml": `apiVersion: v1
+kind: Service
+metadata:
+  name: demo
+`})
+
+	if err := e.install("hbase", "default", "", chartPath); err == nil {
+		t.Fatalf("install() is expected to fail")
+	}
+	releases, err := e.storage.list("hbase", "default")
+	if err != nil || len(releases) != 0 {
+		t.Errorf("releases = %v, %v, want the failed release rolled back", releases, err)
+	}
+}
+
+func TestCheckReleaseWithNativeEngine(t *testing.T) {
+	t.Setenv(common.EnvHelmEngine, EngineNative)
+	e := newTestNativeEngine(t, driverSecret)
+	patches := gomonkey.ApplyFunc(getNativeEngine, func() (*nativeEngine, error) {
+		return e, nil
+	})
+	defer patches.Reset()
+
+	rls := &release{Name: "hbase", Namespace: "default", Version: 1, Info: &releaseInfo{Status: statusFailed}}
+	if err := e.storage.create(rls); err != nil {
+		t.Fatal(err)
+	}
+
+	exist, err := CheckRelease("hbase", "default")
+	if err != nil || exist {
+		t.Errorf("CheckRelease() = %v, %v, want the failed release not exist", exist, err)
+	}
+	if releases, _ := e.storage.list("hbase", "default"); len(releases) != 0 {
+		t.Errorf("releases = %v, want the failed release deleted", releases)
+	}
+}
+
+func TestReleaseEncoding(t *testing.T) {
+	rls := &release{
+		Name:      "hbase",
+		Namespace: "default",
+		Version:   3,
+		Info:      &releaseInfo{Status: statusDeployed},
+		Manifest:  "---\n# Source: demo/templates/configmap.yaml\napiVersion: v1\nkind: ConfigMap\n",
+	}
+	data, err := encodeRelease(rls)
+	if err != nil {
+		t.Fatalf("encodeRelease() error = %v", err)
+	}
+	got, err := decodeRelease(data)
+	if err != nil {
+		t.Fatalf("decodeRelease() error = %v", err)
+	}
+	if got.Name != rls.Name || got.Version != rls.Version || got.Info.Status != rls.Info.Status || got.Manifest != rls.Manifest {
+		t.Errorf("decodeRelease() = %v, want %v", got, rls)
+	}
+	if !got.Info.Deleted.IsZero() {
+		t.Errorf("decodeRelease() deleted = %v, want zero time", got.Info.Deleted)
+	}
+}
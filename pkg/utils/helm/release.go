@@ -0,0 +1,330 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// The releases are stored in the same format as the storage drivers of helm v3,
+// so that the releases are compatible with ddc-helm in both directions.
+const (
+	driverConfigMap = "configmap"
+	driverSecret    = "secret"
+
+	releaseSecretType = "helm.sh/release.v1"
+	releaseDataKey    = "release"
+
+	statusDeployed        = "deployed"
+	statusSuperseded      = "superseded"
+	statusFailed          = "failed"
+	statusPendingInstall  = "pending-install"
+	statusPendingUpgrade  = "pending-upgrade"
+	statusUninstalling    = "uninstalling"
+	releaseOwner          = "helm"
+	releaseObjectPrefix   = "sh.helm.release.v1"
+	releaseLabelName      = "name"
+	releaseLabelOwner     = "owner"
+	releaseLabelStatus    = "status"
+	releaseLabelVersion   = "version"
+	releaseLabelCreatedAt = "createdAt"
+	releaseLabelModified  = "modifiedAt"
+)
+
+var gzipMagic = []byte{0x1f, 0x8b, 0x08}
+
+// releaseTime is a time which is marshaled into an empty string when it's zero, as helm does
+type releaseTime struct {
+	time.Time
+}
+
+func (t releaseTime) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte(`""`), nil
+	}
+	return t.Time.MarshalJSON()
+}
+
+func (t *releaseTime) UnmarshalJSON(data []byte) error {
+	if string(data) == `""` || string(data) == "null" {
+		t.Time = time.Time{}
+		return nil
+	}
+	return t.Time.UnmarshalJSON(data)
+}
+
+type releaseInfo struct {
+	FirstDeployed releaseTime `json:"first_deployed,omitempty"`
+	LastDeployed  releaseTime `json:"last_deployed,omitempty"`
+	Deleted       releaseTime `json:"deleted"`
+	Description   string      `json:"description,omitempty"`
+	Status        string      `json:"status,omitempty"`
+}
+
+type releaseChart struct {
+	Metadata  *ChartMetadata         `json:"metadata"`
+	Templates []*chartFile           `json:"templates"`
+	Values    map[string]interface{} `json:"values"`
+}
+
+type releaseHook struct {
+	Name           string   `json:"name,omitempty"`
+	Kind           string   `json:"kind,omitempty"`
+	Path           string   `json:"path,omitempty"`
+	Manifest       string   `json:"manifest,omitempty"`
+	Events         []string `json:"events,omitempty"`
+	Weight         int      `json:"weight,omitempty"`
+	DeletePolicies []string `json:"delete_policies,omitempty"`
+}
+
+// release is a revision of the installed chart
+type release struct {
+	Name      string                 `json:"name,omitempty"`
+	Info      *releaseInfo           `json:"info,omitempty"`
+	Chart     *releaseChart          `json:"chart,omitempty"`
+	Config    map[string]interface{} `json:"config,omitempty"`
+	Manifest  string                 `json:"manifest,omitempty"`
+	Hooks     []*releaseHook         `json:"hooks,omitempty"`
+	Version   int                    `json:"version,omitempty"`
+	Namespace string                 `json:"namespace,omitempty"`
+}
+
+func newRelease(name, namespace string, version int, c *chart, values map[string]interface{}, manifests, hooks []manifest) *release {
+	now := releaseTime{Time: time.Now()}
+	rls := &release{
+		Name:      name,
+		Namespace: namespace,
+		Version:   version,
+		Info:      &releaseInfo{FirstDeployed: now, LastDeployed: now},
+		Chart:     &releaseChart{Metadata: c.Metadata, Templates: c.Templates, Values: c.Values},
+		Config:    values,
+		Manifest:  joinManifests(manifests),
+	}
+	for _, hook := range hooks {
+		h := &releaseHook{
+			Name:     hook.Head.Metadata.Name,
+			Kind:     hook.Head.Kind,
+			Path:     hook.Source,
+			Manifest: hook.Content,
+			Events:   hook.hookEvents(),
+			Weight:   hook.hookWeight(),
+		}
+		for _, policy := range []string{hookDeletePolicyBeforeCreation, hookDeletePolicySucceeded} {
+			if hook.hasHookDeletePolicy(policy) {
+				h.DeletePolicies = append(h.DeletePolicies, policy)
+			}
+		}
+		rls.Hooks = append(rls.Hooks, h)
+	}
+	return rls
+}
+
+func (r *release) objectName() string {
+	return fmt.Sprintf("%s.%s.v%d", releaseObjectPrefix, r.Name, r.Version)
+}
+
+func encodeRelease(rls *release) (string, error) {
+	data, err := json.Marshal(rls)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	w, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return "", err
+	}
+	if _, err = w.Write(data); err != nil {
+		return "", err
+	}
+	if err = w.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+func decodeRelease(data string) (*release, error) {
+	b, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) > 3 && bytes.Equal(b[0:3], gzipMagic) {
+		r, err := gzip.NewReader(bytes.NewReader(b))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		if b, err = io.ReadAll(r); err != nil {
+			return nil, err
+		}
+	}
+	rls := &release{}
+	if err = json.Unmarshal(b, rls); err != nil {
+		return nil, err
+	}
+	return rls, nil
+}
+
+// releaseStorage stores the releases in configmaps or secrets according to the driver
+type releaseStorage struct {
+	client client.Client
+	driver string
+}
+
+// list returns the revisions of the release sorted by version, or all the releases in the namespace if name is empty
+func (s *releaseStorage) list(name, namespace string) ([]*release, error) {
+	selector := client.MatchingLabels{releaseLabelOwner: releaseOwner}
+	if len(name) > 0 {
+		selector[releaseLabelName] = name
+	}
+
+	var encoded []string
+	if s.driver == driverSecret {
+		secrets := &corev1.SecretList{}
+		if err := s.client.List(context.TODO(), secrets, client.InNamespace(namespace), selector); err != nil {
+			return nil, errors.Wrapf(err, "failed to list releases in namespace %s", namespace)
+		}
+		for _, secret := range secrets.Items {
+			encoded = append(encoded, string(secret.Data[releaseDataKey]))
+		}
+	} else {
+		configMaps := &corev1.ConfigMapList{}
+		if err := s.client.List(context.TODO(), configMaps, client.InNamespace(namespace), selector); err != nil {
+			return nil, errors.Wrapf(err, "failed to list releases in namespace %s", namespace)
+		}
+		for _, configMap := range configMaps.Items {
+			encoded = append(encoded, configMap.Data[releaseDataKey])
+		}
+	}
+
+	releases := make([]*release, 0, len(encoded))
+	for _, data := range encoded {
+		rls, err := decodeRelease(data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode release in namespace %s", namespace)
+		}
+		releases = append(releases, rls)
+	}
+	sort.Slice(releases, func(i, j int) bool {
+		if releases[i].Name != releases[j].Name {
+			return releases[i].Name < releases[j].Name
+		}
+		return releases[i].Version < releases[j].Version
+	})
+	return releases, nil
+}
+
+func (s *releaseStorage) create(rls *release) error {
+	return s.save(rls, true)
+}
+
+func (s *releaseStorage) update(rls *release) error {
+	return s.save(rls, false)
+}
+
+func (s *releaseStorage) save(rls *release, create bool) error {
+	data, err := encodeRelease(rls)
+	if err != nil {
+		return errors.Wrapf(err, "failed to encode release %s", rls.objectName())
+	}
+
+	objectMeta := metav1.ObjectMeta{
+		Name:      rls.objectName(),
+		Namespace: rls.Namespace,
+		Labels: map[string]string{
+			releaseLabelName:    rls.Name,
+			releaseLabelOwner:   releaseOwner,
+			releaseLabelStatus:  rls.Info.Status,
+			releaseLabelVersion: strconv.Itoa(rls.Version),
+		},
+	}
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	if create {
+		objectMeta.Labels[releaseLabelCreatedAt] = now
+	} else {
+		objectMeta.Labels[releaseLabelModified] = now
+	}
+
+	var obj client.Object
+	if s.driver == driverSecret {
+		obj = &corev1.Secret{
+			ObjectMeta: objectMeta,
+			Type:       releaseSecretType,
+			Data:       map[string][]byte{releaseDataKey: []byte(data)},
+		}
+	} else {
+		obj = &corev1.ConfigMap{
+			ObjectMeta: objectMeta,
+			Data:       map[string]string{releaseDataKey: data},
+		}
+	}
+
+	if create {
+		err = s.client.Create(context.TODO(), obj)
+	} else {
+		err = s.mergeLabelsAndUpdate(obj)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to save release %s in namespace %s", rls.objectName(), rls.Namespace)
+	}
+	return nil
+}
+
+// mergeLabelsAndUpdate keeps the createdAt label and the resource version of the stored object when updating it
+func (s *releaseStorage) mergeLabelsAndUpdate(obj client.Object) error {
+	var existing client.Object = &corev1.ConfigMap{}
+	if s.driver == driverSecret {
+		existing = &corev1.Secret{}
+	}
+	if err := s.client.Get(context.TODO(), client.ObjectKeyFromObject(obj), existing); err != nil {
+		return err
+	}
+	labels := obj.GetLabels()
+	if createdAt, found := existing.GetLabels()[releaseLabelCreatedAt]; found {
+		labels[releaseLabelCreatedAt] = createdAt
+	}
+	obj.SetLabels(labels)
+	obj.SetResourceVersion(existing.GetResourceVersion())
+	return s.client.Update(context.TODO(), obj)
+}
+
+func (s *releaseStorage) delete(rls *release) error {
+	objectMeta := metav1.ObjectMeta{Name: rls.objectName(), Namespace: rls.Namespace}
+	var obj client.Object = &corev1.ConfigMap{ObjectMeta: objectMeta}
+	if s.driver == driverSecret {
+		obj = &corev1.Secret{ObjectMeta: objectMeta}
+	}
+	if err := s.client.Delete(context.TODO(), obj); utils.IgnoreNotFound(err) != nil {
+		return errors.Wrapf(err, "failed to delete release %s in namespace %s", rls.objectName(), rls.Namespace)
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+// defaultKubeVersion is the kubernetes version used when rendering charts without a cluster
+const defaultKubeVersion = "v1.29.0"
+
+// Capabilities describes the kubernetes cluster which the charts are rendered for, it's exposed to templates as .Capabilities
+type Capabilities struct {
+	KubeVersion KubeVersion
+	APIVersions VersionSet
+}
+
+// KubeVersion is the version of the kubernetes cluster
+type KubeVersion struct {
+	Version string
+	Major   string
+	Minor   string
+}
+
+func (kv *KubeVersion) String() string {
+	return kv.Version
+}
+
+// GitVersion returns the kubernetes version, which is kept for the compatibility of the charts
+func (kv *KubeVersion) GitVersion() string {
+	return kv.Version
+}
+
+// VersionSet is a set of api versions in the format of "group/version" or "group/version/kind"
+type VersionSet []string
+
+// Has checks if the api version or the api version with kind is supported
+func (v VersionSet) Has(apiVersion string) bool {
+	for _, version := range v {
+		if version == apiVersion {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultCapabilities returns the capabilities of the builtin kubernetes api, which are used when
+// rendering charts without a cluster.
+func defaultCapabilities() *Capabilities {
+	versions := sets.New[string]()
+	for gvk := range scheme.Scheme.AllKnownTypes() {
+		versions.Insert(gvk.GroupVersion().String(), gvk.GroupVersion().String()+"/"+gvk.Kind)
+	}
+	return &Capabilities{
+		KubeVersion: KubeVersion{Version: defaultKubeVersion, Major: "1", Minor: "29"},
+		APIVersions: sets.List(versions),
+	}
+}
+
+// discoverCapabilities returns the capabilities of the kubernetes cluster
+func discoverCapabilities(client discovery.DiscoveryInterface) (*Capabilities, error) {
+	serverVersion, err := client.ServerVersion()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get kubernetes version")
+	}
+
+	_, resourceLists, err := client.ServerGroupsAndResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, errors.Wrap(err, "failed to get api versions of kubernetes")
+	}
+	versions := sets.New[string]()
+	for _, resourceList := range resourceLists {
+		versions.Insert(resourceList.GroupVersion)
+		for _, resource := range resourceList.APIResources {
+			versions.Insert(resourceList.GroupVersion + "/" + resource.Kind)
+		}
+	}
+
+	return &Capabilities{
+		KubeVersion: KubeVersion{Version: serverVersion.GitVersion, Major: serverVersion.Major, Minor: serverVersion.Minor},
+		APIVersions: sets.List(versions),
+	}, nil
+}
+
+// releaseOptions describes the release exposed to templates as .Release
+type releaseOptions struct {
+	Name      string
+	Namespace string
+	Revision  int
+	IsInstall bool
+	IsUpgrade bool
+}
+
+func (o releaseOptions) toValues() map[string]interface{} {
+	return map[string]interface{}{
+		"Name":      o.Name,
+		"Namespace": o.Namespace,
+		"Revision":  o.Revision,
+		"IsInstall": o.IsInstall,
+		"IsUpgrade": o.IsUpgrade,
+		"Service":   "Helm",
+	}
+}
+
+// renderable is a template to render with the values of the chart it belongs to
+type renderable struct {
+	name     string
+	data     string
+	values   map[string]interface{}
+	basePath string
+	library  bool
+}
+
+// renderChart renders the templates of the chart and its sub charts with the coalesced values.
+// It returns the rendered content keyed by the template name, e.g. "alluxio/templates/master/statefulset.yaml".
+func renderChart(c *chart, values map[string]interface{}, options releaseOptions, caps *Capabilities) (map[string]string, error) {
+	var templates []renderable
+	collectTemplates(c, c.Name(), values, options, caps, &templates)
+
+	// parse the templates of the sub charts first, so that the parent chart can override their definitions
+	sort.SliceStable(templates, func(i, j int) bool {
+		di, dj := strings.Count(templates[i].name, "/"), strings.Count(templates[j].name, "/")
+		if di != dj {
+			return di > dj
+		}
+		return templates[i].name < templates[j].name
+	})
+
+	t := template.New("gotpl").Option("missingkey=zero")
+	t.Funcs(funcMap(t))
+	for _, tpl := range templates {
+		if _, err := t.New(tpl.name).Parse(tpl.data); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse template %s", tpl.name)
+		}
+	}
+
+	rendered := map[string]string{}
+	for _, tpl := range templates {
+		base := path.Base(tpl.name)
+		if tpl.library || strings.HasPrefix(base, "_") || base == "NOTES.txt" {
+			continue
+		}
+		vals := map[string]interface{}{}
+		for key, value := range tpl.values {
+			vals[key] = value
+		}
+		vals["Template"] = map[string]interface{}{"Name": tpl.name, "BasePath": tpl.basePath}
+
+		var buf strings.Builder
+		if err := t.ExecuteTemplate(&buf, tpl.name, vals); err != nil {
+			return nil, errors.Wrapf(err, "failed to render template %s", tpl.name)
+		}
+		rendered[tpl.name] = strings.ReplaceAll(buf.String(), "<no value>", "")
+	}
+
+	return rendered, nil
+}
+
+func collectTemplates(c *chart, prefix string, values map[string]interface{}, options releaseOptions, caps *Capabilities, templates *[]renderable) {
+	topValues := map[string]interface{}{
+		"Values":       values,
+		"Release":      options.toValues(),
+		"Chart":        c.Metadata,
+		"Capabilities": caps,
+	}
+	for _, file := range c.Templates {
+		*templates = append(*templates, renderable{
+			name:     path.Join(prefix, file.Name),
+			data:     string(file.Data),
+			values:   topValues,
+			basePath: path.Join(prefix, templatesDir),
+			library:  c.Metadata.Type == libraryChartType,
+		})
+	}
+
+	for _, subChart := range c.Dependencies {
+		if !dependencyEnabled(c, subChart, values) {
+			continue
+		}
+		subValues, _ := values[subChart.Name()].(map[string]interface{})
+		if subValues == nil {
+			subValues = map[string]interface{}{}
+		}
+		collectTemplates(subChart, path.Join(prefix, subChartsDir, subChart.Name()), subValues, options, caps, templates)
+	}
+}
+
+// renderManifests loads the chart and the values file, and renders the chart into sorted manifests and hooks
+func renderManifests(chartName, valueFile string, options releaseOptions, caps *Capabilities) (c *chart, values map[string]interface{}, manifests []manifest, hooks []manifest, err error) {
+	c, err = loadChart(chartName)
+	if err != nil {
+		return
+	}
+
+	values = map[string]interface{}{}
+	if len(valueFile) > 0 {
+		if values, err = readValuesFile(valueFile); err != nil {
+			return
+		}
+	}
+
+	rendered, err := renderChart(c, coalesceValues(c, values), options, caps)
+	if err != nil {
+		return
+	}
+
+	manifests, hooks, err = splitManifests(rendered)
+	if err != nil {
+		err = fmt.Errorf("failed to parse manifests of chart %s: %v", chartName, err)
+	}
+	return
+}
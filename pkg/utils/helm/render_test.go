@@ -0,0 +1,298 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// writeTestChart writes a chart with a library sub chart into a temp directory
+func writeTestChart(t *testing.T, files map[string]string) string {
+	chartPath := filepath.Join(t.TempDir(), "demo")
+	defaults := map[string]string{
+		"Chart.yaml": `apiVersion: v2
+name: demo
+version: 0.1.0
+appVersion: 1.0.0
+dependencies:
+- name: library
+  version: 0.1.0
+`,
+		"values.yaml": `image: demo
+replicas: 1
+worker:
+  enabled: true
+  resources:
+    limits:
+      cpu: 1
+`,
+		"templates/_helpers.tpl": `{{- define "demo.fullname" -}}
+{{ .Release.Name }}-{{ .Chart.Name }}
+{{- end -}}
+`,
+		"templates/NOTES.txt": `Thanks for installing {{ .Release.Name }}`,
+		"templates/worker/statefulset.yaml": `{{- if .Values.worker.enabled }}
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: {{ include "demo.fullname" . }}-worker
+  labels:
+    {{- include "library.labels" . | nindent 4 }}
+spec:
+  replicas: {{ .Values.replicas }}
+  template:
+    spec:
+      containers:
+      - name: worker
+        image: {{ .Values.image | quote }}
+        resources:
+          {{- toYaml .Values.worker.resources | nindent 10 }}
+        args: {{ .Values.missing }}
+{{- end }}
+`,
+		"templates/config/configmap.yaml": `# the config of the worker
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ include "demo.fullname" . }}-config
+data:
+  kube: {{ ternary "new" "old" (.Capabilities.APIVersions.Has "batch/v1/CronJob") }}
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ include "demo.fullname" . }}-hook
+  annotations:
+    "helm.sh/hook": pre-install
+    "helm.sh/hook-delete-policy": before-hook-creation
+data:
+  install: {{ .Release.IsInstall | quote }}
+`,
+		"charts/library/Chart.yaml": `apiVersion: v2
+name: library
+version: 0.1.0
+type: library
+`,
+		"charts/library/templates/_labels.tpl": `{{- define "library.labels" -}}
+app: {{ .Chart.Name }}
+release: {{ .Release.Name }}
+{{- end -}}
+`,
+	}
+	for name, content := range files {
+		defaults[name] = content
+	}
+	for name, content := range defaults {
+		path := filepath.Join(chartPath, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return chartPath
+}
+
+func writeTestValues(t *testing.T, content string) string {
+	valueFile := filepath.Join(t.TempDir(), "values.yaml")
+	if err := os.WriteFile(valueFile, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return valueFile
+}
+
+func TestRenderRelease(t *testing.T) {
+	chartPath := writeTestChart(t, nil)
+	valueFile := writeTestValues(t, `image: fluid/demo:v1
+worker:
+  resources:
+    limits:
+      cpu: null
+      memory: 1Gi
+`)
+
+	got, err := RenderRelease("hbase", "default", valueFile, chartPath)
+	if err != nil {
+		t.Fatalf("RenderRelease() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"# Source: demo/templates/config/configmap.yaml",
+		"name: hbase-demo-config",
+		"kube: new",
+		"name: hbase-demo-hook",
+		`install: "true"`,
+		"name: hbase-demo-worker",
+		"app: demo\n    release: hbase",
+		`image: "fluid/demo:v1"`,
+		"limits:\n            memory: 1Gi",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("RenderRelease() = %s, want to contain %q", got, want)
+		}
+	}
+	for _, unwanted := range []string{"<no value>", "cpu:", "Thanks for installing"} {
+		if strings.Contains(got, unwanted) {
+			t.Errorf("RenderRelease() = %s, want not to contain %q", got, unwanted)
+		}
+	}
+
+	// hooks are followed by the manifests sorted in install order
+	hookIndex := strings.Index(got, "name: hbase-demo-hook")
+	configIndex := strings.Index(got, "name: hbase-demo-config")
+	workerIndex := strings.Index(got, "name: hbase-demo-worker")
+	if !(hookIndex < configIndex && configIndex < workerIndex) {
+		t.Errorf("RenderRelease() = %s, want hook, configmap and statefulset in order", got)
+	}
+}
+
+func TestRenderReleaseWithErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+	}{
+		{
+			name:  "required_value",
+			files: map[string]string{"templates/secret.yaml": `{{ required "secret name should be set" .Values.secretName }}`},
+		},
+		{
+			name:  "parse_error",
+			files: map[string]string{"templates/secret.yaml": `{{ if .Values.secretName }}`},
+		},
+		{
+			name: "invalid_manifest",
+			files: map[string]string{"templates/secret.yaml": `apiVersion: v1
+kind: Secret
+`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chartPath := writeTestChart(t, tt.files)
+			if _, err := RenderRelease("hbase", "default", "", chartPath); err == nil {
+				t.Errorf("RenderRelease() is expected to fail")
+			}
+		})
+	}
+
+	if _, err := RenderRelease("hbase", "default", "", filepath.Join(t.TempDir(), "not-exist")); err == nil {
+		t.Errorf("RenderRelease() is expected to fail for the chart not found")
+	}
+}
+
+func TestCoalesceValues(t *testing.T) {
+	c := &chart{
+		Metadata: &ChartMetadata{Name: "demo", Dependencies: []*ChartDependency{{Name: "fuse", Condition: "fuse.enabled"}}},
+		Values: map[string]interface{}{
+			"image":  "demo",
+			"global": map[string]interface{}{"registry": "docker.io"},
+			"worker": map[string]interface{}{"replicas": float64(1), "port": float64(8080)},
+		},
+		Dependencies: []*chart{
+			{Metadata: &ChartMetadata{Name: "fuse"}, Values: map[string]interface{}{"enabled": true, "image": "fuse"}},
+		},
+	}
+
+	got := coalesceValues(c, map[string]interface{}{
+		"worker": map[string]interface{}{"replicas": float64(3), "port": nil},
+		"fuse":   map[string]interface{}{"image": "custom-fuse"},
+	})
+	want := map[string]interface{}{
+		"image":  "demo",
+		"global": map[string]interface{}{"registry": "docker.io"},
+		"worker": map[string]interface{}{"replicas": float64(3)},
+		"fuse": map[string]interface{}{
+			"enabled": true,
+			"image":   "custom-fuse",
+			"global":  map[string]interface{}{"registry": "docker.io"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("coalesceValues() = %v, want %v", got, want)
+	}
+
+	got = coalesceValues(c, map[string]interface{}{"fuse": map[string]interface{}{"enabled": false}})
+	if fuse := got["fuse"].(map[string]interface{}); len(fuse) != 1 {
+		t.Errorf("coalesceValues() fuse = %v, want the disabled sub chart not coalesced", fuse)
+	}
+}
+
+func TestDiffManifests(t *testing.T) {
+	configMap := manifest{Source: "demo/templates/configmap.yaml", Content: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: demo\ndata:\n  a: b"}
+	changedConfigMap := manifest{Source: configMap.Source, Content: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: demo\ndata:\n  a: c"}
+	service := manifest{Source: "demo/templates/service.yaml", Content: "apiVersion: v1\nkind: Service\nmetadata:\n  name: demo"}
+	for _, m := range []*manifest{&configMap, &changedConfigMap, &service} {
+		docs, err := parseDocuments(m.Source, m.Content)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m.Head = docs[0].Head
+	}
+
+	diff, err := diffManifests(joinManifests([]manifest{configMap, service}), joinManifests([]manifest{service, configMap}))
+	if err != nil || len(diff) != 0 {
+		t.Errorf("diffManifests() = %q, %v, want no difference", diff, err)
+	}
+
+	diff, err = diffManifests(joinManifests([]manifest{configMap, service}), joinManifests([]manifest{changedConfigMap}))
+	if err != nil {
+		t.Fatalf("diffManifests() error = %v", err)
+	}
+	for _, want := range []string{"-  a: b", "+  a: c", "--- /Service//demo (deployed)", "-kind: Service"} {
+		if !strings.Contains(diff, want) {
+			t.Errorf("diffManifests() = %s, want to contain %q", diff, want)
+		}
+	}
+}
+
+// TestRenderFluidCharts renders the charts shipped with fluid, so that a template function missing in funcMap fails
+// the test instead of the installation of the charts at runtime.
+func TestRenderFluidCharts(t *testing.T) {
+	runtimeCharts, err := filepath.Glob("../../../charts/*/Chart.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	operationCharts, err := filepath.Glob("../../../charts/fluid-*/*/Chart.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runtimeCharts) == 0 || len(operationCharts) == 0 {
+		t.Fatalf("no chart found, runtime charts: %v, data operation charts: %v", runtimeCharts, operationCharts)
+	}
+
+	// the runtime charts are rendered with their default values
+	for _, chartFile := range runtimeCharts {
+		chartPath := filepath.Dir(chartFile)
+		if _, err := RenderRelease("demo", "default", "", chartPath); err != nil {
+			t.Errorf("RenderRelease(%s) error = %v", chartPath, err)
+		}
+	}
+
+	// the data operation charts require the values generated by the controllers, e.g. the target dataset,
+	// so only all of their templates are expected to be parsed
+	for _, chartFile := range operationCharts {
+		chartPath := filepath.Dir(chartFile)
+		if _, err := RenderRelease("demo", "default", "", chartPath); err != nil && strings.Contains(err.Error(), "failed to parse template") {
+			t.Errorf("RenderRelease(%s) error = %v", chartPath, err)
+		}
+	}
+}
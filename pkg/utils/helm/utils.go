@@ -43,6 +43,18 @@ var helmCmd = []string{"ddc-helm"}
 // InstallRelease installs the release with cmd: helm install -f values.yaml chart_name, support helm v3
 func InstallRelease(name string, namespace string, valueFile string, chartName string) error {
 	defer utils.TimeTrack(time.Now(), "Helm.InstallRelease", "name", name, "namespace", namespace)
+	if useNativeEngine() {
+		engine, err := getNativeEngine()
+		if err != nil {
+			return err
+		}
+		if err = engine.install(name, namespace, valueFile, chartName); err != nil {
+			log.Error(err, "failed to install release with native engine", "name", name, "namespace", namespace)
+			return fmt.Errorf("failed to install kubernetes resources of %s: %v", chartName, err)
+		}
+		return nil
+	}
+
 	binary, err := exec.LookPath(helmCmd[0])
 	if err != nil {
 		return err
@@ -92,6 +104,14 @@ func InstallRelease(name string, namespace string, valueFile string, chartName s
 // CheckRelease checks if the release with the given name and namespace exist.
 func CheckRelease(name, namespace string) (exist bool, err error) {
 	defer utils.TimeTrack(time.Now(), "Helm.CheckRelease", "name", name, "namespace", namespace)
+	if useNativeEngine() {
+		engine, err := getNativeEngine()
+		if err != nil {
+			return exist, err
+		}
+		return engine.check(name, namespace)
+	}
+
 	_, err = exec.LookPath(helmCmd[0])
 	if err != nil {
 		return exist, err
@@ -134,7 +154,8 @@ func CheckRelease(name, namespace string) (exist bool, err error) {
 			resultLines := strings.Split(string(resultBytes), "\n")
 			for _, line := range resultLines {
 				if strings.HasPrefix(line, "STATUS: ") {
-					if strings.Replace(line, "STATUS: ", "", 1) == "deployed" {
+					// the first revision is superseded once the release is upgraded
+					if status := strings.Replace(line, "STATUS: ", "", 1); status == "deployed" || status == "superseded" {
 						exist = true
 					} else {
 						rollbackErr := DeleteRelease(name, namespace)
@@ -160,6 +181,18 @@ func CheckRelease(name, namespace string) (exist bool, err error) {
 // DeleteRelease deletes release with the name and namespace
 func DeleteRelease(name, namespace string) error {
 	defer utils.TimeTrack(time.Now(), "Helm.DeleteRelease", "name", name, "namespace", namespace)
+	if useNativeEngine() {
+		engine, err := getNativeEngine()
+		if err != nil {
+			return err
+		}
+		if err = engine.uninstall(name, namespace); err != nil {
+			log.Error(err, "failed to delete release with native engine", "name", name, "namespace", namespace)
+			return fmt.Errorf("failed to delete engine-related kubernetes resources")
+		}
+		return nil
+	}
+
 	binary, err := exec.LookPath(helmCmd[0])
 	if err != nil {
 		return err
@@ -185,9 +218,108 @@ func DeleteRelease(name, namespace string) error {
 	return nil
 }
 
+// UpgradeRelease upgrades the release with the chart and the values file, the resources which are no longer
+// rendered by the chart are deleted.
+func UpgradeRelease(name string, namespace string, valueFile string, chartName string) error {
+	defer utils.TimeTrack(time.Now(), "Helm.UpgradeRelease", "name", name, "namespace", namespace)
+	if useNativeEngine() {
+		engine, err := getNativeEngine()
+		if err != nil {
+			return err
+		}
+		if err = engine.upgrade(name, namespace, valueFile, chartName); err != nil {
+			log.Error(err, "failed to upgrade release with native engine", "name", name, "namespace", namespace)
+			return fmt.Errorf("failed to upgrade kubernetes resources of %s: %v", chartName, err)
+		}
+		return nil
+	}
+
+	binary, err := exec.LookPath(helmCmd[0])
+	if err != nil {
+		return err
+	}
+	// keep all the revisions, so that CheckRelease is able to find the first revision
+	args := []string{"upgrade", "-f", valueFile, "--namespace", namespace, "--history-max", "0", name, chartName}
+	cmd, err := cmdguard.Command(binary, args...)
+	if err != nil {
+		return err
+	}
+	log.Info("Exec", "command", cmd.String())
+	out, err := cmd.CombinedOutput()
+	log.Info(string(out))
+	if err != nil {
+		log.Error(err, "failed to execute UpgradeRelease() command", "command", cmd.String())
+		return fmt.Errorf("failed to upgrade kubernetes resources of %s: %s", chartName, string(out))
+	}
+	return nil
+}
+
+// DiffRelease returns the unified diff between the deployed release and the chart rendered with the values file
+// for each changed resource, an empty diff means that upgrading the release changes nothing.
+func DiffRelease(name string, namespace string, valueFile string, chartName string) (diff string, err error) {
+	defer utils.TimeTrack(time.Now(), "Helm.DiffRelease", "name", name, "namespace", namespace)
+	if useNativeEngine() {
+		engine, err := getNativeEngine()
+		if err != nil {
+			return "", err
+		}
+		return engine.diff(name, namespace, valueFile, chartName)
+	}
+
+	binary, err := exec.LookPath(helmCmd[0])
+	if err != nil {
+		return
+	}
+	cmd, err := cmdguard.Command(binary, "get", "manifest", name, "-n", namespace)
+	if err != nil {
+		return
+	}
+	deployed, err := cmd.Output()
+	if err != nil {
+		log.Error(err, "failed to execute DiffRelease() command", "command", cmd.String())
+		return "", errors.Wrapf(err, "failed to get manifest of release %s in namespace %s", name, namespace)
+	}
+	cmd, err = cmdguard.Command(binary, "template", "-f", valueFile, "--namespace", namespace, name, chartName)
+	if err != nil {
+		return
+	}
+	rendered, err := cmd.Output()
+	if err != nil {
+		log.Error(err, "failed to execute DiffRelease() command", "command", cmd.String())
+		return "", errors.Wrapf(err, "failed to render chart %s", chartName)
+	}
+	return diffManifests(string(deployed), string(rendered))
+}
+
+// RenderRelease renders the chart with the values file in process without accessing the cluster,
+// it returns the manifests in the same format as "helm template".
+func RenderRelease(name string, namespace string, valueFile string, chartName string) (string, error) {
+	options := releaseOptions{Name: name, Namespace: namespace, Revision: 1, IsInstall: true}
+	_, _, manifests, hooks, err := renderManifests(chartName, valueFile, options, defaultCapabilities())
+	if err != nil {
+		return "", err
+	}
+	return joinManifests(append(hooks, manifests...)), nil
+}
+
 // ListReleases return an array with all releases' names in a given namespace
 func ListReleases(namespace string) (releases []string, err error) {
 	releases = []string{}
+	if useNativeEngine() {
+		engine, err := getNativeEngine()
+		if err != nil {
+			return releases, err
+		}
+		list, err := engine.list(namespace)
+		if err != nil {
+			return releases, err
+		}
+		for _, rls := range list {
+			releases = append(releases, rls.Name)
+		}
+		return releases, nil
+	}
+
 	_, err = exec.LookPath(helmCmd[0])
 	if err != nil {
 		return releases, err
@@ -211,6 +343,23 @@ func ListReleases(namespace string) (releases []string, err error) {
 // ListReleaseMap returns a map with all releases' names and app versions in a given namespace.
 func ListReleaseMap(namespace string) (releaseMap map[string]string, err error) {
 	releaseMap = map[string]string{}
+	if useNativeEngine() {
+		engine, err := getNativeEngine()
+		if err != nil {
+			return releaseMap, err
+		}
+		list, err := engine.list(namespace)
+		if err != nil {
+			return releaseMap, err
+		}
+		for _, rls := range list {
+			if rls.Info.Status == statusDeployed && rls.Chart != nil && rls.Chart.Metadata != nil {
+				releaseMap[rls.Name] = rls.Chart.Metadata.AppVersion
+			}
+		}
+		return releaseMap, nil
+	}
+
 	_, err = exec.LookPath(helmCmd[0])
 	if err != nil {
 		return releaseMap, err
@@ -248,6 +397,28 @@ func ListReleaseMap(namespace string) (releaseMap map[string]string, err error)
 // ListAllReleasesWithDetail returns a map with all releases' names and other info in a given namespace
 func ListAllReleasesWithDetail(namespace string) (releaseMap map[string][]string, err error) {
 	releaseMap = map[string][]string{}
+	if useNativeEngine() {
+		engine, err := getNativeEngine()
+		if err != nil {
+			return releaseMap, err
+		}
+		list, err := engine.list(namespace)
+		if err != nil {
+			return releaseMap, err
+		}
+		for _, rls := range list {
+			chart, appVersion := "", ""
+			if rls.Chart != nil && rls.Chart.Metadata != nil {
+				chart = rls.Chart.Metadata.Name + "-" + rls.Chart.Metadata.Version
+				appVersion = rls.Chart.Metadata.AppVersion
+			}
+			// the same columns as "helm list --all"
+			releaseMap[rls.Name] = strings.Fields(fmt.Sprintf("%s %s %d %s %s %s %s",
+				rls.Name, rls.Namespace, rls.Version, releaseUpdated(rls), rls.Info.Status, chart, appVersion))
+		}
+		return releaseMap, nil
+	}
+
 	_, err = exec.LookPath(helmCmd[0])
 	if err != nil {
 		return releaseMap, err
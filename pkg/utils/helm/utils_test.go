@@ -18,8 +18,10 @@ package helm
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"os/exec"
+	"strings"
 	"testing"
 
 	"github.com/agiledragon/gomonkey/v2"
@@ -84,6 +86,49 @@ func TestInstallRelease(t *testing.T) {
 	statPatch.Reset()
 }
 
+func TestUpgradeRelease(t *testing.T) {
+	LookPathCommon := func(file string) (string, error) {
+		return "test-path", nil
+	}
+	LookPathErr := func(file string) (string, error) {
+		return "", errors.New("fail to run the command")
+	}
+	CombinedOutputCommon := func(cmd *exec.Cmd) ([]byte, error) {
+		return []byte("test-output"), nil
+	}
+	CombinedOutputErr := func(cmd *exec.Cmd) ([]byte, error) {
+		return nil, errors.New("fail to run the command")
+	}
+
+	lookPathPatch := gomonkey.ApplyFunc(exec.LookPath, LookPathErr)
+	err := UpgradeRelease("fluid", "default", "testValueFile", "/chart/fluid")
+	if err == nil {
+		t.Errorf("fail to catch the error")
+	}
+	lookPathPatch.Reset()
+
+	lookPathPatch.ApplyFunc(exec.LookPath, LookPathCommon)
+	combineOutputPatch := gomonkey.ApplyMethod((*exec.Cmd)(nil), "CombinedOutput", CombinedOutputErr)
+	err = UpgradeRelease("fluid", "default", "testValueFile", "/chart/fluid")
+	if err == nil {
+		t.Errorf("fail to catch the error")
+	}
+	combineOutputPatch.Reset()
+
+	combineOutputPatch.ApplyMethod((*exec.Cmd)(nil), "CombinedOutput", func(cmd *exec.Cmd) ([]byte, error) {
+		if !strings.Contains(cmd.String(), "upgrade -f testValueFile --namespace default --history-max 0 fluid /chart/fluid") {
+			return nil, fmt.Errorf("unexpected command %s", cmd.String())
+		}
+		return CombinedOutputCommon(cmd)
+	})
+	err = UpgradeRelease("fluid", "default", "testValueFile", "/chart/fluid")
+	if err != nil {
+		t.Errorf("fail to exec the function: %v", err)
+	}
+	combineOutputPatch.Reset()
+	lookPathPatch.Reset()
+}
+
 func TestCheckRelease(t *testing.T) {
 	LookPathCommon := func(file string) (string, error) {
 		return "test-path", nil
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TransformPropertiesToJavaOpts transforms the properties and the jvm options into the java options in the same
+// order as the ones rendered by the helm charts of Alluxio and GooseFS, which is the properties sorted by keys
+// followed by the jvm options.
+func TransformPropertiesToJavaOpts(properties map[string]string, jvmOptions []string) (javaOpts []string) {
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		javaOpts = append(javaOpts, fmt.Sprintf("-D%s=%s", key, properties[key]))
+	}
+	for _, option := range jvmOptions {
+		javaOpts = append(javaOpts, strings.Fields(option)...)
+	}
+	return
+}
+
+// UpdateJavaOpts replaces the old java options at the end of the rendered java options with the new ones, the
+// options generated by the helm charts before them are kept. The rendered java options are returned as they are
+// if they have been updated already, or they don't end with the old options.
+func UpdateJavaOpts(javaOpts string, oldOpts, newOpts []string) string {
+	fields := strings.Fields(javaOpts)
+	endsWithOld, endsWithNew := hasSuffixFields(fields, oldOpts), hasSuffixFields(fields, newOpts)
+	// both of them match if one is the suffix of the other, the longer one is the actual options
+	if !endsWithOld || (endsWithNew && len(newOpts) > len(oldOpts)) {
+		return javaOpts
+	}
+	fields = append(fields[:len(fields)-len(oldOpts)], newOpts...)
+
+	// the helm charts render each option with a trailing space
+	var builder strings.Builder
+	for _, field := range fields {
+		builder.WriteString(field)
+		builder.WriteString(" ")
+	}
+	return builder.String()
+}
+
+func hasSuffixFields(fields, suffix []string) bool {
+	if len(fields) < len(suffix) {
+		return false
+	}
+	for i := range suffix {
+		if fields[len(fields)-len(suffix)+i] != suffix[i] {
+			return false
+		}
+	}
+	return true
+}
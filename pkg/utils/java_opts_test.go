@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTransformPropertiesToJavaOpts(t *testing.T) {
+	got := TransformPropertiesToJavaOpts(map[string]string{
+		"alluxio.worker.rpc.port": "29999",
+		"alluxio.master.rpc.port": "19998",
+		"alluxio.user.block.size": "64MB",
+	}, []string{"-Xmx4G -Xms1G", "-XX:+UseG1GC"})
+	want := []string{
+		"-Dalluxio.master.rpc.port=19998",
+		"-Dalluxio.user.block.size=64MB",
+		"-Dalluxio.worker.rpc.port=29999",
+		"-Xmx4G",
+		"-Xms1G",
+		"-XX:+UseG1GC",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TransformPropertiesToJavaOpts() = %v, want %v", got, want)
+	}
+}
+
+func TestUpdateJavaOpts(t *testing.T) {
+	tests := []struct {
+		name     string
+		javaOpts string
+		oldOpts  []string
+		newOpts  []string
+		want     string
+	}{
+		{
+			name:     "update_property",
+			javaOpts: "-Dalluxio.worker.hostname=${ALLUXIO_WORKER_HOSTNAME} -Dalluxio.user.block.size=64MB -Xmx4G ",
+			oldOpts:  []string{"-Dalluxio.user.block.size=64MB", "-Xmx4G"},
+			newOpts:  []string{"-Dalluxio.user.block.size=128MB", "-Xmx4G"},
+			want:     "-Dalluxio.worker.hostname=${ALLUXIO_WORKER_HOSTNAME} -Dalluxio.user.block.size=128MB -Xmx4G ",
+		},
+		{
+			name:     "keep_same_option_generated_by_chart",
+			javaOpts: "-Dalluxio.worker.rpc.port=29999 -Dalluxio.worker.rpc.port=29999 ",
+			oldOpts:  []string{"-Dalluxio.worker.rpc.port=29999"},
+			newOpts:  nil,
+			want:     "-Dalluxio.worker.rpc.port=29999 ",
+		},
+		{
+			name:     "add_options_to_empty",
+			javaOpts: "",
+			newOpts:  []string{"-Dalluxio.fuse.debug.enabled=true"},
+			want:     "-Dalluxio.fuse.debug.enabled=true ",
+		},
+		{
+			name:     "already_updated",
+			javaOpts: "-Dalluxio.worker.rpc.port=29999 -Xms1G -Xmx4G ",
+			oldOpts:  []string{"-Xmx4G"},
+			newOpts:  []string{"-Xms1G", "-Xmx4G"},
+			want:     "-Dalluxio.worker.rpc.port=29999 -Xms1G -Xmx4G ",
+		},
+		{
+			name:     "not_end_with_old_options",
+			javaOpts: "-Dalluxio.worker.rpc.port=29999 ",
+			oldOpts:  []string{"-Xmx4G"},
+			newOpts:  []string{"-Xmx8G"},
+			want:     "-Dalluxio.worker.rpc.port=29999 ",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UpdateJavaOpts(tt.javaOpts, tt.oldOpts, tt.newOpts); got != tt.want {
+				t.Errorf("UpdateJavaOpts() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -45,20 +45,12 @@ func UpdateJob(client client.Client, job *v1.Job) error {
 
 // GetSucceedPodForJob get the first finished pod for the job, if no succeed pod, return nil with no error.
 func GetSucceedPodForJob(c client.Client, job *v1.Job) (*corev1.Pod, error) {
-	var podList corev1.PodList
-	selector, err := metav1.LabelSelectorAsSelector(job.Spec.Selector)
+	pods, err := GetPodsForJob(c, job)
 	if err != nil {
-		return nil, fmt.Errorf("error converting Job %s in namespace %s selector: %v", job.Name, job.Namespace, err)
-	}
-	err = c.List(context.TODO(), &podList, &client.ListOptions{
-		Namespace:     job.Namespace,
-		LabelSelector: selector,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("error listing pods for Job %s in namespace %s: %v", job.Name, job.Namespace, err)
+		return nil, err
 	}
 
-	for _, pod := range podList.Items {
+	for _, pod := range pods {
 		if IsSucceededPod(&pod) {
 			return &pod, nil
 		}
@@ -78,3 +70,20 @@ func GetFinishedJobCondition(job *v1.Job) *v1.JobCondition {
 	}
 	return nil
 }
+
+// GetPodsForJob gets the pods of the job by the selector of the job
+func GetPodsForJob(c client.Client, job *v1.Job) ([]corev1.Pod, error) {
+	var podList corev1.PodList
+	selector, err := metav1.LabelSelectorAsSelector(job.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("error converting Job %s in namespace %s selector: %v", job.Name, job.Namespace, err)
+	}
+	err = c.List(context.TODO(), &podList, &client.ListOptions{
+		Namespace:     job.Namespace,
+		LabelSelector: selector,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing pods for Job %s in namespace %s: %v", job.Name, job.Namespace, err)
+	}
+	return podList.Items, nil
+}
@@ -119,6 +119,13 @@ func getParentNameAndOrdinal(pod *v1.Pod) (string, int) {
 	return parent, ordinal
 }
 
+// GetStatefulSetPodOrdinal gets the ordinal of the pod created by a StatefulSet, it returns -1 if the pod was not
+// created by a StatefulSet.
+func GetStatefulSetPodOrdinal(pod *v1.Pod) int {
+	_, ordinal := getParentNameAndOrdinal(pod)
+	return ordinal
+}
+
 // getParentName gets the name of pod's parent StatefulSet. If pod has not parent, the empty string is returned.
 func getParentName(pod *v1.Pod) string {
 	parent, _ := getParentNameAndOrdinal(pod)
@@ -17,9 +17,12 @@ limitations under the License.
 package mountinfo
 
 import (
+	"errors"
 	"fmt"
+	"os"
 	"path"
 	"strings"
+	"syscall"
 
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/golang/glog"
@@ -54,6 +57,62 @@ func GetBrokenMountPoints() ([]MountPoint, error) {
 	return getBrokenBindMounts(globalMountByName, bindMountByName), nil
 }
 
+// statMountPath is used to check the mount point, which is replaceable in unit tests
+var statMountPath = os.Stat
+
+// GetDisconnectedMountPoints returns the bind mount points of Fluid volumes whose FUSE connection is lost,
+// i.e. accessing them fails with ENOTCONN (transport endpoint is not connected), which usually means the
+// FUSE daemon is crashed and the FUSE pod needs to be restarted before recovering the bind mount points.
+func GetDisconnectedMountPoints() ([]MountPoint, error) {
+	mountByPath, err := loadMountInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	globalMountByName, err := getGlobalMounts(mountByPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var disconnectedMounts []MountPoint
+	for name, bindMounts := range getBindMounts(mountByPath) {
+		globalMount, ok := globalMountByName[name]
+		if !ok {
+			continue
+		}
+		for _, bindMount := range bindMounts {
+			if _, err := statMountPath(bindMount.MountPath); err == nil || !errors.Is(err, syscall.ENOTCONN) {
+				continue
+			}
+			disconnectedMounts = append(disconnectedMounts, MountPoint{
+				SourcePath:            path.Join(globalMount.MountPath, bindMount.Subtree),
+				MountPath:             bindMount.MountPath,
+				FilesystemType:        bindMount.FilesystemType,
+				ReadOnly:              bindMount.ReadOnly,
+				Count:                 bindMount.Count,
+				NamespacedDatasetName: name,
+			})
+		}
+	}
+	return disconnectedMounts, nil
+}
+
+// IsBrokenMountPoint checks if the given bind mount path of a Fluid volume is broken,
+// which means it no longer shares the peer group with the global FUSE mount point.
+func IsBrokenMountPoint(mountPath string) (bool, error) {
+	brokenMounts, err := GetBrokenMountPoints()
+	if err != nil {
+		return false, err
+	}
+
+	for _, brokenMount := range brokenMounts {
+		if path.Clean(brokenMount.MountPath) == path.Clean(mountPath) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func getGlobalMounts(mountByPath map[string]*Mount) (globalMountByName map[string]*Mount, err error) {
 	globalMountByName = make(map[string]*Mount)
 	// get fluid MountRoot
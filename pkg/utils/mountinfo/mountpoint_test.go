@@ -17,9 +17,13 @@ limitations under the License.
 package mountinfo
 
 import (
+	"os"
 	"reflect"
+	"syscall"
 	"testing"
 
+	"github.com/agiledragon/gomonkey/v2"
+
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 )
 
@@ -225,3 +229,69 @@ func Test_getGlobalMounts(t *testing.T) {
 		})
 	}
 }
+
+func TestIsBrokenMountPoint(t *testing.T) {
+	t.Setenv(utils.MountRoot, "/runtime-mnt")
+	patches := gomonkey.ApplyFunc(loadMountInfo, func() (map[string]*Mount, error) {
+		return mockMountPoints, nil
+	})
+	defer patches.Reset()
+
+	tests := []struct {
+		name       string
+		mountPath  string
+		wantBroken bool
+	}{
+		{
+			name:       "broken_bind_mount",
+			mountPath:  "/var/lib/kubelet/pods/1140aa96-18c2-4896-a14f-7e3965a51406/volumes/kubernetes.io~csi/default-jfsdemo/mount/",
+			wantBroken: true,
+		},
+		{
+			name:       "not_fluid_mount",
+			mountPath:  "/var/lib/kubelet/pods/1140aa96-18c2-4896-a14f-7e3965a51406/volumes/kubernetes.io~csi/default-other/mount",
+			wantBroken: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotBroken, err := IsBrokenMountPoint(tt.mountPath)
+			if err != nil {
+				t.Fatalf("IsBrokenMountPoint() error = %v", err)
+			}
+			if gotBroken != tt.wantBroken {
+				t.Errorf("IsBrokenMountPoint() = %v, want %v", gotBroken, tt.wantBroken)
+			}
+		})
+	}
+}
+
+func TestGetDisconnectedMountPoints(t *testing.T) {
+	t.Setenv(utils.MountRoot, "/runtime-mnt")
+	patches := gomonkey.ApplyFunc(loadMountInfo, func() (map[string]*Mount, error) {
+		return mockMountPoints, nil
+	})
+	defer patches.Reset()
+	patches.ApplyGlobalVar(&statMountPath, func(name string) (os.FileInfo, error) {
+		if name == mockBindMount.MountPath {
+			return nil, &os.PathError{Op: "stat", Path: name, Err: syscall.ENOTCONN}
+		}
+		return nil, nil
+	})
+
+	got, err := GetDisconnectedMountPoints()
+	if err != nil {
+		t.Fatalf("GetDisconnectedMountPoints() error = %v", err)
+	}
+	want := []MountPoint{{
+		SourcePath:            mockGlobalMount.MountPath,
+		MountPath:             mockBindMount.MountPath,
+		FilesystemType:        mockBindMount.FilesystemType,
+		ReadOnly:              mockBindMount.ReadOnly,
+		Count:                 mockBindMount.Count,
+		NamespacedDatasetName: "default-jfsdemo",
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetDisconnectedMountPoints() = %v, want %v", got, want)
+	}
+}
@@ -19,6 +19,7 @@ package utils
 import (
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 func TransformCoreV1ResourcesToInternalResources(res corev1.ResourceRequirements) (cRes common.Resources) {
@@ -43,6 +44,28 @@ func TransformCoreV1ResourcesToInternalResources(res corev1.ResourceRequirements
 	return
 }
 
+// TransformInternalResourcesToCoreV1Resources transforms the resources in the helm values back to the resource requirements
+func TransformInternalResourcesToCoreV1Resources(cRes common.Resources) (res corev1.ResourceRequirements, err error) {
+	transform := func(cList common.ResourceList) (list corev1.ResourceList, err error) {
+		if len(cList) == 0 {
+			return
+		}
+		list = corev1.ResourceList{}
+		for k, v := range cList {
+			if list[k], err = resource.ParseQuantity(v); err != nil {
+				return nil, err
+			}
+		}
+		return
+	}
+
+	if res.Requests, err = transform(cRes.Requests); err != nil {
+		return
+	}
+	res.Limits, err = transform(cRes.Limits)
+	return
+}
+
 func ResourceRequirementsEqual(source corev1.ResourceRequirements,
 	target corev1.ResourceRequirements) bool {
 	return resourceListsEqual(source.Requests, target.Requests) &&
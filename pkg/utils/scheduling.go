@@ -0,0 +1,41 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+// TransformSchedulingSpec transforms the scheduling spec of a runtime component into the helm values.
+// It returns nil if no scheduling constraint is specified, so that the scheduling is omitted in the values.
+func TransformSchedulingSpec(spec datav1alpha1.SchedulingSpec) *common.Scheduling {
+	if len(spec.Tolerations) == 0 && spec.Affinity == nil && len(spec.PriorityClassName) == 0 &&
+		len(spec.TopologySpreadConstraints) == 0 && len(spec.SchedulerName) == 0 && spec.SecurityContext == nil {
+		return nil
+	}
+
+	in := spec.DeepCopy()
+	return &common.Scheduling{
+		Tolerations:               in.Tolerations,
+		Affinity:                  in.Affinity,
+		PriorityClassName:         in.PriorityClassName,
+		TopologySpreadConstraints: in.TopologySpreadConstraints,
+		SchedulerName:             in.SchedulerName,
+		SecurityContext:           in.SecurityContext,
+	}
+}
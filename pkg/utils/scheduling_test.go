@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+func TestTransformSchedulingSpec(t *testing.T) {
+	affinity := &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+					MatchExpressions: []corev1.NodeSelectorRequirement{{
+						Key:      "node-role.kubernetes.io/control-plane",
+						Operator: corev1.NodeSelectorOpExists,
+					}},
+				}},
+			},
+		},
+	}
+	tests := []struct {
+		name string
+		spec datav1alpha1.SchedulingSpec
+		want *common.Scheduling
+	}{
+		{
+			name: "empty",
+			spec: datav1alpha1.SchedulingSpec{},
+			want: nil,
+		},
+		{
+			name: "scheduling",
+			spec: datav1alpha1.SchedulingSpec{
+				Tolerations:       []corev1.Toleration{{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpExists}},
+				Affinity:          affinity,
+				PriorityClassName: "high-priority",
+				SchedulerName:     "volcano",
+			},
+			want: &common.Scheduling{
+				Tolerations:       []corev1.Toleration{{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpExists}},
+				Affinity:          affinity,
+				PriorityClassName: "high-priority",
+				SchedulerName:     "volcano",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TransformSchedulingSpec(tt.spec)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TransformSchedulingSpec() = %v, want %v", got, tt.want)
+			}
+			if got != nil && got.Affinity == tt.spec.Affinity {
+				t.Errorf("TransformSchedulingSpec() is expected to copy the affinity")
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateObjectName checks the name of a dataset or a runtime. Such names are used as prefixes of
+// the underlying workloads and services, so they must follow the DNS-1035 label rule (e.g. "20-hbase" is invalid).
+func ValidateObjectName(name string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(name) == 0 {
+		return allErrs
+	}
+
+	if errs := validation.IsDNS1035Label(name); len(errs) > 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, name, strings.Join(errs, ",")))
+	}
+	return allErrs
+}
+
+// ValidateDataset checks the fields of a dataset that can be validated without knowing which runtime it will be bound to.
+func ValidateDataset(dataset *datav1alpha1.Dataset) field.ErrorList {
+	allErrs := ValidateObjectName(dataset.Name, field.NewPath("metadata").Child("name"))
+	allErrs = append(allErrs, ValidateDatasetMounts(dataset.Spec.Mounts, field.NewPath("spec").Child("mounts"))...)
+	return allErrs
+}
+
+// ValidateDatasetUpdate checks the changed fields of a dataset. Mounts are only validated when they are changed
+// so that existing objects can still be updated by the controllers, and the placement mode must not be changed
+// once the dataset is bound to a runtime.
+func ValidateDatasetUpdate(newDataset, oldDataset *datav1alpha1.Dataset) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !equality.Semantic.DeepEqual(newDataset.Spec.Mounts, oldDataset.Spec.Mounts) {
+		allErrs = append(allErrs, ValidateDatasetMounts(newDataset.Spec.Mounts, field.NewPath("spec").Child("mounts"))...)
+	}
+
+	if len(oldDataset.Status.Runtimes) > 0 && newDataset.Spec.PlacementMode != oldDataset.Spec.PlacementMode {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec").Child("placement"),
+			fmt.Sprintf("placement mode is immutable once the dataset is bound to runtime %s/%s, delete the runtime before changing it",
+				oldDataset.Status.Runtimes[0].Namespace, oldDataset.Status.Runtimes[0].Name)))
+	}
+
+	return allErrs
+}
+
+// ValidateDatasetMounts checks that mount names and mount paths are unique, and the mount points whose schemes
+// are parsed by Fluid itself (pvc://, local:// and dataset://) are well-formed. Other mount points are passed to
+// the runtimes as they are (e.g. "<IP>:<PATH>" for NFS in ThinRuntime), so their format is not checked here.
+func ValidateDatasetMounts(mounts []datav1alpha1.Mount, fldPath *field.Path) field.ErrorList {
+	allErrs := ValidateDuplicateDatasetMounts(mounts, fldPath)
+	for idx, mount := range mounts {
+		allErrs = append(allErrs, validateMountPoint(mount.MountPoint, fldPath.Index(idx).Child("mountPoint"))...)
+	}
+	return allErrs
+}
+
+// ValidateDuplicateDatasetMounts checks that mount names and mount paths are unique.
+// The mount path follows the convention used by the cache engines: mount.Path if set, otherwise /{mount.Name}.
+func ValidateDuplicateDatasetMounts(mounts []datav1alpha1.Mount, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	existedMountNames := map[string]int{}
+	existedMountPath := map[string]int{}
+	for idx, mount := range mounts {
+		idxPath := fldPath.Index(idx)
+
+		path := mount.Path
+		if len(path) == 0 {
+			path = fmt.Sprintf("/%s", mount.Name)
+		}
+
+		if _, exists := existedMountNames[mount.Name]; exists {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), mount.Name))
+			continue // Skip to next iteration because collided mount names imply collided mount paths.
+		}
+
+		if _, exists := existedMountPath[path]; exists {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("path"), path))
+			continue
+		}
+
+		existedMountNames[mount.Name] = idx
+		existedMountPath[path] = idx
+	}
+
+	return allErrs
+}
+
+func validateMountPoint(mountPoint string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch {
+	case strings.HasPrefix(mountPoint, common.VolumeScheme.String()):
+		pvcName := strings.SplitN(strings.TrimPrefix(mountPoint, common.VolumeScheme.String()), "/", 2)[0]
+		if errs := validation.IsDNS1123Subdomain(pvcName); len(errs) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath, mountPoint, fmt.Sprintf("mount point must be in the form of pvc://<pvcName>/<subPath>, invalid pvc name %q: %s", pvcName, strings.Join(errs, ","))))
+		}
+	case strings.HasPrefix(mountPoint, common.PathScheme.String()):
+		localPath := strings.TrimPrefix(mountPoint, common.PathScheme.String())
+		if !filepath.IsAbs(localPath) {
+			allErrs = append(allErrs, field.Invalid(fldPath, mountPoint, "mount point must be in the form of local://<absolutePath>, e.g. local:///mnt/data"))
+		}
+	case common.IsFluidRefSchema(mountPoint):
+		parts := strings.Split(strings.TrimPrefix(mountPoint, common.RefSchema.String()), "/")
+		if len(parts) < 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath, mountPoint, "mount point must be in the form of dataset://<namespace>/<name>/<subPath>"))
+		}
+	}
+
+	return allErrs
+}
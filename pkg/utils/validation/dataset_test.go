@@ -0,0 +1,177 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateDatasetMounts(t *testing.T) {
+	testCases := []struct {
+		name    string
+		mounts  []datav1alpha1.Mount
+		wantErr bool
+	}{
+		{
+			name: "valid mounts",
+			mounts: []datav1alpha1.Mount{
+				{Name: "spark", MountPoint: "s3://bucket/spark"},
+				{Name: "hbase", MountPoint: "pvc://hbase-pvc/subpath"},
+				{Name: "local", MountPoint: "local:///mnt/data"},
+				{Name: "ref", MountPoint: "dataset://default/hbase/subpath"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "duplicate mount names",
+			mounts: []datav1alpha1.Mount{
+				{Name: "spark", MountPoint: "s3://bucket/spark"},
+				{Name: "spark", MountPoint: "s3://bucket/spark2", Path: "/spark2"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate mount paths",
+			mounts: []datav1alpha1.Mount{
+				{Name: "spark", MountPoint: "s3://bucket/spark"},
+				{Name: "hbase", MountPoint: "s3://bucket/hbase", Path: "/spark"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "mount point without scheme",
+			mounts: []datav1alpha1.Mount{
+				{Name: "nfs", MountPoint: "192.168.0.1:/nfs/data"},
+				{Name: "cubefs", MountPoint: "192.168.0.2:17010,192.168.0.3:17010/vol"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid pvc name",
+			mounts: []datav1alpha1.Mount{
+				{Name: "spark", MountPoint: "pvc://Invalid_PVC"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "relative local path",
+			mounts: []datav1alpha1.Mount{
+				{Name: "spark", MountPoint: "local://mnt/spark"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "dataset mount point without name",
+			mounts: []datav1alpha1.Mount{
+				{Name: "ref", MountPoint: "dataset://default"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		errs := ValidateDatasetMounts(tc.mounts, field.NewPath("spec").Child("mounts"))
+		if gotErr := len(errs) > 0; gotErr != tc.wantErr {
+			t.Errorf("testcase %s: expect error %v, got %v", tc.name, tc.wantErr, errs)
+		}
+	}
+}
+
+func TestValidateDataset(t *testing.T) {
+	dataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{Name: "20-hbase", Namespace: "default"},
+		Spec: datav1alpha1.DatasetSpec{
+			Mounts: []datav1alpha1.Mount{{Name: "hbase", MountPoint: "s3://bucket/hbase"}},
+		},
+	}
+	if errs := ValidateDataset(dataset); len(errs) == 0 {
+		t.Errorf("expect dataset with invalid name to be rejected")
+	}
+
+	dataset.Name = "hbase"
+	if errs := ValidateDataset(dataset); len(errs) != 0 {
+		t.Errorf("expect dataset to be valid, got %v", errs)
+	}
+}
+
+func TestValidateDatasetUpdate(t *testing.T) {
+	oldDataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"},
+		Spec: datav1alpha1.DatasetSpec{
+			Mounts:        []datav1alpha1.Mount{{Name: "hbase", MountPoint: "s3://bucket/hbase"}},
+			PlacementMode: datav1alpha1.ExclusiveMode,
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		bound   bool
+		mutate  func(dataset *datav1alpha1.Dataset)
+		wantErr bool
+	}{
+		{
+			name: "add a mount",
+			mutate: func(dataset *datav1alpha1.Dataset) {
+				dataset.Spec.Mounts = append(dataset.Spec.Mounts, datav1alpha1.Mount{Name: "spark", MountPoint: "s3://bucket/spark"})
+			},
+			bound:   true,
+			wantErr: false,
+		},
+		{
+			name: "add a duplicate mount",
+			mutate: func(dataset *datav1alpha1.Dataset) {
+				dataset.Spec.Mounts = append(dataset.Spec.Mounts, datav1alpha1.Mount{Name: "hbase", MountPoint: "s3://bucket/spark"})
+			},
+			bound:   true,
+			wantErr: true,
+		},
+		{
+			name: "change placement mode of bound dataset",
+			mutate: func(dataset *datav1alpha1.Dataset) {
+				dataset.Spec.PlacementMode = datav1alpha1.ShareMode
+			},
+			bound:   true,
+			wantErr: true,
+		},
+		{
+			name: "change placement mode of unbound dataset",
+			mutate: func(dataset *datav1alpha1.Dataset) {
+				dataset.Spec.PlacementMode = datav1alpha1.ShareMode
+			},
+			bound:   false,
+			wantErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		old := oldDataset.DeepCopy()
+		if tc.bound {
+			old.Status.Runtimes = []datav1alpha1.Runtime{{Name: "hbase", Namespace: "default"}}
+		}
+		newDataset := old.DeepCopy()
+		tc.mutate(newDataset)
+
+		errs := ValidateDatasetUpdate(newDataset, old)
+		if gotErr := len(errs) > 0; gotErr != tc.wantErr {
+			t.Errorf("testcase %s: expect error %v, got %v", tc.name, tc.wantErr, errs)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateTieredStore checks the quotas and watermarks of every tiered store level with the same rules
+// used by the runtime controllers when building the runtime info.
+func ValidateTieredStore(tieredStore datav1alpha1.TieredStore, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	mediumTypes := map[string]int{}
+	for idx, level := range tieredStore.Levels {
+		levelPath := fldPath.Child("levels").Index(idx)
+
+		if _, exists := mediumTypes[string(level.MediumType)]; exists {
+			allErrs = append(allErrs, field.Duplicate(levelPath.Child("mediumtype"), level.MediumType))
+		}
+		mediumTypes[string(level.MediumType)] = idx
+
+		allErrs = append(allErrs, validateLevelQuota(level, levelPath)...)
+		allErrs = append(allErrs, validateLevelWatermarks(level, levelPath)...)
+	}
+
+	return allErrs
+}
+
+// ValidateTieredStoreUpdate checks the tiered store of a runtime only when it is changed, so that existing objects
+// can still be updated by the controllers. Changing the tiered store is allowed because the runtimes which support
+// SyncRuntime (e.g. JuiceFS) apply the new cache layout in place.
+func ValidateTieredStoreUpdate(newTieredStore, oldTieredStore datav1alpha1.TieredStore, fldPath *field.Path) field.ErrorList {
+	if equality.Semantic.DeepEqual(newTieredStore, oldTieredStore) {
+		return field.ErrorList{}
+	}
+	return ValidateTieredStore(newTieredStore, fldPath)
+}
+
+func validateLevelQuota(level datav1alpha1.Level, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(level.QuotaList) == 0 {
+		if level.Quota == nil {
+			return append(allErrs, field.Required(fldPath.Child("quota"), "either quota or quotaList must be set"))
+		}
+		if level.Quota.Sign() <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("quota"), level.Quota.String(), "quota must be greater than 0"))
+		}
+		return allErrs
+	}
+
+	paths := strings.Split(level.Path, ",")
+	quotaStrs := strings.Split(level.QuotaList, ",")
+	if len(quotaStrs) != len(paths) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("quotaList"), level.QuotaList,
+			fmt.Sprintf("length of quotaList (%d) must be consistent with length of paths (%d)", len(quotaStrs), len(paths))))
+	}
+
+	for _, quotaStr := range quotaStrs {
+		quota, err := resource.ParseQuantity(quotaStr)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("quotaList"), level.QuotaList,
+				fmt.Sprintf("can't correctly parse quota %q to a quantity type", quotaStr)))
+			continue
+		}
+		if quota.Sign() <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("quotaList"), level.QuotaList,
+				fmt.Sprintf("quota %q must be greater than 0", quotaStr)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateLevelWatermarks(level datav1alpha1.Level, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	parseRatio := func(value string, path *field.Path) (ratio float64, ok bool) {
+		if len(value) == 0 {
+			return 0, false
+		}
+		ratio, err := strconv.ParseFloat(value, 64)
+		if err != nil || ratio < 0 || ratio > 1 {
+			allErrs = append(allErrs, field.Invalid(path, value, "watermark must be a ratio between 0 and 1"))
+			return 0, false
+		}
+		return ratio, true
+	}
+
+	high, highSet := parseRatio(level.High, fldPath.Child("high"))
+	low, lowSet := parseRatio(level.Low, fldPath.Child("low"))
+	if highSet && lowSet && low > high {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("low"), level.Low,
+			fmt.Sprintf("low watermark must not be greater than high watermark %s", level.High)))
+	}
+
+	return allErrs
+}
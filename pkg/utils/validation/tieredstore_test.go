@@ -0,0 +1,140 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateTieredStore(t *testing.T) {
+	quota := resource.MustParse("10Gi")
+	zeroQuota := resource.MustParse("0")
+
+	testCases := []struct {
+		name    string
+		levels  []datav1alpha1.Level
+		wantErr bool
+	}{
+		{
+			name: "valid quota",
+			levels: []datav1alpha1.Level{
+				{MediumType: common.Memory, Path: "/dev/shm", Quota: &quota, High: "0.95", Low: "0.7"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid quota list",
+			levels: []datav1alpha1.Level{
+				{MediumType: common.SSD, Path: "/mnt/cache1,/mnt/cache2", QuotaList: "10Gi,20Gi"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "no quota",
+			levels: []datav1alpha1.Level{
+				{MediumType: common.Memory, Path: "/dev/shm"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "zero quota",
+			levels: []datav1alpha1.Level{
+				{MediumType: common.Memory, Path: "/dev/shm", Quota: &zeroQuota},
+			},
+			wantErr: true,
+		},
+		{
+			name: "inconsistent quota list",
+			levels: []datav1alpha1.Level{
+				{MediumType: common.SSD, Path: "/mnt/cache1,/mnt/cache2", QuotaList: "10Gi"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unparsable quota list",
+			levels: []datav1alpha1.Level{
+				{MediumType: common.SSD, Path: "/mnt/cache1,/mnt/cache2", QuotaList: "10Gi,abc"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate medium types",
+			levels: []datav1alpha1.Level{
+				{MediumType: common.SSD, Path: "/mnt/cache1", Quota: &quota},
+				{MediumType: common.SSD, Path: "/mnt/cache2", Quota: &quota},
+			},
+			wantErr: true,
+		},
+		{
+			name: "low watermark greater than high watermark",
+			levels: []datav1alpha1.Level{
+				{MediumType: common.Memory, Path: "/dev/shm", Quota: &quota, High: "0.7", Low: "0.9"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "watermark out of range",
+			levels: []datav1alpha1.Level{
+				{MediumType: common.Memory, Path: "/dev/shm", Quota: &quota, High: "1.5"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		errs := ValidateTieredStore(datav1alpha1.TieredStore{Levels: tc.levels}, field.NewPath("spec").Child("tieredstore"))
+		if gotErr := len(errs) > 0; gotErr != tc.wantErr {
+			t.Errorf("testcase %s: expect error %v, got %v", tc.name, tc.wantErr, errs)
+		}
+	}
+}
+
+func TestValidateTieredStoreUpdate(t *testing.T) {
+	oldQuota := resource.MustParse("10Gi")
+	sameQuota := resource.MustParse("10240Mi")
+	newQuota := resource.MustParse("20Gi")
+
+	oldTieredStore := datav1alpha1.TieredStore{
+		Levels: []datav1alpha1.Level{{MediumType: common.Memory, Path: "/dev/shm", Quota: &oldQuota}},
+	}
+
+	unchanged := datav1alpha1.TieredStore{
+		Levels: []datav1alpha1.Level{{MediumType: common.Memory, Path: "/dev/shm", Quota: &sameQuota}},
+	}
+	if errs := ValidateTieredStoreUpdate(unchanged, oldTieredStore, field.NewPath("spec").Child("tieredstore")); len(errs) != 0 {
+		t.Errorf("expect semantically equal tieredstore to be allowed, got %v", errs)
+	}
+
+	changed := datav1alpha1.TieredStore{
+		Levels: []datav1alpha1.Level{{MediumType: common.Memory, Path: "/dev/shm", Quota: &newQuota}},
+	}
+	if errs := ValidateTieredStoreUpdate(changed, oldTieredStore, field.NewPath("spec").Child("tieredstore")); len(errs) != 0 {
+		t.Errorf("expect changing tieredstore to be allowed, got %v", errs)
+	}
+
+	invalid := datav1alpha1.TieredStore{
+		Levels: []datav1alpha1.Level{{MediumType: common.Memory, Path: "/dev/shm"}},
+	}
+	if errs := ValidateTieredStoreUpdate(invalid, oldTieredStore, field.NewPath("spec").Child("tieredstore")); len(errs) == 0 {
+		t.Errorf("expect changing tieredstore to an invalid one to be denied")
+	}
+}
@@ -95,21 +95,14 @@ func (a *FluidMutatingHandler) Handle(ctx context.Context, req admission.Request
 		setupLog.Info("skip mutating the pod because injection is done", "Pod", pod.Name, "Namespace", pod.Namespace)
 		return admission.Allowed("skip mutating the pod because injection is done")
 	}
+	if common.CheckExpectValue(pod.Labels, common.InjectWorkloadDone, common.True) {
+		setupLog.Info("skip mutating the pod because its workload is mutated", "Pod", pod.Name, "Namespace", pod.Namespace)
+		return admission.Allowed("skip mutating the pod because its workload is mutated")
+	}
 
-	backupPod := pod.DeepCopy()
-	if err := a.MutatePod(pod, false); err != nil {
-		setupLog.Error(err, "failed to mutate pod with cache client", "Pod", pod.Name, "Namespace", pod.Namespace)
-		if webhookutils.IsNeedRetryWithApiReaderError(err) {
-			setupLog.Info("retrying with API reader",
-				"namespace", pod.Namespace,
-				"pod", pod.Name,
-				"reason", err.Error(),
-			)
-			pod = backupPod
-			if err := a.MutatePod(pod, true); err != nil {
-				return admission.Errored(http.StatusInternalServerError, err)
-			}
-		}
+	pod, err = a.mutatePodWithRetry(pod)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
 	}
 
 	if undoNamespaceOverride {
@@ -127,6 +120,28 @@ func (a *FluidMutatingHandler) Handle(ctx context.Context, req admission.Request
 	return resp
 }
 
+// mutatePodWithRetry mutates the pod with the cache client, and retries with the API reader
+// if the runtime infos can't be collected from the cache.
+func (a *FluidMutatingHandler) mutatePodWithRetry(pod *corev1.Pod) (*corev1.Pod, error) {
+	var setupLog = ctrl.Log.WithName("handle")
+	backupPod := pod.DeepCopy()
+	if err := a.MutatePod(pod, false); err != nil {
+		setupLog.Error(err, "failed to mutate pod with cache client", "Pod", pod.Name, "Namespace", pod.Namespace)
+		if webhookutils.IsNeedRetryWithApiReaderError(err) {
+			setupLog.Info("retrying with API reader",
+				"namespace", pod.Namespace,
+				"pod", pod.Name,
+				"reason", err.Error(),
+			)
+			pod = backupPod
+			if err := a.MutatePod(pod, true); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return pod, nil
+}
+
 // MutatePod will call all plugins to get total prefer info
 func (a *FluidMutatingHandler) MutatePod(pod *corev1.Pod, useDirectReader bool) (err error) {
 	handlerClient := a.Reader
@@ -21,10 +21,12 @@ import (
 )
 
 // +kubebuilder:webhook:path=/mutate-fluid-io-v1alpha1-schedulepod,mutating=true,failurePolicy=fail,sideEffects=None,admissionReviewVersions=v1;v1beta1,groups="",resources=pods,verbs=create;update,versions=v1,name=schedulepod.fluid.io
+// +kubebuilder:webhook:path=/mutate-fluid-io-v1alpha1-workload,mutating=true,failurePolicy=fail,sideEffects=None,admissionReviewVersions=v1;v1beta1,groups=apps;batch,resources=deployments;statefulsets;jobs;cronjobs,verbs=create;update,versions=v1,name=workload.fluid.io
 
 var (
 	// HandlerMap contains admission webhook handlers
 	HandlerMap = map[string]common.AdmissionHandler{
 		common.WebhookSchedulePodPath: &FluidMutatingHandler{},
+		common.WebhookWorkloadPath:    &FluidWorkloadMutatingHandler{},
 	}
 )
@@ -0,0 +1,269 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutating
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// FluidWorkloadMutatingHandler mutates the pod templates of the workloads, e.g. Deployments and Jobs, with the same
+// plugins as the pods, so that the mutations are visible at the workload level before the pods are created.
+type FluidWorkloadMutatingHandler struct {
+	podHandler *FluidMutatingHandler
+	decoder    *admission.Decoder
+	// podTemplatePaths are the paths of the pod templates of the workloads by group kind
+	podTemplatePaths map[schema.GroupKind][]string
+}
+
+func (a *FluidWorkloadMutatingHandler) Setup(client client.Client, reader client.Reader, decoder *admission.Decoder) {
+	a.podHandler = &FluidMutatingHandler{}
+	a.podHandler.Setup(client, reader, decoder)
+	a.decoder = decoder
+
+	paths, err := loadPodTemplatePaths(common.WebhookWorkloadsFilePath)
+	if err != nil {
+		ctrl.Log.WithName("handler").Error(err, "failed to load the workloads profile, only the built-in workloads are mutated",
+			"profile", common.WebhookWorkloadsFilePath)
+		paths = builtinPodTemplatePaths
+	}
+	a.podTemplatePaths = paths
+}
+
+// Handle is the mutating logic of workload
+func (a *FluidWorkloadMutatingHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	defer utils.TimeTrack(time.Now(), "FluidWorkloadMutatingHandler.Handle",
+		"req.name", req.Name, "req.namespace", req.Namespace, "req.kind", req.Kind)
+
+	if utils.GetBoolValueFromEnv(common.EnvDisableInjection, false) {
+		return admission.Allowed("skip mutating the workload because global injection is disabled")
+	}
+
+	var setupLog = ctrl.Log.WithName("handleWorkload")
+	obj := &unstructured.Unstructured{}
+	err := a.decoder.DecodeRaw(req.Object, obj)
+	if err != nil {
+		setupLog.Error(err, "unable to decode workload from req")
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if !common.CheckExpectValue(obj.GetLabels(), common.InjectWorkload, common.True) {
+		return admission.Allowed("skip mutating the workload because workload injection is not enabled")
+	}
+
+	paths, found := a.podTemplatePaths[schema.GroupKind{Group: req.Kind.Group, Kind: req.Kind.Kind}]
+	if !found {
+		return admission.Allowed("skip mutating the workload because the paths of its pod templates are unknown")
+	}
+
+	// the old object is used to strip the previous injection off the pod templates on update
+	var oldObj *unstructured.Unstructured
+	if req.Operation == admissionv1.Update && len(req.OldObject.Raw) > 0 {
+		oldObj = &unstructured.Unstructured{}
+		if err = a.decoder.DecodeRaw(req.OldObject, oldObj); err != nil {
+			setupLog.Error(err, "unable to decode old workload from req")
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+	}
+
+	mutated := false
+	for _, path := range paths {
+		var oldTemplates map[string]map[string]interface{}
+		if oldObj != nil {
+			oldTemplates = findPodTemplatesByPath(oldObj.Object, path)
+		}
+		for templatePath, template := range findPodTemplatesByPath(obj.Object, path) {
+			changed, err := a.mutatePodTemplate(template, oldTemplates[templatePath], req.Namespace)
+			if err != nil {
+				setupLog.Error(err, "failed to mutate pod template", "path", path, "name", obj.GetName(), "namespace", req.Namespace)
+				return admission.Errored(http.StatusInternalServerError, err)
+			}
+			mutated = mutated || changed
+		}
+	}
+	if !mutated {
+		return admission.Allowed("no pod template of the workload is mutated")
+	}
+
+	marshaledObj, err := json.Marshal(obj)
+	if err != nil {
+		setupLog.Error(err, "unable to marshal workload")
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	resp := admission.PatchResponseFromRaw(req.Object.Raw, marshaledObj)
+	setupLog.V(1).Info("patch response", "name", obj.GetName(), "namespace", req.Namespace, "kind", req.Kind.Kind, "patches", utils.DumpJSON(resp.Patch))
+	return resp
+}
+
+// mutatePodTemplate mutates the pod template in place with the plugins of the pods, and labels it with
+// done.workload.fluid.io/inject so that the pods created from it are not mutated again. The pod template mutated
+// before is mutated again from its original version if its dataset volumes are changed since the old template.
+func (a *FluidWorkloadMutatingHandler) mutatePodTemplate(template, oldTemplate map[string]interface{}, namespace string) (mutated bool, err error) {
+	podTemplate := &corev1.PodTemplateSpec{}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(template, podTemplate); err != nil {
+		return
+	}
+
+	if common.CheckExpectValue(podTemplate.Labels, common.InjectWorkloadDone, common.True) {
+		var original *corev1.PodTemplateSpec
+		original, err = restoreOriginalPodTemplate(podTemplate, oldTemplate)
+		if err != nil || original == nil {
+			return
+		}
+		// the original pod template is written back even if it's not mutated again
+		podTemplate, mutated = original, true
+	}
+
+	changed, err := a.injectPodTemplate(podTemplate, namespace)
+	if err != nil || !(mutated || changed) {
+		return
+	}
+
+	out, err := runtime.DefaultUnstructuredConverter.ToUnstructured(podTemplate)
+	if err != nil {
+		return
+	}
+	// avoid the noisy patch of creationTimestamp: null
+	if timestamp, found, _ := unstructured.NestedFieldNoCopy(out, "metadata", "creationTimestamp"); found && timestamp == nil {
+		unstructured.RemoveNestedField(out, "metadata", "creationTimestamp")
+	}
+	for key := range template {
+		delete(template, key)
+	}
+	for key, value := range out {
+		template[key] = value
+	}
+	return true, nil
+}
+
+// injectPodTemplate mutates the pod template with the plugins of the pods, and records the original pod template
+// in the annotation workload.fluid.io/original-template.
+func (a *FluidWorkloadMutatingHandler) injectPodTemplate(podTemplate *corev1.PodTemplateSpec, namespace string) (mutated bool, err error) {
+	if common.CheckExpectValue(podTemplate.Labels, common.EnableFluidInjectionFlag, common.False) ||
+		common.CheckExpectValue(podTemplate.Labels, common.InjectSidecarDone, common.True) {
+		return
+	}
+	// the host paths with random suffix must be generated for each pod, so leave it to the pod mutation
+	if common.HostPathMode(podTemplate.Annotations[common.HostMountPathModeOnDefaultPlatformKey]) == common.HostPathModeRandomSuffix {
+		return
+	}
+
+	originalTemplate, err := json.Marshal(podTemplate)
+	if err != nil {
+		return
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: *podTemplate.ObjectMeta.DeepCopy(),
+		Spec:       *podTemplate.Spec.DeepCopy(),
+	}
+	// The namespace is required by the plugins to find the datasets
+	pod.Namespace = namespace
+	pod, err = a.podHandler.mutatePodWithRetry(pod)
+	if err != nil {
+		return
+	}
+	pod.Namespace = podTemplate.Namespace
+
+	if reflect.DeepEqual(pod.ObjectMeta, podTemplate.ObjectMeta) && reflect.DeepEqual(pod.Spec, podTemplate.Spec) {
+		return
+	}
+
+	podTemplate.ObjectMeta = pod.ObjectMeta
+	podTemplate.Spec = pod.Spec
+	if podTemplate.Labels == nil {
+		podTemplate.Labels = map[string]string{}
+	}
+	podTemplate.Labels[common.InjectWorkloadDone] = common.True
+	if podTemplate.Annotations == nil {
+		podTemplate.Annotations = map[string]string{}
+	}
+	podTemplate.Annotations[common.WorkloadOriginalTemplate] = string(originalTemplate)
+	return true, nil
+}
+
+// restoreOriginalPodTemplate strips the previous injection off the mutated pod template by applying the changes made
+// since the old pod template onto the original pod template recorded by the injection. It returns nil if the dataset
+// volumes are not changed, or the original pod template is unknown, e.g. the pod template is created mutated.
+func restoreOriginalPodTemplate(podTemplate *corev1.PodTemplateSpec, oldTemplate map[string]interface{}) (*corev1.PodTemplateSpec, error) {
+	originalTemplate, found := podTemplate.Annotations[common.WorkloadOriginalTemplate]
+	if !found || oldTemplate == nil {
+		return nil, nil
+	}
+
+	oldPodTemplate, err := json.Marshal(oldTemplate)
+	if err != nil {
+		return nil, err
+	}
+	newPodTemplate, err := json.Marshal(podTemplate)
+	if err != nil {
+		return nil, err
+	}
+	patch, err := strategicpatch.CreateTwoWayMergePatch(oldPodTemplate, newPodTemplate, corev1.PodTemplateSpec{})
+	if err != nil {
+		return nil, err
+	}
+	restoredTemplate, err := strategicpatch.StrategicMergePatch([]byte(originalTemplate), patch, corev1.PodTemplateSpec{})
+	if err != nil {
+		return nil, err
+	}
+
+	original := &corev1.PodTemplateSpec{}
+	if err = json.Unmarshal([]byte(originalTemplate), original); err != nil {
+		return nil, err
+	}
+	restored := &corev1.PodTemplateSpec{}
+	if err = json.Unmarshal(restoredTemplate, restored); err != nil {
+		return nil, err
+	}
+	if reflect.DeepEqual(getClaimNames(original), getClaimNames(restored)) {
+		return nil, nil
+	}
+
+	delete(restored.Labels, common.InjectWorkloadDone)
+	delete(restored.Annotations, common.WorkloadOriginalTemplate)
+	return restored, nil
+}
+
+// getClaimNames returns the sorted names of the persistent volume claims mounted by the pod template
+func getClaimNames(podTemplate *corev1.PodTemplateSpec) (claimNames []string) {
+	for _, volume := range podTemplate.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil {
+			claimNames = append(claimNames, volume.PersistentVolumeClaim.ClaimName)
+		}
+	}
+	sort.Strings(claimNames)
+	return
+}
@@ -0,0 +1,273 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutating
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	admissionv1 "k8s.io/api/admission/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	"github.com/fluid-cloudnative/fluid/pkg/webhook/plugins"
+)
+
+func TestFindPodTemplates(t *testing.T) {
+	obj := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{"metadata": map[string]interface{}{"name": "deploy"}},
+			"replicaSpecs": map[string]interface{}{
+				"Master": map[string]interface{}{"template": map[string]interface{}{}},
+				"Worker": map[string]interface{}{"template": map[string]interface{}{}},
+			},
+			"roles": []interface{}{
+				map[string]interface{}{"template": map[string]interface{}{}},
+				map[string]interface{}{"replicas": int64(1)},
+			},
+		},
+	}
+
+	tests := map[string]int{
+		".spec.template":                1,
+		"spec.template":                 1,
+		".spec.replicaSpecs.*.template": 2,
+		".spec.roles.*.template":        1,
+		".spec.template.metadata.name":  0,
+		".spec.notExist.template":       0,
+	}
+	for path, want := range tests {
+		if got := findPodTemplates(obj, path); len(got) != want {
+			t.Errorf("findPodTemplates(%s) = %v, want %d templates", path, got, want)
+		}
+	}
+
+	// the templates are returned by reference
+	findPodTemplates(obj, ".spec.template")[0]["spec"] = map[string]interface{}{}
+	if _, found := obj["spec"].(map[string]interface{})["template"].(map[string]interface{})["spec"]; !found {
+		t.Errorf("findPodTemplates() is expected to return the templates by reference")
+	}
+}
+
+func TestLoadPodTemplatePaths(t *testing.T) {
+	dir := t.TempDir()
+
+	paths, err := loadPodTemplatePaths(filepath.Join(dir, "notExist"))
+	if err != nil || len(paths) != len(builtinPodTemplatePaths) {
+		t.Errorf("loadPodTemplatePaths() = %v, %v, want the built-in paths", paths, err)
+	}
+
+	profile := filepath.Join(dir, "workloads.profile")
+	if err = os.WriteFile(profile, []byte(`workloads:
+- group: kubeflow.org
+  kind: PyTorchJob
+  podTemplatePaths:
+  - .spec.pytorchReplicaSpecs.*.template
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	paths, err = loadPodTemplatePaths(profile)
+	if err != nil {
+		t.Fatalf("loadPodTemplatePaths() error = %v", err)
+	}
+	if got := paths[schema.GroupKind{Group: "kubeflow.org", Kind: "PyTorchJob"}]; len(got) != 1 || got[0] != ".spec.pytorchReplicaSpecs.*.template" {
+		t.Errorf("loadPodTemplatePaths() = %v, want the paths of PyTorchJob", paths)
+	}
+	if got := paths[schema.GroupKind{Group: "apps", Kind: "Deployment"}]; len(got) != 1 {
+		t.Errorf("loadPodTemplatePaths() = %v, want the paths of the built-in workloads", paths)
+	}
+
+	if err = os.WriteFile(profile, []byte("workloads:\n- group: kubeflow.org\n  kind: PyTorchJob\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = loadPodTemplatePaths(profile); err == nil {
+		t.Errorf("loadPodTemplatePaths() is expected to fail without podTemplatePaths")
+	}
+}
+
+func TestWorkloadHandle(t *testing.T) {
+	newDeployment := func(workloadLabels, templateLabels map[string]string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Labels: workloadLabels},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: templateLabels},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "test", Image: "test"}},
+					},
+				},
+			},
+		}
+	}
+	newRequest := func(kind schema.GroupVersionKind, obj runtime.Object) admission.Request {
+		raw, err := json.Marshal(obj)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				Kind:      metav1.GroupVersionKind{Group: kind.Group, Version: kind.Version, Kind: kind.Kind},
+				Namespace: "default",
+				Object:    runtime.RawExtension{Raw: raw},
+			},
+		}
+	}
+
+	workloadLabels := map[string]string{common.InjectWorkload: common.True}
+	serverfulLabels := map[string]string{common.InjectServerfulFuse: common.True}
+	deploymentKind := appsv1.SchemeGroupVersion.WithKind("Deployment")
+	pytorchJob := &runtime.Unknown{Raw: []byte(`{
+		"apiVersion": "kubeflow.org/v1",
+		"kind": "PyTorchJob",
+		"metadata": {"name": "test", "labels": {"workload.fluid.io/inject": "true"}},
+		"spec": {"pytorchReplicaSpecs": {
+			"Master": {"template": {"metadata": {"labels": {"fuse.serverful.fluid.io/inject": "true"}}, "spec": {"containers": [{"name": "pytorch", "image": "test"}]}}},
+			"Worker": {"template": {"metadata": {"labels": {"fuse.serverful.fluid.io/inject": "true"}}, "spec": {"containers": [{"name": "pytorch", "image": "test"}]}}}
+		}}
+	}`)}
+
+	tests := []struct {
+		name string
+		req  admission.Request
+		// wantMutated are the paths of the mutated pod templates
+		wantMutated []string
+	}{
+		{
+			name:        "deployment",
+			req:         newRequest(deploymentKind, newDeployment(workloadLabels, serverfulLabels)),
+			wantMutated: []string{"/spec/template"},
+		},
+		{
+			name: "workload_injection_disabled",
+			req:  newRequest(deploymentKind, newDeployment(nil, serverfulLabels)),
+		},
+		{
+			name: "workload_injection_done",
+			req: newRequest(deploymentKind, newDeployment(workloadLabels, map[string]string{
+				common.InjectServerfulFuse: common.True,
+				common.InjectWorkloadDone:  common.True,
+			})),
+		},
+		{
+			name: "unknown_workload",
+			req:  newRequest(appsv1.SchemeGroupVersion.WithKind("ReplicaSet"), newDeployment(workloadLabels, serverfulLabels)),
+		},
+		{
+			name:        "custom_resource",
+			req:         newRequest(schema.GroupVersionKind{Group: "kubeflow.org", Version: "v1", Kind: "PyTorchJob"}, pytorchJob),
+			wantMutated: []string{"/spec/pytorchReplicaSpecs/Master/template", "/spec/pytorchReplicaSpecs/Worker/template"},
+		},
+	}
+
+	s := runtime.NewScheme()
+	_ = corev1.AddToScheme(s)
+	fakeClient := fake.NewFakeClientWithScheme(s, &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "default"},
+	})
+
+	mockReadFile := func(content string) ([]byte, error) {
+		return []byte(pluginsProfile), nil
+	}
+	patch := gomonkey.ApplyFunc(os.ReadFile, mockReadFile)
+	defer patch.Reset()
+
+	_ = plugins.RegisterMutatingHandlers(fakeClient)
+
+	handler := &FluidWorkloadMutatingHandler{}
+	handler.Setup(fakeClient, fakeClient, admission.NewDecoder(scheme.Scheme))
+	handler.podTemplatePaths[schema.GroupKind{Group: "kubeflow.org", Kind: "PyTorchJob"}] = []string{".spec.pytorchReplicaSpecs.*.template"}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := handler.Handle(context.TODO(), tt.req)
+			if !resp.Allowed {
+				t.Fatalf("Handle() = %v, want allowed", resp)
+			}
+
+			if len(tt.wantMutated) == 0 && len(resp.Patches) != 0 {
+				t.Errorf("Handle() patches = %v, want no patch", resp.Patches)
+			}
+			patches, _ := json.Marshal(resp.Patches)
+			for _, path := range tt.wantMutated {
+				for _, want := range []string{path + "/metadata/labels/done.workload.fluid.io~1inject", path + "/spec/affinity"} {
+					if !strings.Contains(string(patches), want) {
+						t.Errorf("Handle() patches = %s, want to contain %s", patches, want)
+					}
+				}
+			}
+		})
+	}
+
+	// the pod template mutated before is mutated again from the original one only if its dataset volumes change
+	mutatedDeployment := newDeployment(workloadLabels, serverfulLabels)
+	template, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&mutatedDeployment.Spec.Template)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = handler.mutatePodTemplate(template, nil, "default"); err != nil {
+		t.Fatalf("mutatePodTemplate() error = %v", err)
+	}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(template, &mutatedDeployment.Spec.Template); err != nil {
+		t.Fatal(err)
+	}
+	newUpdateRequest := func(obj *appsv1.Deployment) admission.Request {
+		req := newRequest(deploymentKind, obj)
+		req.Operation = admissionv1.Update
+		req.OldObject = newRequest(deploymentKind, mutatedDeployment).Object
+		return req
+	}
+
+	updated := mutatedDeployment.DeepCopy()
+	updated.Spec.Template.Spec.Containers[0].Image = "test:v2"
+	if resp := handler.Handle(context.TODO(), newUpdateRequest(updated)); !resp.Allowed || len(resp.Patches) != 0 {
+		t.Errorf("Handle() = %v, want no patch when the dataset volumes are not changed", resp)
+	}
+
+	updated.Spec.Template.Spec.Volumes = append(updated.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name:         "data",
+		VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data"}},
+	})
+	resp := handler.Handle(context.TODO(), newUpdateRequest(updated))
+	patches, _ := json.Marshal(resp.Patches)
+	if want := "/spec/template/metadata/annotations/workload.fluid.io~1original-template"; !resp.Allowed || !strings.Contains(string(patches), want) {
+		t.Errorf("Handle() patches = %s, want to contain %s", patches, want)
+	}
+
+	original, err := restoreOriginalPodTemplate(&updated.Spec.Template, template)
+	if err != nil || original == nil {
+		t.Fatalf("restoreOriginalPodTemplate() = %v, %v, want the original pod template", original, err)
+	}
+	if original.Spec.Containers[0].Image != "test:v2" || len(original.Spec.Volumes) != 1 || original.Spec.Affinity != nil {
+		t.Errorf("restoreOriginalPodTemplate() = %v, want the updated pod template without the injection", original)
+	}
+	if _, found := original.Labels[common.InjectWorkloadDone]; found {
+		t.Errorf("restoreOriginalPodTemplate() = %v, want the label %s removed", original, common.InjectWorkloadDone)
+	}
+}
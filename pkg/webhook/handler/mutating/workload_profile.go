@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutating
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// WorkloadsProfile defines the workloads with pod templates to mutate besides the built-in ones
+type WorkloadsProfile struct {
+	Workloads []Workload `yaml:"workloads"`
+}
+
+type Workload struct {
+	Group string `yaml:"group"`
+	Kind  string `yaml:"kind"`
+	// PodTemplatePaths are the JSON paths of the pod templates in dot notation, e.g. .spec.template.
+	// A "*" in the path matches all the values of a map or all the items of a list,
+	// e.g. .spec.pytorchReplicaSpecs.*.template
+	PodTemplatePaths []string `yaml:"podTemplatePaths"`
+}
+
+// builtinPodTemplatePaths are the paths of the pod templates of the built-in workloads
+var builtinPodTemplatePaths = map[schema.GroupKind][]string{
+	{Group: "apps", Kind: "Deployment"}:  {".spec.template"},
+	{Group: "apps", Kind: "StatefulSet"}: {".spec.template"},
+	{Group: "batch", Kind: "Job"}:        {".spec.template"},
+	{Group: "batch", Kind: "CronJob"}:    {".spec.jobTemplate.spec.template"},
+}
+
+// loadPodTemplatePaths returns the paths of the pod templates of the built-in workloads and the workloads
+// in the profile. The profile is optional, only the built-in workloads are mutated if it doesn't exist.
+func loadPodTemplatePaths(profilePath string) (map[schema.GroupKind][]string, error) {
+	paths := make(map[schema.GroupKind][]string, len(builtinPodTemplatePaths))
+	for gk, p := range builtinPodTemplatePaths {
+		paths[gk] = p
+	}
+
+	data, err := os.ReadFile(profilePath)
+	if os.IsNotExist(err) {
+		return paths, nil
+	}
+	if err != nil {
+		return paths, err
+	}
+
+	profile := WorkloadsProfile{}
+	if err = yaml.Unmarshal(data, &profile); err != nil {
+		return paths, err
+	}
+	for _, workload := range profile.Workloads {
+		if len(workload.Kind) == 0 || len(workload.PodTemplatePaths) == 0 {
+			return paths, fmt.Errorf("kind and podTemplatePaths of workload %v must be set", workload)
+		}
+		gk := schema.GroupKind{Group: workload.Group, Kind: workload.Kind}
+		paths[gk] = workload.PodTemplatePaths
+	}
+	return paths, nil
+}
+
+// findPodTemplates returns the pod templates in the object located by the path. The templates are returned
+// by reference, so that they can be modified in place.
+func findPodTemplates(obj map[string]interface{}, path string) (templates []map[string]interface{}) {
+	for _, template := range findPodTemplatesByPath(obj, path) {
+		templates = append(templates, template)
+	}
+	return
+}
+
+// findPodTemplatesByPath returns the pod templates in the object located by the path, keyed by their concrete
+// paths with "*" replaced by the map keys or the list indexes, e.g. .spec.pytorchReplicaSpecs.Master.template
+func findPodTemplatesByPath(obj map[string]interface{}, path string) map[string]map[string]interface{} {
+	templates := map[string]map[string]interface{}{}
+	fields := strings.Split(strings.TrimPrefix(path, "."), ".")
+
+	var walk func(node interface{}, fields []string, walked string)
+	walk = func(node interface{}, fields []string, walked string) {
+		if len(fields) == 0 {
+			if template, ok := node.(map[string]interface{}); ok {
+				templates[walked] = template
+			}
+			return
+		}
+
+		switch v := node.(type) {
+		case map[string]interface{}:
+			if fields[0] == "*" {
+				for key, value := range v {
+					walk(value, fields[1:], walked+"."+key)
+				}
+			} else if value, found := v[fields[0]]; found {
+				walk(value, fields[1:], walked+"."+fields[0])
+			}
+		case []interface{}:
+			if fields[0] == "*" {
+				for i, item := range v {
+					walk(item, fields[1:], fmt.Sprintf("%s.%d", walked, i))
+				}
+			}
+		}
+	}
+	walk(obj, fields, "")
+	return templates
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/validation"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// FluidValidatingHandler validates datasets and runtimes before they are persisted, so that invalid specs
+// are rejected at admission time instead of failing later in the runtime controllers.
+type FluidValidatingHandler struct {
+	Client client.Client
+	Reader client.Reader
+	// A decoder will be automatically injected
+	decoder *admission.Decoder
+}
+
+func (a *FluidValidatingHandler) Setup(client client.Client, reader client.Reader, decoder *admission.Decoder) {
+	a.Client = client
+	a.Reader = reader
+	a.decoder = decoder
+}
+
+// Handle is the validating logic of datasets and runtimes
+func (a *FluidValidatingHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	defer utils.TimeTrack(time.Now(), "FluidValidatingHandler.Handle",
+		"req.kind", req.Kind.Kind, "req.name", req.Name, "req.namespace", req.Namespace)
+
+	var setupLog = ctrl.Log.WithName("validate")
+
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return admission.Allowed(fmt.Sprintf("skip validating operation %s", req.Operation))
+	}
+
+	obj, supported := newObjectForKind(req.Kind.Kind)
+	if !supported {
+		return admission.Allowed(fmt.Sprintf("skip validating unsupported kind %s", req.Kind.Kind))
+	}
+
+	if err := a.decoder.Decode(req, obj); err != nil {
+		setupLog.Error(err, "unable to decode object from req", "kind", req.Kind.Kind)
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	// The object is being deleted and only its finalizers or status can be changed, no need to validate it.
+	if !obj.GetDeletionTimestamp().IsZero() {
+		return admission.Allowed("skip validating the object because it is being deleted")
+	}
+
+	var oldObj client.Object
+	if req.Operation == admissionv1.Update {
+		oldObj, _ = newObjectForKind(req.Kind.Kind)
+		if err := a.decoder.DecodeRaw(req.OldObject, oldObj); err != nil {
+			setupLog.Error(err, "unable to decode old object from req", "kind", req.Kind.Kind)
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+	}
+
+	allErrs := a.validate(obj, oldObj)
+	if len(allErrs) > 0 {
+		setupLog.Info("denied invalid object", "kind", req.Kind.Kind, "name", obj.GetName(), "namespace", obj.GetNamespace(), "errors", allErrs.ToAggregate().Error())
+		return admission.Denied(fmt.Sprintf("%s %s/%s is invalid: %s", req.Kind.Kind, obj.GetNamespace(), obj.GetName(), allErrs.ToAggregate().Error()))
+	}
+
+	return admission.Allowed("")
+}
+
+// validate dispatches the object to the validation rules of its kind. oldObj is nil when the object is being created.
+func (a *FluidValidatingHandler) validate(obj client.Object, oldObj client.Object) field.ErrorList {
+	switch typed := obj.(type) {
+	case *datav1alpha1.Dataset:
+		if oldObj == nil {
+			return validation.ValidateDataset(typed)
+		}
+		return validation.ValidateDatasetUpdate(typed, oldObj.(*datav1alpha1.Dataset))
+	case *datav1alpha1.ThinRuntime:
+		allErrs := validateRuntime(obj, oldObj)
+		return append(allErrs, a.validateThinRuntimeProfile(typed, oldObj)...)
+	default:
+		return validateRuntime(obj, oldObj)
+	}
+}
+
+// validateRuntime checks the fields shared by all the runtimes.
+func validateRuntime(obj client.Object, oldObj client.Object) field.ErrorList {
+	tieredStorePath := field.NewPath("spec").Child("tieredstore")
+	if oldObj == nil {
+		allErrs := validation.ValidateObjectName(obj.GetName(), field.NewPath("metadata").Child("name"))
+		return append(allErrs, validation.ValidateTieredStore(getTieredStore(obj), tieredStorePath)...)
+	}
+
+	return validation.ValidateTieredStoreUpdate(getTieredStore(obj), getTieredStore(oldObj), tieredStorePath)
+}
+
+// validateThinRuntimeProfile checks that the ThinRuntimeProfile referred by the ThinRuntime exists.
+func (a *FluidValidatingHandler) validateThinRuntimeProfile(runtime *datav1alpha1.ThinRuntime, oldObj client.Object) field.ErrorList {
+	allErrs := field.ErrorList{}
+	profileName := runtime.Spec.ThinRuntimeProfileName
+	if len(profileName) == 0 {
+		return allErrs
+	}
+
+	if oldRuntime, ok := oldObj.(*datav1alpha1.ThinRuntime); ok && oldRuntime.Spec.ThinRuntimeProfileName == profileName {
+		return allErrs
+	}
+
+	fldPath := field.NewPath("spec").Child("profileName")
+	if _, err := utils.GetThinRuntimeProfile(a.Reader, profileName); err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			return append(allErrs, field.NotFound(fldPath, profileName))
+		}
+		return append(allErrs, field.InternalError(fldPath, err))
+	}
+
+	return allErrs
+}
+
+func newObjectForKind(kind string) (obj client.Object, supported bool) {
+	switch kind {
+	case datav1alpha1.Datasetkind:
+		return &datav1alpha1.Dataset{}, true
+	case datav1alpha1.AlluxioRuntimeKind:
+		return &datav1alpha1.AlluxioRuntime{}, true
+	case datav1alpha1.GooseFSRuntimeKind:
+		return &datav1alpha1.GooseFSRuntime{}, true
+	case datav1alpha1.JindoRuntimeKind:
+		return &datav1alpha1.JindoRuntime{}, true
+	case datav1alpha1.JuiceFSRuntimeKind:
+		return &datav1alpha1.JuiceFSRuntime{}, true
+	case datav1alpha1.ThinRuntimeKind:
+		return &datav1alpha1.ThinRuntime{}, true
+	case datav1alpha1.EFCRuntimeKind:
+		return &datav1alpha1.EFCRuntime{}, true
+	case datav1alpha1.VineyardRuntimeKind:
+		return &datav1alpha1.VineyardRuntime{}, true
+	default:
+		return nil, false
+	}
+}
+
+func getTieredStore(obj client.Object) datav1alpha1.TieredStore {
+	switch runtime := obj.(type) {
+	case *datav1alpha1.AlluxioRuntime:
+		return runtime.Spec.TieredStore
+	case *datav1alpha1.GooseFSRuntime:
+		return runtime.Spec.TieredStore
+	case *datav1alpha1.JindoRuntime:
+		return runtime.Spec.TieredStore
+	case *datav1alpha1.JuiceFSRuntime:
+		return runtime.Spec.TieredStore
+	case *datav1alpha1.ThinRuntime:
+		return runtime.Spec.TieredStore
+	case *datav1alpha1.EFCRuntime:
+		return runtime.Spec.TieredStore
+	case *datav1alpha1.VineyardRuntime:
+		return runtime.Spec.TieredStore
+	default:
+		return datav1alpha1.TieredStore{}
+	}
+}
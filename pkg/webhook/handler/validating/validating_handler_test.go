@@ -0,0 +1,171 @@
+/*
+Copyright 2025 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func newRequest(t *testing.T, op admissionv1.Operation, kind string, obj, oldObj client.Object) admission.Request {
+	req := admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: op,
+			Kind:      metav1.GroupVersionKind{Group: datav1alpha1.GroupVersion.Group, Version: datav1alpha1.GroupVersion.Version, Kind: kind},
+			Name:      obj.GetName(),
+			Namespace: obj.GetNamespace(),
+		},
+	}
+
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatalf("failed to marshal object: %v", err)
+	}
+	req.Object = runtime.RawExtension{Raw: raw}
+
+	if oldObj != nil {
+		oldRaw, err := json.Marshal(oldObj)
+		if err != nil {
+			t.Fatalf("failed to marshal old object: %v", err)
+		}
+		req.OldObject = runtime.RawExtension{Raw: oldRaw}
+	}
+	return req
+}
+
+func TestHandle(t *testing.T) {
+	s := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(s)
+
+	profile := &datav1alpha1.ThinRuntimeProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-profile"},
+	}
+	c := fake.NewFakeClientWithScheme(s, profile)
+
+	handler := &FluidValidatingHandler{}
+	handler.Setup(c, c, admission.NewDecoder(s))
+
+	quota := resource.MustParse("1Gi")
+	newQuota := resource.MustParse("2Gi")
+	alluxioRuntime := &datav1alpha1.AlluxioRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"},
+		Spec: datav1alpha1.AlluxioRuntimeSpec{
+			TieredStore: datav1alpha1.TieredStore{
+				Levels: []datav1alpha1.Level{{MediumType: common.Memory, Path: "/dev/shm", Quota: &quota}},
+			},
+		},
+	}
+	resizedRuntime := alluxioRuntime.DeepCopy()
+	resizedRuntime.Spec.TieredStore.Levels[0].Quota = &newQuota
+
+	invalidRuntime := alluxioRuntime.DeepCopy()
+	invalidRuntime.Spec.TieredStore.Levels[0].Quota = nil
+
+	deletingRuntime := invalidRuntime.DeepCopy()
+	deletingRuntime.DeletionTimestamp = &metav1.Time{}
+
+	dataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"},
+		Spec: datav1alpha1.DatasetSpec{
+			Mounts: []datav1alpha1.Mount{{Name: "hbase", MountPoint: "s3://bucket/hbase"}},
+		},
+	}
+	conflictDataset := dataset.DeepCopy()
+	conflictDataset.Spec.Mounts = append(conflictDataset.Spec.Mounts, datav1alpha1.Mount{Name: "hbase", MountPoint: "s3://bucket/spark"})
+
+	thinRuntime := &datav1alpha1.ThinRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"},
+		Spec:       datav1alpha1.ThinRuntimeSpec{ThinRuntimeProfileName: "test-profile"},
+	}
+	missingProfileRuntime := thinRuntime.DeepCopy()
+	missingProfileRuntime.Spec.ThinRuntimeProfileName = "not-exist"
+
+	testCases := []struct {
+		name    string
+		req     admission.Request
+		allowed bool
+	}{
+		{
+			name:    "valid runtime",
+			req:     newRequest(t, admissionv1.Create, datav1alpha1.AlluxioRuntimeKind, alluxioRuntime, nil),
+			allowed: true,
+		},
+		{
+			name:    "runtime without quota",
+			req:     newRequest(t, admissionv1.Create, datav1alpha1.AlluxioRuntimeKind, invalidRuntime, nil),
+			allowed: false,
+		},
+		{
+			name:    "change tieredstore",
+			req:     newRequest(t, admissionv1.Update, datav1alpha1.AlluxioRuntimeKind, resizedRuntime, alluxioRuntime),
+			allowed: true,
+		},
+		{
+			name:    "change tieredstore to an invalid one",
+			req:     newRequest(t, admissionv1.Update, datav1alpha1.AlluxioRuntimeKind, invalidRuntime, alluxioRuntime),
+			allowed: false,
+		},
+		{
+			name:    "deleting runtime",
+			req:     newRequest(t, admissionv1.Update, datav1alpha1.AlluxioRuntimeKind, deletingRuntime, invalidRuntime),
+			allowed: true,
+		},
+		{
+			name:    "valid dataset",
+			req:     newRequest(t, admissionv1.Create, datav1alpha1.Datasetkind, dataset, nil),
+			allowed: true,
+		},
+		{
+			name:    "dataset with conflicting mounts",
+			req:     newRequest(t, admissionv1.Update, datav1alpha1.Datasetkind, conflictDataset, dataset),
+			allowed: false,
+		},
+		{
+			name:    "thin runtime with existing profile",
+			req:     newRequest(t, admissionv1.Create, datav1alpha1.ThinRuntimeKind, thinRuntime, nil),
+			allowed: true,
+		},
+		{
+			name:    "thin runtime with missing profile",
+			req:     newRequest(t, admissionv1.Create, datav1alpha1.ThinRuntimeKind, missingProfileRuntime, nil),
+			allowed: false,
+		},
+		{
+			name:    "unsupported kind",
+			req:     newRequest(t, admissionv1.Create, "DataLoad", &datav1alpha1.DataLoad{}, nil),
+			allowed: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		resp := handler.Handle(context.TODO(), tc.req)
+		if resp.Allowed != tc.allowed {
+			t.Errorf("testcase %s: expect allowed %v, got %v with result %v", tc.name, tc.allowed, resp.Allowed, resp.Result)
+		}
+	}
+}